@@ -13,8 +13,8 @@ import (
 )
 
 const (
-	envLocal = "local"
-	envProd  = "prod"
+	envLocal  = "local"
+	envMemory = "memory"
 )
 
 func main() {
@@ -22,6 +22,10 @@ func main() {
 
 	log := setupLogger(cfg.ENV)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(log, cfg, os.Args[2:]))
+	}
+
 	a := app.New(log, cfg)
 
 	ctx := context.Background()
@@ -45,9 +49,10 @@ func setupLogger(env string) *slog.Logger {
 	var log *slog.Logger
 
 	switch env {
-	case envLocal:
+	case envLocal, envMemory:
 		log = setupPrettySlog()
-	case envProd:
+	default:
+		//prod, stage and unknown envs, logger is never nil
 		log = slog.New(
 			slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}),
 		)
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"zadanie-6105/config"
+	"zadanie-6105/internal/repo/postgres"
+)
+
+const migrateUsage = "usage: tender migrate up | down [steps] | status"
+
+// runMigrate handles `tender migrate ...` and returns process exit code
+func runMigrate(log *slog.Logger, cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Println(migrateUsage)
+		return 2
+	}
+
+	db, err := postgres.ConnectPostgres(cfg)
+	if err != nil {
+		return 1
+	}
+	defer db.Close()
+
+	migrator, err := postgres.NewMigrator(log, db)
+	if err != nil {
+		fmt.Println("failed to load migrations:", err)
+		return 1
+	}
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				fmt.Println(migrateUsage)
+				return 2
+			}
+		}
+		err = migrator.Down(steps)
+	case "status":
+		err = printStatus(migrator)
+	default:
+		fmt.Println(migrateUsage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Println("migration failed:", err)
+		return 1
+	}
+	return 0
+}
+
+func printStatus(migrator *postgres.Migrator) error {
+	statuses, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	version, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("database version: %d, build version: %d\n", version, migrator.Latest())
+
+	for _, status := range statuses {
+		state := "pending"
+		if status.Applied {
+			state = "applied " + status.AppliedAt
+		}
+		if status.Version > migrator.Latest() {
+			state += " (unknown to this build)"
+		}
+		fmt.Fprintf(os.Stdout, "%04d_%s\t%s\n", status.Version, status.Name, state)
+	}
+
+	return nil
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -17,6 +20,50 @@ type Config struct {
 	POSTGRES_PORT     string `yaml:"port"`
 	POSTGRES_DATABASE string `yaml:"DBName"`
 
+	AUTH_SECRET       string        `yaml:"authSecret"`
+	ACCESS_TOKEN_TTL  time.Duration `yaml:"accessTokenTTL" env-default:"15m"`
+	REFRESH_TOKEN_TTL time.Duration `yaml:"refreshTokenTTL" env-default:"720h"`
+	INVITE_TTL        time.Duration `yaml:"inviteTTL" env-default:"72h"`
+
+	// username of the first admin, invite for it is logged at startup until password is set
+	BOOTSTRAP_ADMIN string `yaml:"bootstrapAdmin"`
+
+	// how often expired tenders are looked for; 0 disables closing by deadline
+	TENDER_CLOSE_INTERVAL time.Duration `yaml:"tenderCloseInterval" env-default:"1m"`
+
+	// how often outbox is checked for new events and retries (0 disables sending), and how long receiver may answer
+	WEBHOOK_DISPATCH_INTERVAL time.Duration `yaml:"webhookDispatchInterval" env-default:"5s"`
+	WEBHOOK_TIMEOUT           time.Duration `yaml:"webhookTimeout" env-default:"10s"`
+	// lets webhooks call loopback and private addresses, only for local development
+	WEBHOOK_ALLOW_PRIVATE bool `yaml:"webhookAllowPrivate" env-default:"false"`
+
+	// how long a request may run, its database queries are canceled after that; 0 disables the deadline
+	REQUEST_TIMEOUT time.Duration `yaml:"requestTimeout" env-default:"30s"`
+	// the same for upload and download of attachments, their bodies may stream for long
+	TRANSFER_TIMEOUT time.Duration `yaml:"transferTimeout" env-default:"10m"`
+
+	// apply pending migrations at startup, otherwise startup requires up-to-date schema
+	AUTO_MIGRATE bool `yaml:"autoMigrate" env-default:"true"`
+
+	// criteria organizations rate bids on in reviews, scores are from 1 to 5
+	REVIEW_CRITERIA []string `yaml:"reviewCriteria" env-default:"quality,timeliness,price"`
+
+	// where attachments are kept: "fs" keeps them in BLOB_DIR, "s3" in bucket of S3-compatible storage
+	BLOB_STORE    string `yaml:"blobStore" env-default:"fs"`
+	BLOB_DIR      string `yaml:"blobDir" env-default:"./data/attachments"`
+	S3_ENDPOINT   string `yaml:"s3Endpoint"`
+	S3_REGION     string `yaml:"s3Region" env-default:"us-east-1"`
+	S3_BUCKET     string `yaml:"s3Bucket"`
+	S3_ACCESS_KEY string `yaml:"s3AccessKey"`
+	S3_SECRET_KEY string `yaml:"s3SecretKey"`
+
+	// largest attachment in bytes and media types allowed as detected from content
+	ATTACHMENT_MAX_SIZE int64    `yaml:"attachmentMaxSize" env-default:"10485760"`
+	ATTACHMENT_TYPES    []string `yaml:"attachmentTypes" env-default:"application/pdf,image/png,image/jpeg,text/plain,application/zip"`
+
+	// usernames allowed to add, rename and deprecate categories of tenders, nobody by default
+	CATALOG_ADMINS []string `yaml:"catalogAdmins"`
+
 	ENV string
 }
 
@@ -35,12 +82,19 @@ func MustLoad() *Config {
 		config = MustLoadPath("./config/local.yaml")
 	} else if config.ENV == "stage" {
 		config = MustLoadPath("./config/stage.yaml")
+	} else if config.ENV == "memory" {
+		//no database, storage lives in memory
+		config = MustLoadPath("./config/memory.yaml")
+		config.ENV = "memory"
 	} else {
 		//else read from env
 		config = MustGetEnv()
 	}
 
-	fmt.Println(config)
+	//config is not printed, it has secrets
+	if config.AUTH_SECRET == "" {
+		log.Fatal("AUTH_SECRET is required")
+	}
 	return config
 }
 
@@ -87,6 +141,7 @@ func MustGetEnv() *Config {
 		"POSTGRES_HOST":     &config.POSTGRES_HOST,
 		"POSTGRES_PORT":     &config.POSTGRES_PORT,
 		"POSTGRES_DATABASE": &config.POSTGRES_DATABASE,
+		"AUTH_SECRET":       &config.AUTH_SECRET,
 	}
 
 	for env, ptr := range envs {
@@ -105,6 +160,92 @@ func MustGetEnv() *Config {
 
 		config.POSTGRES_CONN = postgresConn
 	}
+
+	//token lifetimes and scheduler intervals are optional
+	durations := map[string]*time.Duration{
+		"ACCESS_TOKEN_TTL":      &config.ACCESS_TOKEN_TTL,
+		"REFRESH_TOKEN_TTL":     &config.REFRESH_TOKEN_TTL,
+		"INVITE_TTL":            &config.INVITE_TTL,
+		"TENDER_CLOSE_INTERVAL": &config.TENDER_CLOSE_INTERVAL,
+
+		"WEBHOOK_DISPATCH_INTERVAL": &config.WEBHOOK_DISPATCH_INTERVAL,
+		"WEBHOOK_TIMEOUT":           &config.WEBHOOK_TIMEOUT,
+		"REQUEST_TIMEOUT":           &config.REQUEST_TIMEOUT,
+		"TRANSFER_TIMEOUT":          &config.TRANSFER_TIMEOUT,
+	}
+	config.ACCESS_TOKEN_TTL = 15 * time.Minute
+	config.REFRESH_TOKEN_TTL = 30 * 24 * time.Hour
+	config.INVITE_TTL = 72 * time.Hour
+	config.TENDER_CLOSE_INTERVAL = time.Minute
+	config.WEBHOOK_DISPATCH_INTERVAL = 5 * time.Second
+	config.WEBHOOK_TIMEOUT = 10 * time.Second
+	config.REQUEST_TIMEOUT = 30 * time.Second
+	config.TRANSFER_TIMEOUT = 10 * time.Minute
+
+	for env, ptr := range durations {
+		if v := os.Getenv(env); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				log.Fatal(fmt.Sprintf("failed to parse env: %s", env))
+			}
+			*ptr = d
+		}
+	}
+
+	config.AUTO_MIGRATE = true
+	if v := os.Getenv("AUTO_MIGRATE"); v != "" {
+		autoMigrate, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("failed to parse env: %s", "AUTO_MIGRATE"))
+		}
+		config.AUTO_MIGRATE = autoMigrate
+	}
+	if v := os.Getenv("WEBHOOK_ALLOW_PRIVATE"); v != "" {
+		allowPrivate, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("failed to parse env: %s", "WEBHOOK_ALLOW_PRIVATE"))
+		}
+		config.WEBHOOK_ALLOW_PRIVATE = allowPrivate
+	}
+	config.REVIEW_CRITERIA = []string{"quality", "timeliness", "price"}
+	if v := os.Getenv("REVIEW_CRITERIA"); v != "" {
+		config.REVIEW_CRITERIA = strings.Split(v, ",")
+	}
+
+	//blob store is optional, files are kept on disk by default
+	blobs := map[string]*string{
+		"BLOB_STORE":    &config.BLOB_STORE,
+		"BLOB_DIR":      &config.BLOB_DIR,
+		"S3_ENDPOINT":   &config.S3_ENDPOINT,
+		"S3_REGION":     &config.S3_REGION,
+		"S3_BUCKET":     &config.S3_BUCKET,
+		"S3_ACCESS_KEY": &config.S3_ACCESS_KEY,
+		"S3_SECRET_KEY": &config.S3_SECRET_KEY,
+	}
+	config.BLOB_STORE = "fs"
+	config.BLOB_DIR = "./data/attachments"
+	config.S3_REGION = "us-east-1"
+	for env, ptr := range blobs {
+		if v := os.Getenv(env); v != "" {
+			*ptr = v
+		}
+	}
+	config.ATTACHMENT_MAX_SIZE = 10 << 20
+	if v := os.Getenv("ATTACHMENT_MAX_SIZE"); v != "" {
+		maxSize, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("failed to parse env: %s", "ATTACHMENT_MAX_SIZE"))
+		}
+		config.ATTACHMENT_MAX_SIZE = maxSize
+	}
+	config.ATTACHMENT_TYPES = []string{"application/pdf", "image/png", "image/jpeg", "text/plain", "application/zip"}
+	if v := os.Getenv("ATTACHMENT_TYPES"); v != "" {
+		config.ATTACHMENT_TYPES = strings.Split(v, ",")
+	}
+	config.BOOTSTRAP_ADMIN = os.Getenv("BOOTSTRAP_ADMIN")
+	if v := os.Getenv("CATALOG_ADMINS"); v != "" {
+		config.CATALOG_ADMINS = strings.Split(v, ",")
+	}
 	config.ENV = "prod"
 	return config
 }
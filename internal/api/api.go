@@ -18,6 +18,19 @@ type Api struct {
 	serviceBidEditor        ServiceBidEditor
 	serviceBidDecisionMaker ServiceBidDecisionMaker
 	serviceBidFeedbacker    ServiceBidFeedbacker
+
+	serviceOrganizationProvider ServiceOrganizationProvider
+	serviceOrganizationEditor   ServiceOrganizationEditor
+	serviceEmployeeProvider     ServiceEmployeeProvider
+	serviceEmployeeEditor       ServiceEmployeeEditor
+
+	serviceAuth ServiceAuth
+
+	serviceWebhooks ServiceWebhooks
+
+	serviceAttachments ServiceAttachments
+
+	serviceCategories ServiceCategories
 }
 
 func New(
@@ -33,6 +46,18 @@ func New(
 	serviceBidDecisionMaker ServiceBidDecisionMaker,
 	serviceBidFeedbacker ServiceBidFeedbacker,
 
+	serviceOrganizationProvider ServiceOrganizationProvider,
+	serviceOrganizationEditor ServiceOrganizationEditor,
+	serviceEmployeeProvider ServiceEmployeeProvider,
+	serviceEmployeeEditor ServiceEmployeeEditor,
+
+	serviceAuth ServiceAuth,
+
+	serviceWebhooks ServiceWebhooks,
+
+	serviceAttachments ServiceAttachments,
+
+	serviceCategories ServiceCategories,
 ) *Api {
 	return &Api{
 		log: log,
@@ -46,6 +71,19 @@ func New(
 		serviceBidEditor:        serviceBidEditor,
 		serviceBidDecisionMaker: serviceBidDecisionMaker,
 		serviceBidFeedbacker:    serviceBidFeedbacker,
+
+		serviceOrganizationProvider: serviceOrganizationProvider,
+		serviceOrganizationEditor:   serviceOrganizationEditor,
+		serviceEmployeeProvider:     serviceEmployeeProvider,
+		serviceEmployeeEditor:       serviceEmployeeEditor,
+
+		serviceAuth: serviceAuth,
+
+		serviceWebhooks: serviceWebhooks,
+
+		serviceAttachments: serviceAttachments,
+
+		serviceCategories: serviceCategories,
 	}
 }
 
@@ -0,0 +1,290 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+// attachmentField is the multipart field with uploaded file
+const attachmentField = "file"
+
+type ServiceAttachments interface {
+	Attachments(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		version int32,
+	) ([]model.AttachmentResponse, error)
+	UploadAttachment(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		name string,
+		size int64,
+		body io.Reader,
+		expectedVersion int32,
+	) (model.AttachmentResponse, int, error)
+	DownloadAttachment(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		attachmentId string,
+	) (model.AttachmentResponse, io.ReadCloser, error)
+	DeleteAttachment(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		attachmentId string,
+		expectedVersion int32,
+	) (int, error)
+}
+
+func (a *Api) TenderAttachments(ctx echo.Context) error {
+	const op = "Api.TenderAttachments"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderAttachments{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.attachments(ctx, model.OwnerTender, req.TenderId, req.Version)
+}
+
+func (a *Api) UploadTenderAttachment(ctx echo.Context) error {
+	const op = "Api.UploadTenderAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.UploadTenderAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.uploadAttachment(ctx, log, model.OwnerTender, req.TenderId, req.IfMatch)
+}
+
+func (a *Api) DownloadTenderAttachment(ctx echo.Context) error {
+	const op = "Api.DownloadTenderAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.downloadAttachment(ctx, model.OwnerTender, req.TenderId, req.AttachmentId)
+}
+
+func (a *Api) DeleteTenderAttachment(ctx echo.Context) error {
+	const op = "Api.DeleteTenderAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.DeleteTenderAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.deleteAttachment(ctx, model.OwnerTender, req.TenderId, req.AttachmentId, req.IfMatch)
+}
+
+func (a *Api) BidAttachments(ctx echo.Context) error {
+	const op = "Api.BidAttachments"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.BidAttachments{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.attachments(ctx, model.OwnerBid, req.BidId, req.Version)
+}
+
+func (a *Api) UploadBidAttachment(ctx echo.Context) error {
+	const op = "Api.UploadBidAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.UploadBidAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.uploadAttachment(ctx, log, model.OwnerBid, req.BidId, req.IfMatch)
+}
+
+func (a *Api) DownloadBidAttachment(ctx echo.Context) error {
+	const op = "Api.DownloadBidAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.BidAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.downloadAttachment(ctx, model.OwnerBid, req.BidId, req.AttachmentId)
+}
+
+func (a *Api) DeleteBidAttachment(ctx echo.Context) error {
+	const op = "Api.DeleteBidAttachment"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.DeleteBidAttachment{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	return a.deleteAttachment(ctx, model.OwnerBid, req.BidId, req.AttachmentId, req.IfMatch)
+}
+
+func (a *Api) attachments(ctx echo.Context, ownerType string, ownerId string, version int32) error {
+	attachments, err := a.serviceAttachments.Attachments(ctx.Request().Context(), ownerType, ownerId, version)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, attachments)
+}
+
+// uploadAttachment and deleteAttachment change version of owner, so they follow If-Match and give new ETag
+func (a *Api) uploadAttachment(ctx echo.Context, log *slog.Logger, ownerType string, ownerId string, ifMatchHeader string) error {
+	expectedVersion, err := ifMatch(ifMatchHeader)
+	if err != nil {
+		return err
+	}
+
+	header, err := ctx.FormFile(attachmentField)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return err
+	}
+	if err != nil {
+		log.Error("failed to get file", sl.Err(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "multipart field "+attachmentField+" with file is required")
+	}
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	attachment, version, err := a.serviceAttachments.UploadAttachment(ctx.Request().Context(), ownerType, ownerId, header.Filename, header.Size, file, expectedVersion)
+	if err != nil {
+		return err
+	}
+
+	setETag(ctx, version)
+	return ctx.JSON(http.StatusCreated, attachment)
+}
+
+func (a *Api) deleteAttachment(ctx echo.Context, ownerType string, ownerId string, attachmentId string, ifMatchHeader string) error {
+	expectedVersion, err := ifMatch(ifMatchHeader)
+	if err != nil {
+		return err
+	}
+
+	version, err := a.serviceAttachments.DeleteAttachment(ctx.Request().Context(), ownerType, ownerId, attachmentId, expectedVersion)
+	if err != nil {
+		return err
+	}
+
+	setETag(ctx, version)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// downloadAttachment streams content, checksum lets client verify it
+func (a *Api) downloadAttachment(ctx echo.Context, ownerType string, ownerId string, attachmentId string) error {
+	attachment, content, err := a.serviceAttachments.DownloadAttachment(ctx.Request().Context(), ownerType, ownerId, attachmentId)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size, 10))
+	header.Set("X-Checksum-Sha256", attachment.Checksum)
+
+	return ctx.Stream(http.StatusOK, attachment.ContentType, content)
+}
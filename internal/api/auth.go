@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"strings"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/auth"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+type ServiceAuth interface {
+	Register(
+		ctx context.Context,
+		inviteToken string,
+		password string,
+	) error
+	InviteEmployee(
+		ctx context.Context,
+		employeeId string,
+	) (model.InviteResponse, error)
+	IssueToken(
+		ctx context.Context,
+		username string,
+		password string,
+	) (model.TokenResponse, error)
+	RefreshToken(
+		ctx context.Context,
+		refreshToken string,
+	) (model.TokenResponse, error)
+	RevokeToken(
+		ctx context.Context,
+		allSessions bool,
+	) error
+	Authenticate(
+		ctx context.Context,
+		accessToken string,
+	) (model.Caller, error)
+}
+
+// Authenticate resolves bearer token into caller and puts him into request context.
+func (a *Api) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		header := ctx.Request().Header.Get(echo.HeaderAuthorization)
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Errorf("missing bearer token"))
+		}
+
+		caller, err := a.serviceAuth.Authenticate(ctx.Request().Context(), token)
+		if err != nil {
+			return err
+		}
+
+		ctx.SetRequest(ctx.Request().WithContext(auth.WithCaller(ctx.Request().Context(), caller)))
+		return next(ctx)
+	}
+}
+
+func (a *Api) Register(ctx echo.Context) error {
+	const op = "Api.Register"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.Register{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	// no sl.Req here, request contains password and invite
+	log.Info("register")
+
+	err = a.serviceAuth.Register(ctx.Request().Context(), req.InviteToken, req.Password)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+func (a *Api) InviteEmployee(ctx echo.Context) error {
+	const op = "Api.InviteEmployee"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.InviteEmployee{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var invite model.InviteResponse
+	invite, err = a.serviceAuth.InviteEmployee(ctx.Request().Context(), req.EmployeeId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, invite)
+}
+
+func (a *Api) IssueToken(ctx echo.Context) error {
+	const op = "Api.IssueToken"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.IssueToken{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info("issue token", slog.String("username", req.Username))
+
+	var token model.TokenResponse
+	token, err = a.serviceAuth.IssueToken(ctx.Request().Context(), req.Username, req.Password)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, token)
+}
+
+func (a *Api) RefreshToken(ctx echo.Context) error {
+	const op = "Api.RefreshToken"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.RefreshToken{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	var token model.TokenResponse
+	token, err = a.serviceAuth.RefreshToken(ctx.Request().Context(), req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, token)
+}
+
+func (a *Api) RevokeToken(ctx echo.Context) error {
+	const op = "Api.RevokeToken"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.RevokeToken{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	err = a.serviceAuth.RevokeToken(ctx.Request().Context(), req.All)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
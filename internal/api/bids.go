@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"github.com/labstack/echo/v4"
 	"log/slog"
 	"net/http"
@@ -13,68 +14,125 @@ import (
 
 type ServiceBidProvider interface {
 	GetBidsByUser(
-		limit int32,
-		offset int32,
-		username string,
-	) ([]model.BidResponse, error)
+		ctx context.Context,
+		page model.PageQuery,
+		authors string,
+	) (model.Page[model.BidResponse], error)
 	BidsForTender(
+		ctx context.Context,
 		tenderId string,
-		limit int32,
-		offset int32,
-		username string,
-	) ([]model.BidResponse, error)
+		page model.PageQuery,
+	) (model.Page[model.BidResponse], error)
 	BidStatus(
+		ctx context.Context,
 		bidId string,
-		username string,
 	) (string, error)
+	BidVersions(
+		ctx context.Context,
+		bidId string,
+	) ([]model.BidVersionResponse, error)
+	BidVersion(
+		ctx context.Context,
+		bidId string,
+		version int32,
+	) (model.BidVersionResponse, error)
+	BidVersionsDiff(
+		ctx context.Context,
+		bidId string,
+		from int32,
+		to int32,
+	) (model.VersionDiff, error)
+	SearchBids(
+		ctx context.Context,
+		filter model.SearchFilter,
+		page model.PageQuery,
+	) (model.Page[model.BidSearchResponse], error)
 }
 type ServiceBidCreator interface {
 	CreateBid(
+		ctx context.Context,
 		name string,
 		description string,
 		tenderId string,
 		authorType string,
 		authorId string,
+		offer *model.Offer,
 	) (model.BidResponse, error)
 }
 type ServiceBidEditor interface {
 	UpdateBidStatus(
+		ctx context.Context,
 		bidId string,
 		status string,
-		username string,
+		expectedVersion int32,
 	) (model.BidResponse, error)
 	EditBid(
+		ctx context.Context,
 		bidId string,
-		username string,
 		name string,
 		description string,
+		offer *model.Offer,
+		expectedVersion int32,
 	) (model.BidResponse, error)
 	RollbackBid(
+		ctx context.Context,
 		bidId string,
 		version int32,
-		username string,
+		expectedVersion int32,
 	) (model.BidResponse, error)
 }
 type ServiceBidDecisionMaker interface {
 	SubmitDecision(
+		ctx context.Context,
 		bidId string,
 		decision string,
-		username string,
+		comment string,
+		organizationId string,
+		expectedVersion int32,
 	) (model.BidResponse, error)
+	Decisions(
+		ctx context.Context,
+		bidId string,
+	) ([]model.DecisionResponse, error)
+	TenderTally(
+		ctx context.Context,
+		tenderId string,
+	) (model.TenderTally, error)
 }
 type ServiceBidFeedbacker interface {
 	Feedback(
+		ctx context.Context,
 		bidId string,
 		feedback string,
-		username string,
+		ratings map[string]int,
+		organizationId string,
 	) (model.BidResponse, error)
 	Reviews(
+		ctx context.Context,
+		tenderId string,
+		filter model.ReviewFilter,
+		page model.PageQuery,
+	) (model.Page[model.Feedback], error)
+	Reputation(
+		ctx context.Context,
 		tenderId string,
-		authorUsername string,
-		requesterUsername string,
-		limit int32,
-		offset int32,
-	) ([]model.Feedback, error)
+		authorType string,
+		author string,
+	) (model.Reputation, error)
+	PostMessage(
+		ctx context.Context,
+		bidId string,
+		text string,
+	) (model.ThreadMessageResponse, error)
+	Thread(
+		ctx context.Context,
+		bidId string,
+		page model.PageQuery,
+	) (model.Page[model.ThreadMessageResponse], error)
+	MarkThreadRead(
+		ctx context.Context,
+		bidId string,
+	) error
 }
 
 func (a *Api) CreateBid(ctx echo.Context) error {
@@ -96,10 +154,11 @@ func (a *Api) CreateBid(ctx echo.Context) error {
 	log.Info(sl.Req(req))
 
 	var bid model.BidResponse
-	bid, err = a.serviceBidCreator.CreateBid(req.Name, req.Description, req.TenderId, req.AuthorType, req.AuthorId)
+	bid, err = a.serviceBidCreator.CreateBid(ctx.Request().Context(), req.Name, req.Description, req.TenderId, req.AuthorType, req.AuthorId, convertOffer(req.Offer))
 	if err != nil {
 		return err
 	}
+	setETag(ctx, bid.Version)
 
 	return ctx.JSON(http.StatusOK, bid)
 }
@@ -122,13 +181,15 @@ func (a *Api) GetBidsByUser(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
-	var bids []model.BidResponse
-	bids, err = a.serviceBidProvider.GetBidsByUser(req.Limit, req.Offset, req.Username)
+	var bids model.Page[model.BidResponse]
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	bids, err = a.serviceBidProvider.GetBidsByUser(ctx.Request().Context(), page, req.Authors)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, bids)
+	setPageHeaders(ctx, bids.NextCursor, bids.Total)
+	return ctx.JSON(http.StatusOK, bids.Items)
 }
 
 func (a *Api) BidsForTender(ctx echo.Context) error {
@@ -149,13 +210,15 @@ func (a *Api) BidsForTender(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
-	var bids []model.BidResponse
-	bids, err = a.serviceBidProvider.BidsForTender(req.TenderId, req.Limit, req.Offset, req.Username)
+	var bids model.Page[model.BidResponse]
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	bids, err = a.serviceBidProvider.BidsForTender(ctx.Request().Context(), req.TenderId, page)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, bids)
+	setPageHeaders(ctx, bids.NextCursor, bids.Total)
+	return ctx.JSON(http.StatusOK, bids.Items)
 }
 
 func (a *Api) BidStatus(ctx echo.Context) error {
@@ -177,7 +240,7 @@ func (a *Api) BidStatus(ctx echo.Context) error {
 	log.Info(sl.Req(req))
 
 	var status string
-	status, err = a.serviceBidProvider.BidStatus(req.BidId, req.Username)
+	status, err = a.serviceBidProvider.BidStatus(ctx.Request().Context(), req.BidId)
 	if err != nil {
 		return err
 	}
@@ -203,12 +266,18 @@ func (a *Api) UpdateBidStatus(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var bid model.BidResponse
-	bid, err = a.serviceBidEditor.UpdateBidStatus(req.BidId, req.Status, req.Username)
+	bid, err = a.serviceBidEditor.UpdateBidStatus(ctx.Request().Context(), req.BidId, req.Status, expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, bid.Version)
 	return ctx.JSON(http.StatusOK, bid)
 }
 
@@ -230,12 +299,18 @@ func (a *Api) EditBid(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var bid model.BidResponse
-	bid, err = a.serviceBidEditor.EditBid(req.BidId, req.Username, req.Name, req.Description)
+	bid, err = a.serviceBidEditor.EditBid(ctx.Request().Context(), req.BidId, req.Name, req.Description, convertOffer(req.Offer), expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, bid.Version)
 	return ctx.JSON(http.StatusOK, bid)
 }
 
@@ -257,16 +332,47 @@ func (a *Api) SubmitDecision(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var bid model.BidResponse
-	bid, err = a.serviceBidDecisionMaker.SubmitDecision(req.BidId, req.Decision, req.Username)
+	bid, err = a.serviceBidDecisionMaker.SubmitDecision(ctx.Request().Context(), req.BidId, req.Decision, req.Comment, req.OrganizationId, expectedVersion)
 
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, bid.Version)
 	return ctx.JSON(http.StatusOK, bid)
 }
 
+func (a *Api) Decisions(ctx echo.Context) error {
+	const op = "Api.Decisions"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.Decisions{}
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	decisions, err := a.serviceBidDecisionMaker.Decisions(ctx.Request().Context(), req.BidId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, decisions)
+}
+
 func (a *Api) Feedback(ctx echo.Context) error {
 	const op = "Api.Feedback"
 	log := a.log.With(
@@ -286,10 +392,11 @@ func (a *Api) Feedback(ctx echo.Context) error {
 	log.Info(sl.Req(req))
 
 	var bid model.BidResponse
-	bid, err = a.serviceBidFeedbacker.Feedback(req.BidId, req.BidFeedback, req.Username)
+	bid, err = a.serviceBidFeedbacker.Feedback(ctx.Request().Context(), req.BidId, req.BidFeedback, req.Ratings, req.OrganizationId)
 	if err != nil {
 		return err
 	}
+	setETag(ctx, bid.Version)
 
 	return ctx.JSON(http.StatusOK, bid)
 }
@@ -312,12 +419,18 @@ func (a *Api) RollbackBid(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var bid model.BidResponse
-	bid, err = a.serviceBidEditor.RollbackBid(req.BidId, req.Version, req.Username)
+	bid, err = a.serviceBidEditor.RollbackBid(ctx.Request().Context(), req.BidId, req.Version, expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, bid.Version)
 	return ctx.JSON(http.StatusOK, bid)
 }
 
@@ -339,12 +452,81 @@ func (a *Api) Reviews(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
-	var feedbacks []model.Feedback
-	feedbacks, err = a.serviceBidFeedbacker.Reviews(req.TenderId, req.AuthorUsername, req.RequesterUsername, req.Limit, req.Offset)
+	authorType, author := reviewAuthor(req.ReviewAuthor)
+	filter := model.ReviewFilter{
+		AuthorType:  authorType,
+		Author:      author,
+		AllTenders:  req.Scope == "organization",
+		BidId:       req.BidId,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+	}
+
+	var feedbacks model.Page[model.Feedback]
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	feedbacks, err = a.serviceBidFeedbacker.Reviews(ctx.Request().Context(), req.TenderId, filter, page)
 
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, feedbacks)
+	setPageHeaders(ctx, feedbacks.NextCursor, feedbacks.Total)
+	return ctx.JSON(http.StatusOK, feedbacks.Items)
+}
+
+func (a *Api) Reputation(ctx echo.Context) error {
+	const op = "Api.Reputation"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.Reputation{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	authorType, author := reviewAuthor(req.ReviewAuthor)
+	reputation, err := a.serviceBidFeedbacker.Reputation(ctx.Request().Context(), req.TenderId, authorType, author)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, reputation)
+}
+
+// reviewAuthor tells author type of bids by which of author fields is set, validation allows only one
+func reviewAuthor(req request.ReviewAuthor) (string, string) {
+	if req.AuthorOrganizationId != "" {
+		return "Organization", req.AuthorOrganizationId
+	}
+	return "User", req.AuthorUsername
+}
+
+// convertOffer maps optional offer from request, nil means offer was not sent
+func convertOffer(req *request.Offer) *model.Offer {
+	if req == nil {
+		return nil
+	}
+	offer := &model.Offer{
+		Amount:           req.Amount,
+		Currency:         req.Currency,
+		DeliveryDeadline: req.DeliveryDeadline,
+		WarrantyMonths:   req.WarrantyMonths,
+		Items:            make([]model.OfferItem, 0, len(req.Items)),
+	}
+	for _, item := range req.Items {
+		offer.Items = append(offer.Items, model.OfferItem{
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+	return offer
 }
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+type ServiceCategories interface {
+	Categories(
+		ctx context.Context,
+		withDeprecated bool,
+	) ([]model.CategoryResponse, error)
+	CreateCategory(
+		ctx context.Context,
+		code string,
+		name string,
+		parentCode string,
+	) (model.CategoryResponse, error)
+	EditCategory(
+		ctx context.Context,
+		code string,
+		name string,
+		deprecated *bool,
+	) (model.CategoryResponse, error)
+}
+
+func (a *Api) Categories(ctx echo.Context) error {
+	const op = "Api.Categories"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.Categories{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var categories []model.CategoryResponse
+	categories, err = a.serviceCategories.Categories(ctx.Request().Context(), req.Deprecated)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, categories)
+}
+
+func (a *Api) CreateCategory(ctx echo.Context) error {
+	const op = "Api.CreateCategory"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.CreateCategory{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var category model.CategoryResponse
+	category, err = a.serviceCategories.CreateCategory(ctx.Request().Context(), req.Code, req.Name, req.ParentCode)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, category)
+}
+
+func (a *Api) EditCategory(ctx echo.Context) error {
+	const op = "Api.EditCategory"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.EditCategory{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var category model.CategoryResponse
+	category, err = a.serviceCategories.EditCategory(ctx.Request().Context(), req.Code, req.Name, req.Deprecated)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, category)
+}
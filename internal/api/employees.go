@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+type ServiceEmployeeProvider interface {
+	Employees(
+		ctx context.Context,
+		page model.PageQuery,
+	) (model.Page[model.EmployeeResponse], error)
+	Employee(
+		ctx context.Context,
+		employeeId string,
+	) (model.EmployeeResponse, error)
+	Me(
+		ctx context.Context,
+	) (model.EmployeeResponse, error)
+}
+type ServiceEmployeeEditor interface {
+	CreateEmployee(
+		ctx context.Context,
+		username string,
+		firstName string,
+		lastName string,
+		password string,
+		organizationId string,
+		role string,
+	) (model.EmployeeResponse, error)
+	EditEmployee(
+		ctx context.Context,
+		employeeId string,
+		firstName string,
+		lastName string,
+	) (model.EmployeeResponse, error)
+	DeleteEmployee(
+		ctx context.Context,
+		employeeId string,
+	) error
+}
+
+func (a *Api) Employees(ctx echo.Context) error {
+	const op = "Api.Employees"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.GetEmployees{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	employees, err := a.serviceEmployeeProvider.Employees(ctx.Request().Context(), page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, employees.NextCursor, employees.Total)
+	return ctx.JSON(http.StatusOK, employees.Items)
+}
+
+func (a *Api) Employee(ctx echo.Context) error {
+	const op = "Api.Employee"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.GetEmployee{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var employee model.EmployeeResponse
+	employee, err = a.serviceEmployeeProvider.Employee(ctx.Request().Context(), req.EmployeeId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, employee)
+}
+
+func (a *Api) Me(ctx echo.Context) error {
+	employee, err := a.serviceEmployeeProvider.Me(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, employee)
+}
+
+func (a *Api) CreateEmployee(ctx echo.Context) error {
+	const op = "Api.CreateEmployee"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.CreateEmployee{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	// no sl.Req here, request contains password
+	log.Info("create employee", slog.String("username", req.Username), slog.String("organizationId", req.OrganizationId))
+
+	var employee model.EmployeeResponse
+	employee, err = a.serviceEmployeeEditor.CreateEmployee(ctx.Request().Context(), req.Username, req.FirstName, req.LastName, req.Password, req.OrganizationId, req.Role)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, employee)
+}
+
+func (a *Api) EditEmployee(ctx echo.Context) error {
+	const op = "Api.EditEmployee"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.EditEmployee{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var employee model.EmployeeResponse
+	employee, err = a.serviceEmployeeEditor.EditEmployee(ctx.Request().Context(), req.EmployeeId, req.FirstName, req.LastName)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, employee)
+}
+
+func (a *Api) DeleteEmployee(ctx echo.Context) error {
+	const op = "Api.DeleteEmployee"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.DeleteEmployee{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	err = a.serviceEmployeeEditor.DeleteEmployee(ctx.Request().Context(), req.EmployeeId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"zadanie-6105/internal/lib/etag"
+)
+
+// ifMatch returns version client expects to modify, 0 means any version
+func ifMatch(header string) (int32, error) {
+	version, err := etag.Parse(header)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	return version, nil
+}
+
+func setETag(ctx echo.Context, version int) {
+	ctx.Response().Header().Set("ETag", etag.Format(version))
+}
@@ -0,0 +1,319 @@
+package api
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+type ServiceOrganizationProvider interface {
+	Organizations(
+		ctx context.Context,
+		page model.PageQuery,
+	) (model.Page[model.OrganizationResponse], error)
+	Organization(
+		ctx context.Context,
+		organizationId string,
+	) (model.OrganizationResponse, error)
+	Responsibles(
+		ctx context.Context,
+		organizationId string,
+	) ([]model.MemberResponse, error)
+	MyOrganizations(
+		ctx context.Context,
+	) ([]model.MembershipResponse, error)
+}
+type ServiceOrganizationEditor interface {
+	CreateOrganization(
+		ctx context.Context,
+		name string,
+		description string,
+		organizationType string,
+	) (model.OrganizationResponse, error)
+	EditOrganization(
+		ctx context.Context,
+		organizationId string,
+		name string,
+		description string,
+		organizationType string,
+	) (model.OrganizationResponse, error)
+	DeleteOrganization(
+		ctx context.Context,
+		organizationId string,
+	) error
+	AddResponsible(
+		ctx context.Context,
+		organizationId string,
+		username string,
+		role string,
+	) ([]model.MemberResponse, error)
+	SetRole(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+		role string,
+	) ([]model.MemberResponse, error)
+	RemoveResponsible(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+	) ([]model.MemberResponse, error)
+}
+
+func (a *Api) Organizations(ctx echo.Context) error {
+	const op = "Api.Organizations"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.GetOrganizations{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	organizations, err := a.serviceOrganizationProvider.Organizations(ctx.Request().Context(), page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, organizations.NextCursor, organizations.Total)
+	return ctx.JSON(http.StatusOK, organizations.Items)
+}
+
+func (a *Api) MyOrganizations(ctx echo.Context) error {
+	organizations, err := a.serviceOrganizationProvider.MyOrganizations(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, organizations)
+}
+
+func (a *Api) Organization(ctx echo.Context) error {
+	const op = "Api.Organization"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.GetOrganization{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var organization model.OrganizationResponse
+	organization, err = a.serviceOrganizationProvider.Organization(ctx.Request().Context(), req.OrganizationId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, organization)
+}
+
+func (a *Api) CreateOrganization(ctx echo.Context) error {
+	const op = "Api.CreateOrganization"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.CreateOrganization{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var organization model.OrganizationResponse
+	organization, err = a.serviceOrganizationEditor.CreateOrganization(ctx.Request().Context(), req.Name, req.Description, req.Type)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, organization)
+}
+
+func (a *Api) EditOrganization(ctx echo.Context) error {
+	const op = "Api.EditOrganization"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.EditOrganization{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var organization model.OrganizationResponse
+	organization, err = a.serviceOrganizationEditor.EditOrganization(ctx.Request().Context(), req.OrganizationId, req.Name, req.Description, req.Type)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, organization)
+}
+
+func (a *Api) DeleteOrganization(ctx echo.Context) error {
+	const op = "Api.DeleteOrganization"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.DeleteOrganization{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	err = a.serviceOrganizationEditor.DeleteOrganization(ctx.Request().Context(), req.OrganizationId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (a *Api) Responsibles(ctx echo.Context) error {
+	const op = "Api.Responsibles"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.OrganizationResponsibles{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var responsibles []model.MemberResponse
+	responsibles, err = a.serviceOrganizationProvider.Responsibles(ctx.Request().Context(), req.OrganizationId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, responsibles)
+}
+
+func (a *Api) AddResponsible(ctx echo.Context) error {
+	const op = "Api.AddResponsible"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.AddResponsible{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var responsibles []model.MemberResponse
+	responsibles, err = a.serviceOrganizationEditor.AddResponsible(ctx.Request().Context(), req.OrganizationId, req.Username, req.Role)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, responsibles)
+}
+
+func (a *Api) SetRole(ctx echo.Context) error {
+	const op = "Api.SetRole"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.SetRole{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var responsibles []model.MemberResponse
+	responsibles, err = a.serviceOrganizationEditor.SetRole(ctx.Request().Context(), req.OrganizationId, req.UserId, req.Role)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, responsibles)
+}
+
+func (a *Api) RemoveResponsible(ctx echo.Context) error {
+	const op = "Api.RemoveResponsible"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.RemoveResponsible{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var responsibles []model.MemberResponse
+	responsibles, err = a.serviceOrganizationEditor.RemoveResponsible(ctx.Request().Context(), req.OrganizationId, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, responsibles)
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"github.com/labstack/echo/v4"
+	"strconv"
+	"zadanie-6105/internal/domain/model"
+)
+
+// lists keep returning plain arrays, pagination state goes in headers
+const (
+	headerNextCursor = "X-Next-Cursor"
+	headerTotalCount = "X-Total-Count"
+)
+
+func pageQuery(limit int32, offset int32, cursor string, sort string, order string) model.PageQuery {
+	return model.PageQuery{
+		Limit:  limit,
+		Offset: offset,
+		Cursor: cursor,
+		Sort:   sort,
+		Desc:   order == "desc",
+	}
+}
+
+// setPageHeaders must be called before response is written, no next cursor means last page
+func setPageHeaders(ctx echo.Context, nextCursor string, total int) {
+	if nextCursor != "" {
+		ctx.Response().Header().Set(headerNextCursor, nextCursor)
+	}
+	ctx.Response().Header().Set(headerTotalCount, strconv.Itoa(total))
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+func (a *Api) SearchTenders(ctx echo.Context) error {
+	const op = "Api.SearchTenders"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.SearchTenders{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	filter := model.SearchFilter{
+		Query:          req.Query,
+		Statuses:       req.Status,
+		OrganizationId: req.OrganizationId,
+		ServiceTypes:   req.ServiceType,
+		CreatedFrom:    req.CreatedFrom,
+		CreatedTo:      req.CreatedTo,
+	}
+
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, model.SortRank, "desc")
+	tenders, err := a.serviceTenderProvider.SearchTenders(ctx.Request().Context(), filter, page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, tenders.NextCursor, tenders.Total)
+	return ctx.JSON(http.StatusOK, tenders.Items)
+}
+
+func (a *Api) SearchBids(ctx echo.Context) error {
+	const op = "Api.SearchBids"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.SearchBids{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	filter := model.SearchFilter{
+		Query:          req.Query,
+		Statuses:       req.Status,
+		OrganizationId: req.OrganizationId,
+		ServiceTypes:   req.ServiceType,
+		CreatedFrom:    req.CreatedFrom,
+		CreatedTo:      req.CreatedTo,
+	}
+
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, model.SortRank, "desc")
+	bids, err := a.serviceBidProvider.SearchBids(ctx.Request().Context(), filter, page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, bids.NextCursor, bids.Total)
+	return ctx.JSON(http.StatusOK, bids.Items)
+}
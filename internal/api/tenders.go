@@ -1,12 +1,15 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
 	"zadanie-6105/internal/domain/request"
 	"zadanie-6105/internal/lib/binder"
 	sl "zadanie-6105/internal/lib/logger/slog"
@@ -15,46 +18,73 @@ import (
 
 type ServiceTenderProvider interface {
 	Tenders(
-		limit int32,
-		offset int32,
+		ctx context.Context,
+		page model.PageQuery,
 		serviceType []string,
-	) ([]model.TenderResponse, error)
+	) (model.Page[model.TenderResponse], error)
 	GetTenderByUser(
-		limit int32,
-		offset int32,
-		username string,
-	) ([]model.TenderResponse, error)
+		ctx context.Context,
+		page model.PageQuery,
+	) (model.Page[model.TenderResponse], error)
 	TenderStatus(
+		ctx context.Context,
 		tenderId string,
-		username string,
 	) (string, error)
+	TenderVersions(
+		ctx context.Context,
+		tenderId string,
+	) ([]model.TenderResponse, error)
+	TenderVersion(
+		ctx context.Context,
+		tenderId string,
+		version int32,
+	) (model.TenderResponse, error)
+	TenderVersionsDiff(
+		ctx context.Context,
+		tenderId string,
+		from int32,
+		to int32,
+	) (model.VersionDiff, error)
+	SearchTenders(
+		ctx context.Context,
+		filter model.SearchFilter,
+		page model.PageQuery,
+	) (model.Page[model.TenderSearchResponse], error)
 }
 type ServiceTenderCreator interface {
 	CreateTender(
+		ctx context.Context,
 		name string,
 		description string,
 		serviceType string,
 		organizationId string,
-		creatorUsername string,
+		submissionDeadline *time.Time,
+		decisionDeadline *time.Time,
+		decisionPolicy quorum.Policy,
 	) (model.TenderResponse, error)
 }
 type ServiceTenderEditor interface {
 	ChangeTenderStatus(
+		ctx context.Context,
 		tenderId string,
 		status string,
-		username string,
+		expectedVersion int32,
 	) (model.TenderResponse, error)
 	EditTender(
+		ctx context.Context,
 		tenderId string,
-		username string,
 		name string,
 		description string,
 		serviceType string,
+		submissionDeadline *time.Time,
+		decisionDeadline *time.Time,
+		expectedVersion int32,
 	) (model.TenderResponse, error)
 	RollbackTender(
+		ctx context.Context,
 		tenderId string,
 		version int32,
-		username string,
+		expectedVersion int32,
 	) (model.TenderResponse, error)
 }
 
@@ -80,14 +110,16 @@ func (a *Api) Tenders(ctx echo.Context) error {
 	log.Info(sl.Req(req))
 
 	fmt.Println(req.ServiceType)
-	var tenders []model.TenderResponse
-	tenders, err = a.serviceTenderProvider.Tenders(req.Limit, req.Offset, req.ServiceType)
+	var tenders model.Page[model.TenderResponse]
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	tenders, err = a.serviceTenderProvider.Tenders(ctx.Request().Context(), page, req.ServiceType)
 
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, tenders)
+	setPageHeaders(ctx, tenders.NextCursor, tenders.Total)
+	return ctx.JSON(http.StatusOK, tenders.Items)
 }
 
 func (a *Api) CreateTender(ctx echo.Context) error {
@@ -108,12 +140,22 @@ func (a *Api) CreateTender(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	var decisionPolicy quorum.Policy
+	if req.DecisionPolicy != nil {
+		decisionPolicy = quorum.Policy{
+			Kind:      quorum.Kind(req.DecisionPolicy.Kind),
+			Required:  req.DecisionPolicy.Required,
+			Approvers: req.DecisionPolicy.Approvers,
+		}
+	}
+
 	var tender model.TenderResponse
-	tender, err = a.serviceTenderCreator.CreateTender(req.Name, req.Description, req.ServiceType, req.OrganizationId, req.CreatorUsername)
+	tender, err = a.serviceTenderCreator.CreateTender(ctx.Request().Context(), req.Name, req.Description, req.ServiceType, req.OrganizationId, req.SubmissionDeadline, req.DecisionDeadline, decisionPolicy)
 
 	if err != nil {
 		return err
 	}
+	setETag(ctx, tender.Version)
 
 	return ctx.JSON(http.StatusOK, tender)
 }
@@ -136,14 +178,16 @@ func (a *Api) GetTenderByUser(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
-	var tenders []model.TenderResponse
-	tenders, err = a.serviceTenderProvider.GetTenderByUser(req.Limit, req.Offset, req.Username)
+	var tenders model.Page[model.TenderResponse]
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, req.Sort, req.Order)
+	tenders, err = a.serviceTenderProvider.GetTenderByUser(ctx.Request().Context(), page)
 
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, tenders)
+	setPageHeaders(ctx, tenders.NextCursor, tenders.Total)
+	return ctx.JSON(http.StatusOK, tenders.Items)
 }
 
 func (a *Api) TenderStatus(ctx echo.Context) error {
@@ -164,7 +208,7 @@ func (a *Api) TenderStatus(ctx echo.Context) error {
 	log.Info(sl.Req(req))
 
 	var status string
-	status, err = a.serviceTenderProvider.TenderStatus(req.TenderId, req.Username)
+	status, err = a.serviceTenderProvider.TenderStatus(ctx.Request().Context(), req.TenderId)
 
 	if err != nil {
 		return err
@@ -173,6 +217,31 @@ func (a *Api) TenderStatus(ctx echo.Context) error {
 	return ctx.String(http.StatusOK, status)
 }
 
+func (a *Api) TenderTally(ctx echo.Context) error {
+	const op = "Api.TenderTally"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderTally{}
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	tally, err := a.serviceBidDecisionMaker.TenderTally(ctx.Request().Context(), req.TenderId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, tally)
+}
+
 func (a *Api) ChangeTenderStatus(ctx echo.Context) error {
 	const op = "Api.ChangeTenderStatus"
 	log := a.log.With(
@@ -190,12 +259,18 @@ func (a *Api) ChangeTenderStatus(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var tender model.TenderResponse
-	tender, err = a.serviceTenderEditor.ChangeTenderStatus(req.TenderId, req.Status, req.Username)
+	tender, err = a.serviceTenderEditor.ChangeTenderStatus(ctx.Request().Context(), req.TenderId, req.Status, expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, tender.Version)
 	return ctx.JSON(http.StatusOK, tender)
 }
 
@@ -222,12 +297,18 @@ func (a *Api) EditTender(ctx echo.Context) error {
 		return err
 	}
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var tender model.TenderResponse
-	tender, err = a.serviceTenderEditor.EditTender(req.TenderId, req.Username, req.Name, req.Description, req.ServiceType)
+	tender, err = a.serviceTenderEditor.EditTender(ctx.Request().Context(), req.TenderId, req.Name, req.Description, req.ServiceType, req.SubmissionDeadline, req.DecisionDeadline, expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, tender.Version)
 	return ctx.JSON(http.StatusOK, tender)
 }
 
@@ -249,11 +330,17 @@ func (a *Api) RollbackTender(ctx echo.Context) error {
 	}
 	log.Info(sl.Req(req))
 
+	expectedVersion, err := ifMatch(req.IfMatch)
+	if err != nil {
+		return err
+	}
+
 	var tender model.TenderResponse
-	tender, err = a.serviceTenderEditor.RollbackTender(req.TenderId, req.Version, req.Username)
+	tender, err = a.serviceTenderEditor.RollbackTender(ctx.Request().Context(), req.TenderId, req.Version, expectedVersion)
 	if err != nil {
 		return err
 	}
 
+	setETag(ctx, tender.Version)
 	return ctx.JSON(http.StatusOK, tender)
 }
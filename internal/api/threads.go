@@ -0,0 +1,89 @@
+package api
+
+import (
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+func (a *Api) Thread(ctx echo.Context) error {
+	const op = "Api.Thread"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.Thread{}
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, model.SortCreatedAt, req.Order)
+	messages, err := a.serviceBidFeedbacker.Thread(ctx.Request().Context(), req.BidId, page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, messages.NextCursor, messages.Total)
+	return ctx.JSON(http.StatusOK, messages.Items)
+}
+
+func (a *Api) PostMessage(ctx echo.Context) error {
+	const op = "Api.PostMessage"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.PostMessage{}
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	message, err := a.serviceBidFeedbacker.PostMessage(ctx.Request().Context(), req.BidId, req.Text)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, message)
+}
+
+func (a *Api) MarkThreadRead(ctx echo.Context) error {
+	const op = "Api.MarkThreadRead"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.MarkThreadRead{}
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	err = a.serviceBidFeedbacker.MarkThreadRead(ctx.Request().Context(), req.BidId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+func (a *Api) TenderVersions(ctx echo.Context) error {
+	const op = "Api.TenderVersions"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderVersions{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var versions []model.TenderResponse
+	versions, err = a.serviceTenderProvider.TenderVersions(ctx.Request().Context(), req.TenderId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, versions)
+}
+
+func (a *Api) TenderVersion(ctx echo.Context) error {
+	const op = "Api.TenderVersion"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderVersion{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var tender model.TenderResponse
+	tender, err = a.serviceTenderProvider.TenderVersion(ctx.Request().Context(), req.TenderId, req.Version)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, tender)
+}
+
+func (a *Api) TenderVersionsDiff(ctx echo.Context) error {
+	const op = "Api.TenderVersionsDiff"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.TenderVersionsDiff{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var diff model.VersionDiff
+	diff, err = a.serviceTenderProvider.TenderVersionsDiff(ctx.Request().Context(), req.TenderId, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, diff)
+}
+
+func (a *Api) BidVersions(ctx echo.Context) error {
+	const op = "Api.BidVersions"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.BidVersions{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var versions []model.BidVersionResponse
+	versions, err = a.serviceBidProvider.BidVersions(ctx.Request().Context(), req.BidId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, versions)
+}
+
+func (a *Api) BidVersion(ctx echo.Context) error {
+	const op = "Api.BidVersion"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.BidVersion{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var bid model.BidVersionResponse
+	bid, err = a.serviceBidProvider.BidVersion(ctx.Request().Context(), req.BidId, req.Version)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, bid)
+}
+
+func (a *Api) BidVersionsDiff(ctx echo.Context) error {
+	const op = "Api.BidVersionsDiff"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.BidVersionsDiff{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var diff model.VersionDiff
+	diff, err = a.serviceBidProvider.BidVersionsDiff(ctx.Request().Context(), req.BidId, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, diff)
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"log/slog"
+	"net/http"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+	"zadanie-6105/internal/lib/binder"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/validator"
+)
+
+type ServiceWebhooks interface {
+	CreateWebhook(
+		ctx context.Context,
+		organizationId string,
+		url string,
+		events []string,
+	) (model.WebhookResponse, error)
+	Webhooks(
+		ctx context.Context,
+		organizationId string,
+	) ([]model.WebhookResponse, error)
+	DeleteWebhook(
+		ctx context.Context,
+		organizationId string,
+		webhookId string,
+	) error
+	WebhookDeliveries(
+		ctx context.Context,
+		organizationId string,
+		webhookId string,
+		page model.PageQuery,
+	) (model.Page[model.WebhookDeliveryResponse], error)
+}
+
+func (a *Api) CreateWebhook(ctx echo.Context) error {
+	const op = "Api.CreateWebhook"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.CreateWebhook{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var webhook model.WebhookResponse
+	webhook, err = a.serviceWebhooks.CreateWebhook(ctx.Request().Context(), req.OrganizationId, req.Url, req.Events)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (a *Api) Webhooks(ctx echo.Context) error {
+	const op = "Api.Webhooks"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.OrganizationWebhooks{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	var webhooks []model.WebhookResponse
+	webhooks, err = a.serviceWebhooks.Webhooks(ctx.Request().Context(), req.OrganizationId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, webhooks)
+}
+
+func (a *Api) DeleteWebhook(ctx echo.Context) error {
+	const op = "Api.DeleteWebhook"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.DeleteWebhook{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	err = a.serviceWebhooks.DeleteWebhook(ctx.Request().Context(), req.OrganizationId, req.WebhookId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (a *Api) WebhookDeliveries(ctx echo.Context) error {
+	const op = "Api.WebhookDeliveries"
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	req := request.WebhookDeliveries{}
+
+	err := binder.BindReq(log, ctx, &req)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+	log.Info(sl.Req(req))
+
+	// newest first unless asked otherwise
+	if req.Order == "" {
+		req.Order = "desc"
+	}
+	page := pageQuery(req.Limit, req.Offset, req.Cursor, model.SortCreatedAt, req.Order)
+	deliveries, err := a.serviceWebhooks.WebhookDeliveries(ctx.Request().Context(), req.OrganizationId, req.WebhookId, page)
+	if err != nil {
+		return err
+	}
+
+	setPageHeaders(ctx, deliveries.NextCursor, deliveries.Total)
+	return ctx.JSON(http.StatusOK, deliveries.Items)
+}
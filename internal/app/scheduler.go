@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// scheduler runs job right after start and then every interval until stopped,
+// interval of zero or less disables it
+type scheduler struct {
+	log      *slog.Logger
+	interval time.Duration
+	job      func(ctx context.Context) error
+
+	mu      sync.Mutex
+	stopped bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newScheduler(log *slog.Logger, interval time.Duration, job func(ctx context.Context) error) *scheduler {
+	return &scheduler{
+		log:      log,
+		interval: interval,
+		job:      job,
+	}
+}
+
+// Start is a no-op if scheduler is already running or was stopped
+func (s *scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped || s.cancel != nil || s.interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go s.loop(ctx)
+}
+
+func (s *scheduler) loop(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// ticker may fire together with cancellation, ctx is checked before every run
+	for ctx.Err() == nil {
+		if err := s.job(ctx); err != nil && ctx.Err() == nil {
+			s.log.Error("scheduled job failed", slog.String("op", "app.scheduler"), sl.Err(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop cancels running job and waits until it returns or ctx is done
+func (s *scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopped = true
+	cancel, done := s.cancel, s.done
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
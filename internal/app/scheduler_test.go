@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestScheduler(job func(ctx context.Context) error) *scheduler {
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	return newScheduler(log, 10*time.Millisecond, job)
+}
+
+func TestSchedulerRunsPeriodically(t *testing.T) {
+	var runs atomic.Int32
+	s := newTestScheduler(func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+
+	s.Start()
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+	require.NoError(t, s.Stop(context.Background()))
+
+	stoppedAt := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stoppedAt, runs.Load())
+}
+
+func TestSchedulerStopCancelsRunningJob(t *testing.T) {
+	started := make(chan struct{})
+	s := newTestScheduler(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	s.Start()
+	<-started
+	require.NoError(t, s.Stop(context.Background()))
+}
+
+func TestSchedulerStopWaitsNoLongerThanContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	s := newTestScheduler(func(ctx context.Context) error {
+		close(started)
+		// job ignores cancellation
+		<-release
+		return nil
+	})
+
+	s.Start()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.Stop(ctx), context.DeadlineExceeded)
+}
+
+func TestSchedulerStopBeforeStart(t *testing.T) {
+	var runs atomic.Int32
+	s := newTestScheduler(func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+
+	require.NoError(t, s.Stop(context.Background()))
+	s.Start()
+	time.Sleep(30 * time.Millisecond)
+	assert.Zero(t, runs.Load())
+}
+
+// zero interval would panic in ticker, it means scheduler is off
+func TestSchedulerZeroIntervalDisables(t *testing.T) {
+	var runs atomic.Int32
+	s := newScheduler(slog.Default(), 0, func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+
+	s.Start()
+	time.Sleep(30 * time.Millisecond)
+	assert.Zero(t, runs.Load())
+	require.NoError(t, s.Stop(context.Background()))
+}
@@ -7,17 +7,50 @@ import (
 	"github.com/labstack/echo/v4"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 	"zadanie-6105/config"
 	"zadanie-6105/internal/api"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/lib/blob"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/webhook"
+	"zadanie-6105/internal/repo"
+	"zadanie-6105/internal/repo/memory"
 	"zadanie-6105/internal/repo/postgres"
 	"zadanie-6105/internal/service"
 )
 
+// storage is implemented by both postgres and in-memory repos
+type storage interface {
+	service.RepoTenderProvider
+	service.RepoTenderCreator
+	service.RepoTenderEditor
+	service.RepoBidProvider
+	service.RepoBidCreator
+	service.RepoBidEditor
+	service.RepoBidDecisionMaker
+	service.RepoBidFeedbacker
+	service.RepoOrganizationProvider
+	service.RepoOrganizationEditor
+	service.RepoEmployeeProvider
+	service.RepoEmployeeEditor
+	service.RepoAuth
+	service.RepoWebhook
+	service.RepoOutbox
+	service.RepoAttachments
+	service.RepoCategories
+	repo.Checkers
+	repo.Transactor
+}
+
 type App struct {
-	api     *api.Api
-	svc     *service.Service
-	storage *postgres.Storage
-	echo    *echo.Echo
+	api        *api.Api
+	svc        *service.Service
+	storage    storage
+	echo       *echo.Echo
+	scheduler  *scheduler
+	dispatcher *scheduler
 }
 
 func New(log *slog.Logger, cfg *config.Config) *App {
@@ -25,56 +58,214 @@ func New(log *slog.Logger, cfg *config.Config) *App {
 
 	app.echo = echo.New()
 
-	db, err := postgres.ConnectPostgres(cfg)
-	if err != nil {
-		log.Error("failed to connect to PostgresSQL", err)
-	}
-
-	err = postgres.CreateTables(db)
-	if err != nil {
-		log.Error("failed to create table in DB", err)
+	if cfg.ENV == "memory" {
+		// nothing is persisted, good for demos
+		app.storage = memory.New(log)
+	} else {
+		app.storage = mustConnectPostgres(log, cfg)
 	}
 
-	app.storage = postgres.New(log, db)
 	app.svc = service.New(log,
+		service.TokenConfig{
+			Secret:     []byte(cfg.AUTH_SECRET),
+			AccessTTL:  cfg.ACCESS_TOKEN_TTL,
+			RefreshTTL: cfg.REFRESH_TOKEN_TTL,
+			InviteTTL:  cfg.INVITE_TTL,
+		},
+		cfg.REVIEW_CRITERIA,
+		service.AttachmentConfig{
+			MaxSize: cfg.ATTACHMENT_MAX_SIZE,
+			Types:   cfg.ATTACHMENT_TYPES,
+		},
+		cfg.CATALOG_ADMINS,
 		app.storage, app.storage, app.storage,
 		app.storage, app.storage, app.storage, app.storage, app.storage,
+		app.storage, app.storage, app.storage, app.storage,
+		app.storage,
+		app.storage, app.storage, webhook.NewSender(cfg.WEBHOOK_TIMEOUT, cfg.WEBHOOK_ALLOW_PRIVATE),
+		app.storage, mustOpenBlobStore(cfg),
+		app.storage,
+		app.storage,
 		app.storage)
 
+	if cfg.BOOTSTRAP_ADMIN != "" {
+		bootstrapAdmin(log, app.svc, cfg.BOOTSTRAP_ADMIN)
+	}
+
 	app.api = api.New(log,
 		app.svc, app.svc, app.svc,
 		app.svc, app.svc, app.svc, app.svc, app.svc,
+		app.svc, app.svc, app.svc, app.svc,
+		app.svc,
+		app.svc,
+		app.svc,
+		app.svc,
 	)
 
+	// tenders with passed decision deadline are closed in background
+	app.scheduler = newScheduler(log, cfg.TENDER_CLOSE_INTERVAL, func(ctx context.Context) error {
+		_, err := app.svc.CloseExpiredTenders(ctx)
+		return err
+	})
+	// outbox events are sent to organization webhooks in background
+	app.dispatcher = newScheduler(log, cfg.WEBHOOK_DISPATCH_INTERVAL, app.svc.DispatchEvents)
+
 	app.echo.HTTPErrorHandler = customHTTPErrorHandler
+	app.echo.Use(requestTimeout(cfg.REQUEST_TIMEOUT, cfg.TRANSFER_TIMEOUT))
 
 	app.echo.GET("/api/ping", app.api.Ping)
 
+	app.echo.POST("/api/auth/register", app.api.Register)
+	app.echo.POST("/api/auth/token", app.api.IssueToken)
+	app.echo.POST("/api/auth/refresh", app.api.RefreshToken)
+
 	app.echo.GET("/api/tenders", app.api.Tenders)
-	app.echo.POST("/api/tenders/new", app.api.CreateTender)
-	app.echo.GET("/api/tenders/my", app.api.GetTenderByUser)
-	app.echo.GET("/api/tenders/:tenderId/status", app.api.TenderStatus)
-	app.echo.PUT("/api/tenders/:tenderId/status", app.api.ChangeTenderStatus)
-	app.echo.PATCH("/api/tenders/:tenderId/edit", app.api.EditTender)
-	app.echo.PUT("/api/tenders/:tenderId/rollback/:version", app.api.RollbackTender)
-
-	app.echo.POST("/api/bids/new", app.api.CreateBid)
-	app.echo.GET("/api/bids/my", app.api.GetBidsByUser)
-	app.echo.GET("/api/bids/:tenderId/list", app.api.BidsForTender)
-	app.echo.GET("/api/bids/:bidId/status", app.api.BidStatus)
-	app.echo.PUT("/api/bids/:bidId/status", app.api.UpdateBidStatus)
-	app.echo.PATCH("/api/bids/:bidId/edit", app.api.EditBid)
-	app.echo.PUT("/api/bids/:bidId/submit_decision", app.api.SubmitDecision)
-	app.echo.PUT("/api/bids/:bidId/feedback", app.api.Feedback)
-	app.echo.PUT("/api/bids/:bidId/rollback/:version", app.api.RollbackBid)
-	app.echo.GET("/api/bids/:tenderId/reviews", app.api.Reviews)
+	app.echo.GET("/api/categories", app.api.Categories)
+
+	// everything below requires bearer token
+	authorized := app.echo.Group("/api", app.api.Authenticate)
+
+	authorized.POST("/auth/revoke", app.api.RevokeToken)
+
+	authorized.GET("/employees", app.api.Employees)
+	authorized.POST("/employees/new", app.api.CreateEmployee)
+	authorized.GET("/employees/me", app.api.Me)
+	authorized.GET("/employees/:employeeId", app.api.Employee)
+	authorized.PATCH("/employees/:employeeId", app.api.EditEmployee)
+	authorized.DELETE("/employees/:employeeId", app.api.DeleteEmployee)
+	authorized.POST("/employees/:employeeId/invite", app.api.InviteEmployee)
+
+	authorized.GET("/organizations", app.api.Organizations)
+	authorized.POST("/organizations/new", app.api.CreateOrganization)
+	authorized.GET("/organizations/my", app.api.MyOrganizations)
+	authorized.GET("/organizations/:organizationId", app.api.Organization)
+	authorized.PATCH("/organizations/:organizationId", app.api.EditOrganization)
+	authorized.DELETE("/organizations/:organizationId", app.api.DeleteOrganization)
+	authorized.GET("/organizations/:organizationId/responsibles", app.api.Responsibles)
+	authorized.POST("/organizations/:organizationId/responsibles", app.api.AddResponsible)
+	authorized.PUT("/organizations/:organizationId/responsibles/:userId", app.api.SetRole)
+	authorized.DELETE("/organizations/:organizationId/responsibles/:userId", app.api.RemoveResponsible)
+	authorized.GET("/organizations/:organizationId/webhooks", app.api.Webhooks)
+	authorized.POST("/organizations/:organizationId/webhooks", app.api.CreateWebhook)
+	authorized.DELETE("/organizations/:organizationId/webhooks/:webhookId", app.api.DeleteWebhook)
+	authorized.GET("/organizations/:organizationId/webhooks/:webhookId/deliveries", app.api.WebhookDeliveries)
+
+	authorized.POST("/categories", app.api.CreateCategory)
+	authorized.PATCH("/categories/:code", app.api.EditCategory)
+
+	authorized.POST("/tenders/new", app.api.CreateTender)
+	authorized.GET("/tenders/my", app.api.GetTenderByUser)
+	authorized.GET("/tenders/search", app.api.SearchTenders)
+	authorized.GET("/tenders/:tenderId/status", app.api.TenderStatus)
+	authorized.PUT("/tenders/:tenderId/status", app.api.ChangeTenderStatus)
+	authorized.GET("/tenders/:tenderId/tally", app.api.TenderTally)
+	authorized.PATCH("/tenders/:tenderId/edit", app.api.EditTender)
+	authorized.PUT("/tenders/:tenderId/rollback/:version", app.api.RollbackTender)
+	authorized.GET("/tenders/:tenderId/versions", app.api.TenderVersions)
+	authorized.GET("/tenders/:tenderId/versions/diff", app.api.TenderVersionsDiff)
+	authorized.GET("/tenders/:tenderId/versions/:version", app.api.TenderVersion)
+	authorized.GET("/tenders/:tenderId/attachments", app.api.TenderAttachments)
+	authorized.POST("/tenders/:tenderId/attachments", app.api.UploadTenderAttachment, uploadLimit(cfg.ATTACHMENT_MAX_SIZE))
+	authorized.GET("/tenders/:tenderId/attachments/:attachmentId", app.api.DownloadTenderAttachment)
+	authorized.DELETE("/tenders/:tenderId/attachments/:attachmentId", app.api.DeleteTenderAttachment)
+
+	authorized.POST("/bids/new", app.api.CreateBid)
+	authorized.GET("/bids/my", app.api.GetBidsByUser)
+	authorized.GET("/bids/search", app.api.SearchBids)
+	authorized.GET("/bids/:tenderId/list", app.api.BidsForTender)
+	authorized.GET("/bids/:bidId/status", app.api.BidStatus)
+	authorized.PUT("/bids/:bidId/status", app.api.UpdateBidStatus)
+	authorized.PATCH("/bids/:bidId/edit", app.api.EditBid)
+	authorized.PUT("/bids/:bidId/submit_decision", app.api.SubmitDecision)
+	authorized.GET("/bids/:bidId/decisions", app.api.Decisions)
+	authorized.PUT("/bids/:bidId/feedback", app.api.Feedback)
+	authorized.GET("/bids/:bidId/thread", app.api.Thread)
+	authorized.POST("/bids/:bidId/thread", app.api.PostMessage)
+	authorized.PUT("/bids/:bidId/thread/read", app.api.MarkThreadRead)
+	authorized.PUT("/bids/:bidId/rollback/:version", app.api.RollbackBid)
+	authorized.GET("/bids/:bidId/versions", app.api.BidVersions)
+	authorized.GET("/bids/:bidId/versions/diff", app.api.BidVersionsDiff)
+	authorized.GET("/bids/:bidId/versions/:version", app.api.BidVersion)
+	authorized.GET("/bids/:bidId/attachments", app.api.BidAttachments)
+	authorized.POST("/bids/:bidId/attachments", app.api.UploadBidAttachment, uploadLimit(cfg.ATTACHMENT_MAX_SIZE))
+	authorized.GET("/bids/:bidId/attachments/:attachmentId", app.api.DownloadBidAttachment)
+	authorized.DELETE("/bids/:bidId/attachments/:attachmentId", app.api.DeleteBidAttachment)
+	authorized.GET("/bids/:tenderId/reviews", app.api.Reviews)
+	authorized.GET("/bids/:tenderId/reputation", app.api.Reputation)
 
 	return app
 }
 
+// bootstrapAdmin invites the first admin until the password is set, log is the only place where invite is shown
+func bootstrapAdmin(log *slog.Logger, svc *service.Service, username string) {
+	invite, err := svc.BootstrapInvite(context.Background(), username)
+	if errs.KindOf(err) == errs.KindConflict {
+		log.Info("bootstrap admin is already registered", slog.String("username", username))
+		return
+	}
+	if err != nil {
+		log.Error("failed to invite bootstrap admin", sl.Err(err))
+		return
+	}
+	log.Warn("bootstrap admin is invited, set password with POST /api/auth/register",
+		slog.String("username", username),
+		slog.String("inviteToken", invite.InviteToken))
+}
+
+func mustConnectPostgres(log *slog.Logger, cfg *config.Config) *postgres.Storage {
+	db, err := postgres.ConnectPostgres(cfg)
+	if err != nil {
+		log.Error("failed to connect to PostgresSQL", sl.Err(err))
+	}
+
+	migrator, err := postgres.NewMigrator(log, db)
+	if err != nil {
+		panic("failed to load migrations: " + err.Error())
+	}
+	if cfg.AUTO_MIGRATE {
+		err = migrator.Up()
+	} else {
+		err = migrator.Check()
+	}
+	// unknown schema version means the database belongs to another build, better not touch it
+	if err != nil {
+		panic("database schema is not compatible: " + err.Error())
+	}
+
+	return postgres.New(log, db)
+}
+
+// mustOpenBlobStore picks where attachments are kept, S3 is not contacted until first upload
+func mustOpenBlobStore(cfg *config.Config) service.BlobStore {
+	switch cfg.BLOB_STORE {
+	case "", "fs":
+		store, err := blob.NewFS(cfg.BLOB_DIR)
+		if err != nil {
+			panic("failed to open blob directory: " + err.Error())
+		}
+		return store
+	case "s3":
+		store, err := blob.NewS3(blob.S3Config{
+			Endpoint:  cfg.S3_ENDPOINT,
+			Region:    cfg.S3_REGION,
+			Bucket:    cfg.S3_BUCKET,
+			AccessKey: cfg.S3_ACCESS_KEY,
+			SecretKey: cfg.S3_SECRET_KEY,
+		}, cfg.REQUEST_TIMEOUT)
+		if err != nil {
+			panic("failed to configure s3: " + err.Error())
+		}
+		return store
+	}
+	panic("unknown blob store: " + cfg.BLOB_STORE)
+}
+
 func (a *App) Run() error {
 	fmt.Println("server running")
 
+	a.scheduler.Start()
+	a.dispatcher.Start()
+
 	err := a.echo.Start(":8080")
 	if err != nil {
 		return err
@@ -92,6 +283,15 @@ func (a *App) MustRun() {
 func (a *App) Stop(ctx context.Context) error {
 	fmt.Println("stopping server..." + " op = app.Stop")
 
+	if err := a.scheduler.Stop(ctx); err != nil {
+		fmt.Println("failed to stop scheduler")
+		return err
+	}
+	if err := a.dispatcher.Stop(ctx); err != nil {
+		fmt.Println("failed to stop webhook dispatcher")
+		return err
+	}
+
 	if err := a.echo.Shutdown(ctx); err != nil {
 		fmt.Println("failed to shutdown server")
 		return err
@@ -99,14 +299,92 @@ func (a *App) Stop(ctx context.Context) error {
 	return nil
 }
 
-// Custom error handler to change "message" to "reason".
+// transferRoutes stream attachment bodies, so they get transfer timeout instead of request timeout
+var transferRoutes = map[string]bool{
+	http.MethodPost + " /api/tenders/:tenderId/attachments":              true,
+	http.MethodGet + " /api/tenders/:tenderId/attachments/:attachmentId": true,
+	http.MethodPost + " /api/bids/:bidId/attachments":                    true,
+	http.MethodGet + " /api/bids/:bidId/attachments/:attachmentId":       true,
+}
+
+// multipartOverhead leaves room for boundary and part headers around uploaded file
+const multipartOverhead = 64 << 10
+
+// uploadLimit cuts request body before multipart form is parsed, otherwise whole body would be
+// read into memory and temp files before service sees the size of file
+func uploadLimit(maxSize int64) echo.MiddlewareFunc {
+	limit := maxSize + multipartOverhead
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().ContentLength > limit {
+				return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("file is larger than %d bytes", maxSize))
+			}
+			c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, limit)
+			return next(c)
+		}
+	}
+}
+
+// requestTimeout bounds every request with deadline, storage queries of the request are canceled when it passes.
+// Zero timeout leaves requests unbounded
+func requestTimeout(timeout time.Duration, transferTimeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timeout := timeout
+			if transferRoutes[c.Request().Method+" "+c.Path()] {
+				timeout = transferTimeout
+			}
+			if timeout <= 0 {
+				return next(c)
+			}
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// statuses maps domain errors to http, it is the only place where they meet
+var statuses = map[errs.Kind]int{
+	errs.KindInternal:        http.StatusInternalServerError,
+	errs.KindValidation:      http.StatusBadRequest,
+	errs.KindUnauthorized:    http.StatusUnauthorized,
+	errs.KindForbidden:       http.StatusForbidden,
+	errs.KindNotFound:        http.StatusNotFound,
+	errs.KindConflict:        http.StatusConflict,
+	errs.KindVersionMismatch: http.StatusPreconditionFailed,
+	errs.KindTimeout:         http.StatusServiceUnavailable,
+}
+
+// Custom error handler to change "message" to "reason" and add machine-readable "code".
+// Domain errors come from service and storage, echo errors from api and echo itself
 func customHTTPErrorHandler(err error, ctx echo.Context) {
 	response := map[string]interface{}{
 		"reason": "Internal Server Error",
+		"code":   errs.KindInternal,
 	}
 	statusCode := http.StatusInternalServerError
 
-	if httpError, ok := err.(*echo.HTTPError); ok {
+	var domainError *errs.Error
+	var httpError *echo.HTTPError
+	var tooLarge *http.MaxBytesError
+	switch {
+	case timedOut(err, ctx):
+		statusCode = statuses[errs.KindTimeout]
+		response["reason"] = "request deadline exceeded"
+		response["code"] = errs.KindTimeout
+	// body cut by uploadLimit, echo reports it as bad request when binding form
+	case errors.As(err, &tooLarge):
+		statusCode = http.StatusRequestEntityTooLarge
+		response["reason"] = fmt.Sprintf("request body is larger than %d bytes", tooLarge.Limit)
+		response["code"] = kindOfStatus(statusCode)
+	case errors.As(err, &domainError):
+		statusCode = statuses[domainError.Kind]
+		response["reason"] = domainError.Error()
+		response["code"] = domainError.Kind
+	case errors.As(err, &httpError):
 		if httpError.Code != 0 {
 			statusCode = httpError.Code
 		}
@@ -119,9 +397,30 @@ func customHTTPErrorHandler(err error, ctx echo.Context) {
 		} else {
 			response["reason"] = httpError.Error()
 		}
-	} else {
+		response["code"] = kindOfStatus(statusCode)
+	default:
 		response["reason"] = err.Error()
 	}
 
 	ctx.JSON(statusCode, response)
 }
+
+// timedOut tells internal failures caused by passed request deadline, the driver does not always
+// return context error itself when query is canceled
+func timedOut(err error, ctx echo.Context) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return errs.KindOf(err) == errs.KindInternal &&
+		errors.Is(ctx.Request().Context().Err(), context.DeadlineExceeded)
+}
+
+// kindOfStatus gives codes to errors made by api layer, unknown statuses are named by status text
+func kindOfStatus(statusCode int) errs.Kind {
+	for kind, status := range statuses {
+		if status == statusCode {
+			return kind
+		}
+	}
+	return errs.Kind(strings.ReplaceAll(strings.ToLower(http.StatusText(statusCode)), " ", "_"))
+}
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+)
+
+func TestCustomHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		body   string
+	}{
+		{
+			name:   "domain error",
+			err:    errs.NotFound(fmt.Errorf("tender not found")),
+			status: http.StatusNotFound,
+			body:   `{"code":"not_found","reason":"tender not found"}`,
+		},
+		{
+			name:   "wrapped domain error",
+			err:    fmt.Errorf("closing: %w", errs.VersionMismatch(fmt.Errorf("tender version mismatch"))),
+			status: http.StatusPreconditionFailed,
+			body:   `{"code":"version_mismatch","reason":"tender version mismatch"}`,
+		},
+		{
+			name:   "echo error",
+			err:    echo.NewHTTPError(http.StatusBadRequest, "bad limit"),
+			status: http.StatusBadRequest,
+			body:   `{"code":"validation","reason":"bad limit"}`,
+		},
+		{
+			name:   "unknown error",
+			err:    fmt.Errorf("boom"),
+			status: http.StatusInternalServerError,
+			body:   `{"code":"internal","reason":"boom"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			customHTTPErrorHandler(tt.err, ctx)
+			assert.Equal(t, tt.status, rec.Code)
+			assert.JSONEq(t, tt.body, rec.Body.String())
+		})
+	}
+}
+
+func TestCustomHTTPErrorHandler_Timeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   "internal error after deadline",
+			err:    errs.Internal(fmt.Errorf("pq: canceling statement due to user request")),
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "domain error after deadline keeps its status",
+			err:    errs.NotFound(fmt.Errorf("tender not found")),
+			status: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil).WithContext(reqCtx), rec)
+
+			customHTTPErrorHandler(tt.err, ctx)
+			assert.Equal(t, tt.status, rec.Code)
+		})
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = customHTTPErrorHandler
+	e.Use(requestTimeout(10*time.Millisecond, time.Hour))
+	e.GET("/slow", func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return errs.Internal(c.Request().Context().Err())
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"code":"timeout","reason":"request deadline exceeded"}`, rec.Body.String())
+}
+
+// downloads stream for long, so they are not cut off by request timeout
+func TestRequestTimeout_Transfer(t *testing.T) {
+	e := echo.New()
+	e.Use(requestTimeout(time.Millisecond, time.Hour))
+	e.GET("/api/bids/:bidId/attachments/:attachmentId", func(c echo.Context) error {
+		deadline, ok := c.Request().Context().Deadline()
+		require.True(t, ok)
+		assert.Greater(t, time.Until(deadline), time.Minute)
+		return c.NoContent(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/bids/1/attachments/2", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+// uploads larger than attachment may be are cut before form is parsed, with and without Content-Length
+func TestUploadLimit(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = customHTTPErrorHandler
+	e.POST("/upload", func(c echo.Context) error {
+		var req struct {
+			Name string `form:"name"`
+		}
+		if err := c.Bind(&req); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusCreated)
+	}, uploadLimit(1024))
+
+	body := "--x\r\nContent-Disposition: form-data; name=\"file\"; filename=\"a.txt\"\r\n\r\n" +
+		strings.Repeat("a", multipartOverhead+2048) + "\r\n--x--\r\n"
+	for name, reader := range map[string]io.Reader{
+		"known length":   strings.NewReader(body),
+		"unknown length": io.MultiReader(strings.NewReader(body)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/upload", reader)
+			req.Header.Set(echo.HeaderContentType, "multipart/form-data; boundary=x")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package errs
+
+import "errors"
+
+// Kind is a machine-readable class of error, transports map it to their own status codes
+type Kind string
+
+const (
+	KindInternal        Kind = "internal"
+	KindValidation      Kind = "validation"
+	KindUnauthorized    Kind = "unauthorized"
+	KindForbidden       Kind = "forbidden"
+	KindNotFound        Kind = "not_found"
+	KindConflict        Kind = "conflict"
+	KindVersionMismatch Kind = "version_mismatch"
+	// KindTimeout is not returned by storage, transports give it to failures after request deadline
+	KindTimeout Kind = "timeout"
+)
+
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func Internal(err error) error {
+	return &Error{Kind: KindInternal, Err: err}
+}
+
+func Validation(err error) error {
+	return &Error{Kind: KindValidation, Err: err}
+}
+
+func Unauthorized(err error) error {
+	return &Error{Kind: KindUnauthorized, Err: err}
+}
+
+func Forbidden(err error) error {
+	return &Error{Kind: KindForbidden, Err: err}
+}
+
+func NotFound(err error) error {
+	return &Error{Kind: KindNotFound, Err: err}
+}
+
+func Conflict(err error) error {
+	return &Error{Kind: KindConflict, Err: err}
+}
+
+// VersionMismatch is returned when resource was changed since the version client expects
+func VersionMismatch(err error) error {
+	return &Error{Kind: KindVersionMismatch, Err: err}
+}
+
+// KindOf returns kind of the outermost domain error in chain, errors of unknown origin are internal
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
@@ -0,0 +1,31 @@
+package model
+
+// owners of attachments, they are versioned together with their owner
+const (
+	OwnerTender = "Tender"
+	OwnerBid    = "Bid"
+)
+
+// AttachmentDB is metadata of uploaded file, content lives in blob store under BlobKey
+type AttachmentDB struct {
+	Id          string `db:"id"`
+	OwnerType   string `db:"owner_type"`
+	OwnerId     string `db:"owner_id"`
+	Name        string `db:"name"`
+	ContentType string `db:"content_type"`
+	Size        int64  `db:"size"`
+	// Checksum is hex SHA-256 of content
+	Checksum   string `db:"checksum"`
+	BlobKey    string `db:"blob_key"`
+	UploadedBy string `db:"uploaded_by"`
+	CreatedAt  string `db:"created_at"`
+}
+
+type AttachmentResponse struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"sha256"`
+	CreatedAt   string `json:"createdAt"`
+}
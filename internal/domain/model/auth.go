@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// Caller is an employee resolved from access token.
+type Caller struct {
+	Id        string
+	Username  string
+	SessionId string
+}
+
+type CredentialsDB struct {
+	EmployeeId   string `db:"employee_id"`
+	Username     string `db:"username"`
+	PasswordHash string `db:"password_hash"`
+}
+
+type SessionDB struct {
+	Id         string     `db:"id"`
+	EmployeeId string     `db:"employee_id"`
+	Username   string     `db:"username"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int64  `json:"expiresIn"`
+}
+
+// InviteResponse is given once, only hash of token is stored
+type InviteResponse struct {
+	EmployeeId  string `json:"employeeId"`
+	InviteToken string `json:"inviteToken"`
+	ExpiresIn   int64  `json:"expiresIn"`
+}
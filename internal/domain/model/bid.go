@@ -14,8 +14,19 @@ type BidDB struct {
 	CreatedAt  string `db:"createdat"`
 
 	Feedback []Feedback
+	Offer    *Offer `db:"-"`
+
+	// Attachments are ids of attached files in this version
+	Attachments []string `db:"-"`
 }
 
+// authors of bids listed by GetBidsByUser
+const (
+	BidsPersonal     = "personal"
+	BidsOrganization = "organization"
+	BidsBoth         = "both"
+)
+
 type BidResponse struct {
 	Id         string `json:"id"`
 	Name       string `json:"name"`
@@ -24,4 +35,27 @@ type BidResponse struct {
 	AuthorId   string `json:"authorId"`
 	Version    int    `json:"version"`
 	CreatedAt  string `json:"createdAt"`
+	Offer      *Offer `json:"offer,omitempty"`
+}
+
+// scales of offer columns, money is NUMERIC(18, 2) and quantity is NUMERIC(18, 3)
+const (
+	MoneyScale    = 2
+	QuantityScale = 3
+)
+
+// Offer is commercial part of the bid, it is stored apart from bid and nil when not given
+type Offer struct {
+	Amount   Decimal `json:"amount" db:"amount"`
+	Currency string  `json:"currency" db:"currency"`
+	// DeliveryDeadline is date in YYYY-MM-DD
+	DeliveryDeadline string      `json:"deliveryDeadline,omitempty" db:"delivery_deadline"`
+	WarrantyMonths   int32       `json:"warrantyMonths,omitempty" db:"warranty_months"`
+	Items            []OfferItem `json:"items,omitempty"`
+}
+
+type OfferItem struct {
+	Name      string  `json:"name" db:"name"`
+	Quantity  Decimal `json:"quantity" db:"quantity"`
+	UnitPrice Decimal `json:"unitPrice" db:"unit_price"`
 }
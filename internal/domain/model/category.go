@@ -0,0 +1,20 @@
+package model
+
+// CategoryDB is a service type of tenders, categories form a tree by ParentCode.
+// Code is stored in tenders and never changes, Name is shown to users
+type CategoryDB struct {
+	Code string `db:"code"`
+	Name string `db:"name"`
+	// ParentCode is empty for root categories
+	ParentCode string `db:"parent_code"`
+	// Deprecated categories stay in tenders, new tenders can not use them
+	Deprecated bool   `db:"deprecated"`
+	CreatedAt  string `db:"created_at"`
+}
+
+type CategoryResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	ParentCode string `json:"parentCode,omitempty"`
+	Deprecated bool   `json:"deprecated"`
+}
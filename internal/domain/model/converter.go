@@ -13,6 +13,7 @@ func ConvertBidToResponse(bidDB BidDB) BidResponse {
 		AuthorType: bidDB.AuthorType,
 		AuthorId:   bidDB.AuthorId,
 		Version:    bidDB.Version,
+		Offer:      bidDB.Offer,
 	}
 
 	timestamp, err := time.Parse(time.RFC3339, bidDB.CreatedAt)
@@ -51,6 +52,12 @@ func ConvertTenderToResponse(tenderDB TenderDB) TenderResponse {
 		return TenderResponse{}
 	}
 	tender.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	if tenderDB.SubmissionDeadline != nil {
+		tender.SubmissionDeadline = tenderDB.SubmissionDeadline.UTC().Format(time.RFC3339)
+	}
+	if tenderDB.DecisionDeadline != nil {
+		tender.DecisionDeadline = tenderDB.DecisionDeadline.UTC().Format(time.RFC3339)
+	}
 	return tender
 }
 
@@ -64,3 +71,291 @@ func ConvertTenders(tendersDB []TenderDB) []TenderResponse {
 	}
 	return tenders
 }
+
+func ConvertOrganizationToResponse(organizationDB OrganizationDB) OrganizationResponse {
+	organization := OrganizationResponse{
+		Id:          organizationDB.Id,
+		Name:        organizationDB.Name,
+		Description: organizationDB.Description,
+		Type:        organizationDB.Type,
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, organizationDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for organization")
+		return OrganizationResponse{}
+	}
+	organization.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	return organization
+}
+
+func ConvertOrganizations(organizationsDB []OrganizationDB) []OrganizationResponse {
+	organizations := make([]OrganizationResponse, len(organizationsDB))
+
+	for i, organizationDB := range organizationsDB {
+		organizations[i] = ConvertOrganizationToResponse(organizationDB)
+	}
+	return organizations
+}
+
+func ConvertMemberships(membershipsDB []MembershipDB) []MembershipResponse {
+	memberships := make([]MembershipResponse, len(membershipsDB))
+
+	for i, membershipDB := range membershipsDB {
+		memberships[i] = MembershipResponse{
+			OrganizationResponse: ConvertOrganizationToResponse(membershipDB.OrganizationDB),
+			Role:                 membershipDB.Role,
+		}
+	}
+	return memberships
+}
+
+func ConvertEmployeeToResponse(employeeDB EmployeeDB) EmployeeResponse {
+	employee := EmployeeResponse{
+		Id:        employeeDB.Id,
+		Username:  employeeDB.Username,
+		FirstName: employeeDB.FirstName,
+		LastName:  employeeDB.LastName,
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, employeeDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for employee")
+		return EmployeeResponse{}
+	}
+	employee.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	return employee
+}
+
+func ConvertEmployees(employeesDB []EmployeeDB) []EmployeeResponse {
+	employees := make([]EmployeeResponse, len(employeesDB))
+
+	for i, employeeDB := range employeesDB {
+		employees[i] = ConvertEmployeeToResponse(employeeDB)
+	}
+	return employees
+}
+
+func ConvertMembers(membersDB []MemberDB) []MemberResponse {
+	members := make([]MemberResponse, len(membersDB))
+
+	for i, memberDB := range membersDB {
+		members[i] = MemberResponse{
+			EmployeeResponse: ConvertEmployeeToResponse(memberDB.EmployeeDB),
+			Role:             memberDB.Role,
+		}
+	}
+	return members
+}
+
+func ConvertWebhookToResponse(webhookDB WebhookDB) WebhookResponse {
+	webhook := WebhookResponse{
+		Id:             webhookDB.Id,
+		OrganizationId: webhookDB.OrganizationId,
+		Url:            webhookDB.Url,
+		Events:         webhookDB.Events,
+	}
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, webhookDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for webhook")
+		return WebhookResponse{}
+	}
+	webhook.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	return webhook
+}
+
+func ConvertWebhooks(webhooksDB []WebhookDB) []WebhookResponse {
+	webhooks := make([]WebhookResponse, len(webhooksDB))
+
+	for i, webhookDB := range webhooksDB {
+		webhooks[i] = ConvertWebhookToResponse(webhookDB)
+	}
+	return webhooks
+}
+
+func ConvertDeliveryToResponse(deliveryDB WebhookDeliveryDB) WebhookDeliveryResponse {
+	delivery := WebhookDeliveryResponse{
+		Id:           deliveryDB.Id,
+		EventId:      deliveryDB.EventId,
+		EventType:    deliveryDB.EventType,
+		Status:       deliveryDB.Status,
+		Attempts:     deliveryDB.Attempts,
+		ResponseCode: deliveryDB.ResponseCode,
+		Error:        deliveryDB.Error,
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, deliveryDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for webhook delivery")
+		return WebhookDeliveryResponse{}
+	}
+	updatedAt, err := time.Parse(time.RFC3339, deliveryDB.UpdatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for webhook delivery")
+		return WebhookDeliveryResponse{}
+	}
+	delivery.CreatedAt = time.Time.Format(createdAt, time.RFC3339)
+	delivery.UpdatedAt = time.Time.Format(updatedAt, time.RFC3339)
+	// next attempt makes sense only while delivery is pending
+	if deliveryDB.Status == DeliveryPending {
+		delivery.NextAttemptAt = deliveryDB.NextAttemptAt.UTC().Format(time.RFC3339)
+	}
+	return delivery
+}
+
+func ConvertDeliveries(deliveriesDB []WebhookDeliveryDB) []WebhookDeliveryResponse {
+	deliveries := make([]WebhookDeliveryResponse, len(deliveriesDB))
+
+	for i, deliveryDB := range deliveriesDB {
+		deliveries[i] = ConvertDeliveryToResponse(deliveryDB)
+	}
+	return deliveries
+}
+
+func ConvertBidVersionToResponse(bidDB BidDB) BidVersionResponse {
+	return BidVersionResponse{
+		BidResponse: ConvertBidToResponse(bidDB),
+		Description: bidDB.Description,
+		Decision:    bidDB.Decision,
+	}
+}
+
+func ConvertBidVersions(bidsDB []BidDB) []BidVersionResponse {
+	bids := make([]BidVersionResponse, len(bidsDB))
+
+	for i, bidDB := range bidsDB {
+		bids[i] = ConvertBidVersionToResponse(bidDB)
+	}
+	return bids
+}
+
+func ConvertTenderSearch(resultsDB []TenderSearchDB) []TenderSearchResponse {
+	results := make([]TenderSearchResponse, len(resultsDB))
+
+	for i, resultDB := range resultsDB {
+		results[i] = TenderSearchResponse{
+			TenderResponse: ConvertTenderToResponse(resultDB.TenderDB),
+			Rank:           resultDB.Rank,
+			Highlights: Highlights{
+				Name:        highlightHTML(resultDB.NameHighlight),
+				Description: highlightHTML(resultDB.DescriptionHighlight),
+			},
+		}
+	}
+	return results
+}
+
+func ConvertBidSearch(resultsDB []BidSearchDB) []BidSearchResponse {
+	results := make([]BidSearchResponse, len(resultsDB))
+
+	for i, resultDB := range resultsDB {
+		results[i] = BidSearchResponse{
+			BidResponse: ConvertBidToResponse(resultDB.BidDB),
+			Rank:        resultDB.Rank,
+			Highlights: Highlights{
+				Name:        highlightHTML(resultDB.NameHighlight),
+				Description: highlightHTML(resultDB.DescriptionHighlight),
+			},
+		}
+	}
+	return results
+}
+
+func ConvertDecisionToResponse(decisionDB DecisionDB) DecisionResponse {
+	decision := DecisionResponse{
+		BidId:            decisionDB.BidId,
+		ApproverId:       decisionDB.ResponsibleId,
+		ApproverUsername: decisionDB.Username,
+		Decision:         decisionDB.Decision,
+		Comment:          decisionDB.Comment,
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, decisionDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for decision")
+		return DecisionResponse{}
+	}
+	decision.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	return decision
+}
+
+func ConvertDecisions(decisionsDB []DecisionDB) []DecisionResponse {
+	decisions := make([]DecisionResponse, len(decisionsDB))
+
+	for i, decisionDB := range decisionsDB {
+		decisions[i] = ConvertDecisionToResponse(decisionDB)
+	}
+	return decisions
+}
+
+func ConvertThreadMessageToResponse(messageDB ThreadMessageDB) ThreadMessageResponse {
+	message := ThreadMessageResponse{
+		Id:             messageDB.Id,
+		BidId:          messageDB.BidId,
+		AuthorUsername: messageDB.AuthorUsername,
+		Side:           messageDB.Side,
+		Text:           messageDB.Text,
+		Mentions:       messageDB.Mentions,
+		Unread:         messageDB.Unread,
+	}
+	if message.Mentions == nil {
+		message.Mentions = []string{}
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, messageDB.CreatedAt)
+	if err != nil {
+		fmt.Println("failed to parse time for thread message")
+		return ThreadMessageResponse{}
+	}
+	message.CreatedAt = time.Time.Format(timestamp, time.RFC3339)
+	return message
+}
+
+func ConvertThreadMessages(messagesDB []ThreadMessageDB) []ThreadMessageResponse {
+	messages := make([]ThreadMessageResponse, len(messagesDB))
+
+	for i, messageDB := range messagesDB {
+		messages[i] = ConvertThreadMessageToResponse(messageDB)
+	}
+	return messages
+}
+
+func ConvertAttachmentToResponse(attachment AttachmentDB) AttachmentResponse {
+	return AttachmentResponse{
+		Id:          attachment.Id,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func ConvertAttachments(attachments []AttachmentDB) []AttachmentResponse {
+	responses := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = ConvertAttachmentToResponse(attachment)
+	}
+	return responses
+}
+
+func ConvertCategoryToResponse(category CategoryDB) CategoryResponse {
+	return CategoryResponse{
+		Code:       category.Code,
+		Name:       category.Name,
+		ParentCode: category.ParentCode,
+		Deprecated: category.Deprecated,
+	}
+}
+
+func ConvertCategories(categories []CategoryDB) []CategoryResponse {
+	responses := make([]CategoryResponse, len(categories))
+	for i, category := range categories {
+		responses[i] = ConvertCategoryToResponse(category)
+	}
+	return responses
+}
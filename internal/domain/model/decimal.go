@@ -0,0 +1,98 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Decimal is exact number such as money amount, it is kept as text, so NUMERIC columns
+// are not rounded through float64. In JSON it is a number, quoted numbers are accepted too
+type Decimal string
+
+var decimalPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return "", fmt.Errorf("%q is not a decimal number", s)
+	}
+	return Decimal(s), nil
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("0"), nil
+	}
+	return []byte(d), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" {
+		return nil
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Fits tells if decimal is kept in NUMERIC(precision, scale) column as is, without rounding or overflow
+func (d Decimal) Fits(precision int, scale int) bool {
+	integer, fraction := d.digits()
+	return len(integer) <= precision-scale && len(fraction) <= scale
+}
+
+// WithScale writes decimal the way NUMERIC column with scale gives it back, with exactly scale digits after point.
+// Decimals which do not fit scale are returned as is
+func (d Decimal) WithScale(scale int) Decimal {
+	integer, fraction := d.digits()
+	if d == "" || len(fraction) > scale {
+		return d
+	}
+	if integer == "" {
+		integer = "0"
+	}
+	sign := ""
+	if strings.HasPrefix(string(d), "-") && (integer != "0" || fraction != "") {
+		sign = "-"
+	}
+	if scale == 0 {
+		return Decimal(sign + integer)
+	}
+	return Decimal(sign + integer + "." + fraction + strings.Repeat("0", scale-len(fraction)))
+}
+
+// digits are integer and fraction parts without sign, leading and trailing zeros, they do not count for NUMERIC
+func (d Decimal) digits() (string, string) {
+	integer, fraction, _ := strings.Cut(strings.TrimPrefix(string(d), "-"), ".")
+	return strings.TrimLeft(integer, "0"), strings.TrimRight(fraction, "0")
+}
+
+// Float64 is for validation and comparisons only, never for storing the value
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(string(d), 64)
+	return f
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func (d *Decimal) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		*d = Decimal(v)
+	case []byte:
+		*d = Decimal(v)
+	case nil:
+		*d = ""
+	default:
+		return fmt.Errorf("cannot scan %T into decimal", src)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package model
+
+// DecisionDB is a vote of responsible on a bid
+type DecisionDB struct {
+	BidId         string `db:"bid_id"`
+	ResponsibleId string `db:"responsible"`
+	Username      string `db:"username"`
+	Decision      string `db:"decision"`
+	Comment       string `db:"comment"`
+	CreatedAt     string `db:"created_at"`
+}
+
+type DecisionResponse struct {
+	BidId            string `json:"bidId"`
+	ApproverId       string `json:"approverId"`
+	ApproverUsername string `json:"approverUsername"`
+	Decision         string `json:"decision"`
+	Comment          string `json:"comment,omitempty"`
+	CreatedAt        string `json:"createdAt"`
+}
@@ -0,0 +1,29 @@
+package model
+
+type EmployeeDB struct {
+	Id        string `db:"id"`
+	Username  string `db:"username"`
+	FirstName string `db:"first_name"`
+	LastName  string `db:"last_name"`
+	CreatedAt string `db:"created_at"`
+	UpdatedAt string `db:"updated_at"`
+}
+
+type EmployeeResponse struct {
+	Id        string `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// MemberDB is employee together with his role in organization
+type MemberDB struct {
+	EmployeeDB
+	Role string `db:"role"`
+}
+
+type MemberResponse struct {
+	EmployeeResponse
+	Role string `json:"role"`
+}
@@ -1,7 +1,94 @@
 package model
 
+import "time"
+
+// sides of feedback thread, reviews are messages of organization
+const (
+	SideOrganization = "Organization"
+	SideBidder       = "Bidder"
+)
+
 type Feedback struct {
 	Id          string `db:"id"`
 	Description string `db:"description"`
 	CreatedAt   string `db:"createdat"`
+	// Ratings are scores from 1 to 5 by criteria, review may rate none of them
+	Ratings map[string]int `db:"-" json:",omitempty"`
+}
+
+// ReviewFilter narrows reviews on bids of one author, zero values mean no filter
+type ReviewFilter struct {
+	// AuthorType is User with username in Author or Organization with its id
+	AuthorType string
+	Author     string
+	// AllTenders widens reviews from the tender to all tenders of its organization
+	AllTenders  bool
+	BidId       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// ReviewScope is resolved by service from ReviewFilter,
+// reviews are on bids of author either in the tender or in tenders of organization
+type ReviewScope struct {
+	AuthorType     string
+	AuthorId       string
+	TenderId       string
+	OrganizationId string
+}
+
+// ThreadMessageDB is a message of feedback thread on bid
+type ThreadMessageDB struct {
+	Id             string
+	BidId          string
+	AuthorId       string
+	AuthorUsername string
+	Side           string
+	Text           string
+	// Mentions are usernames mentioned as @username
+	Mentions  []string
+	CreatedAt string
+	// Ratings are set only on messages of organization
+	Ratings map[string]int
+	// Unread is computed for viewer, own messages are never unread
+	Unread bool
+}
+
+type ThreadMessageResponse struct {
+	Id             string   `json:"id"`
+	BidId          string   `json:"bidId"`
+	AuthorUsername string   `json:"authorUsername"`
+	Side           string   `json:"side"`
+	Text           string   `json:"text"`
+	Mentions       []string `json:"mentions"`
+	CreatedAt      string   `json:"createdAt"`
+	Unread         bool     `json:"unread"`
+}
+
+// ReputationDB is raw statistics of bid author, averages are left to service
+type ReputationDB struct {
+	Won      int `db:"won"`
+	Lost     int `db:"lost"`
+	Reviews  int `db:"reviews"`
+	Criteria []CriterionDB
+}
+
+type CriterionDB struct {
+	Criterion string `db:"criterion"`
+	Count     int    `db:"count"`
+	Sum       int    `db:"sum"`
+}
+
+type Reputation struct {
+	AuthorType     string `json:"authorType"`
+	AuthorId       string `json:"authorId"`
+	AuthorUsername string `json:"authorUsername,omitempty"`
+	Reviews        int    `json:"reviews"`
+	// AverageRating is the mean of all scores, Criteria are means by criterion
+	AverageRating float64            `json:"averageRating"`
+	Criteria      map[string]float64 `json:"criteria"`
+	Won           int                `json:"won"`
+	Lost          int                `json:"lost"`
+	// ApprovalRate is the share of won bids among decided ones
+	ApprovalRate float64 `json:"approvalRate"`
 }
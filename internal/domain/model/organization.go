@@ -0,0 +1,29 @@
+package model
+
+type OrganizationDB struct {
+	Id          string `db:"id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Type        string `db:"type"`
+	CreatedAt   string `db:"created_at"`
+	UpdatedAt   string `db:"updated_at"`
+}
+
+type OrganizationResponse struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// MembershipDB is organization together with role of employee in it
+type MembershipDB struct {
+	OrganizationDB
+	Role string `db:"role"`
+}
+
+type MembershipResponse struct {
+	OrganizationResponse
+	Role string `json:"role"`
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// sort fields of list endpoints, ties are broken by id
+const (
+	SortName      = "name"
+	SortCreatedAt = "created_at"
+	SortVersion   = "version"
+	// SortRank is relevance of search results, they are always listed most relevant first
+	SortRank = "rank"
+)
+
+// PageQuery is either keyset page after cursor or legacy offset page.
+// After is decoded from Cursor by service, repos look only at After
+type PageQuery struct {
+	Limit  int32
+	Offset int32
+	Sort   string
+	Desc   bool
+	Cursor string
+	After  *Cursor
+}
+
+// Cursor points to the last item of previous page
+type Cursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d"`
+	Value string `json:"v"`
+	Id    string `json:"i"`
+}
+
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	Total      int
+}
+
+// SortKey returns value of sort field and id, the order of items in lists
+func (t TenderDB) SortKey(sort string) (string, string) {
+	switch sort {
+	case SortCreatedAt:
+		return t.CreatedAt, t.Id
+	case SortVersion:
+		return strconv.Itoa(t.Version), t.Id
+	}
+	return t.Name, t.Id
+}
+
+func (b BidDB) SortKey(sort string) (string, string) {
+	switch sort {
+	case SortCreatedAt:
+		return b.CreatedAt, b.Id
+	case SortVersion:
+		return strconv.Itoa(b.Version), b.Id
+	}
+	return b.Name, b.Id
+}
+
+// SortKey of feedback is always its creation time
+func (f Feedback) SortKey(_ string) (string, string) {
+	return f.CreatedAt, f.Id
+}
+
+func (m ThreadMessageDB) SortKey(_ string) (string, string) {
+	return m.CreatedAt, m.Id
+}
+
+func (t TenderSearchDB) SortKey(_ string) (string, string) {
+	return strconv.FormatFloat(t.Rank, 'g', -1, 64), t.Id
+}
+
+func (b BidSearchDB) SortKey(_ string) (string, string) {
+	return strconv.FormatFloat(b.Rank, 'g', -1, 64), b.Id
+}
+
+// SortKey of organization by name is its name
+func (o OrganizationDB) SortKey(sort string) (string, string) {
+	if sort == SortCreatedAt {
+		return o.CreatedAt, o.Id
+	}
+	return o.Name, o.Id
+}
+
+// SortKey of employee by name is its username
+func (e EmployeeDB) SortKey(sort string) (string, string) {
+	if sort == SortCreatedAt {
+		return e.CreatedAt, e.Id
+	}
+	return e.Username, e.Id
+}
+
+// SortKey of delivery is its creation time in UTC, created_at of deliveries has time zone
+func (d WebhookDeliveryDB) SortKey(_ string) (string, string) {
+	createdAt, err := time.Parse(time.RFC3339Nano, d.CreatedAt)
+	if err != nil {
+		return d.CreatedAt, d.Id
+	}
+	return createdAt.UTC().Format(time.RFC3339Nano), d.Id
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"html"
+	"strings"
+	"time"
+)
+
+// SearchFilter narrows full-text search, zero values mean no filter
+type SearchFilter struct {
+	Query          string
+	Statuses       []string
+	OrganizationId string
+	ServiceTypes   []string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+}
+
+// repos wrap matched words in these markers instead of html, names and descriptions are user text,
+// so they are escaped first and only then markers become <b></b>
+const (
+	HighlightStart = "\uE000"
+	HighlightStop  = "\uE001"
+)
+
+var highlightTags = strings.NewReplacer(HighlightStart, "<b>", HighlightStop, "</b>")
+
+func highlightHTML(text string) string {
+	return highlightTags.Replace(html.EscapeString(text))
+}
+
+type TenderSearchDB struct {
+	TenderDB
+	Rank                 float64 `db:"rank"`
+	NameHighlight        string  `db:"name_highlight"`
+	DescriptionHighlight string  `db:"description_highlight"`
+}
+
+type BidSearchDB struct {
+	BidDB
+	Rank                 float64 `db:"rank"`
+	NameHighlight        string  `db:"name_highlight"`
+	DescriptionHighlight string  `db:"description_highlight"`
+}
+
+// Highlights are html escaped name and description fragments with matched words wrapped in <b></b>
+type Highlights struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type TenderSearchResponse struct {
+	TenderResponse
+	Rank       float64    `json:"rank"`
+	Highlights Highlights `json:"highlights"`
+}
+
+type BidSearchResponse struct {
+	BidResponse
+	Rank       float64    `json:"rank"`
+	Highlights Highlights `json:"highlights"`
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"time"
+	"zadanie-6105/internal/domain/quorum"
+)
+
 type TenderDB struct {
 	Id              string `db:"id"`
 	Name            string `db:"name"`
@@ -10,6 +15,13 @@ type TenderDB struct {
 	CreatorUsername string `db:"creator_username"`
 	Version         int    `db:"version"`
 	CreatedAt       string `db:"created_at"`
+
+	// deadlines are optional, nil means no deadline
+	SubmissionDeadline *time.Time `db:"submission_deadline"`
+	DecisionDeadline   *time.Time `db:"decision_deadline"`
+
+	// Attachments are ids of attached files in this version
+	Attachments []string `db:"-"`
 }
 
 type TenderResponse struct {
@@ -20,4 +32,19 @@ type TenderResponse struct {
 	ServiceType string `json:"serviceType"`
 	Version     int    `json:"version"`
 	CreatedAt   string `json:"createdAt"`
+
+	SubmissionDeadline string `json:"submissionDeadline,omitempty"`
+	DecisionDeadline   string `json:"decisionDeadline,omitempty"`
+}
+
+// TenderTally shows how voting on bids of tender goes under its decision policy
+type TenderTally struct {
+	TenderId string        `json:"tenderId"`
+	Policy   quorum.Policy `json:"policy"`
+	Bids     []BidTally    `json:"bids"`
+}
+
+type BidTally struct {
+	BidId string `json:"bidId"`
+	quorum.Tally
 }
@@ -0,0 +1,21 @@
+package model
+
+// VersionDiff lists only fields that differ between two versions
+type VersionDiff struct {
+	From    int32         `json:"from"`
+	To      int32         `json:"to"`
+	Changes []FieldChange `json:"changes"`
+}
+
+type FieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// BidVersionResponse shows fields hidden from BidResponse, they are part of bid history
+type BidVersionResponse struct {
+	BidResponse
+	Description string `json:"description"`
+	Decision    string `json:"decision,omitempty"`
+}
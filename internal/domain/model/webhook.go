@@ -0,0 +1,83 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// domain events written to outbox together with the state change
+const (
+	EventTenderCreated       = "tender.created"
+	EventTenderStatusChanged = "tender.status_changed"
+	EventBidCreated          = "bid.created"
+	EventBidStatusChanged    = "bid.status_changed"
+	EventBidDecisionApplied  = "bid.decision_applied"
+	EventBidFeedbackLeft     = "bid.feedback_left"
+	EventBidFeedbackReplied  = "bid.feedback_replied"
+)
+
+const (
+	DeliveryPending   = "Pending"
+	DeliveryDelivered = "Delivered"
+	DeliveryFailed    = "Failed"
+)
+
+type WebhookDB struct {
+	Id             string
+	OrganizationId string
+	Url            string
+	Secret         string
+	// empty means all events
+	Events    []string
+	CreatedAt string
+}
+
+type WebhookResponse struct {
+	Id             string   `json:"id"`
+	OrganizationId string   `json:"organizationId"`
+	Url            string   `json:"url"`
+	Events         []string `json:"events"`
+	// secret is shown only once, right after creation
+	Secret    string `json:"secret,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type WebhookDeliveryDB struct {
+	Id            string    `db:"id"`
+	WebhookId     string    `db:"webhook_id"`
+	EventId       string    `db:"event_id"`
+	EventType     string    `db:"event_type"`
+	Status        string    `db:"status"`
+	Attempts      int32     `db:"attempts"`
+	ResponseCode  int       `db:"response_code"`
+	Error         string    `db:"error"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	CreatedAt     string    `db:"created_at"`
+	UpdatedAt     string    `db:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	Id            string `json:"id"`
+	EventId       string `json:"eventId"`
+	EventType     string `json:"eventType"`
+	Status        string `json:"status"`
+	Attempts      int32  `json:"attempts"`
+	ResponseCode  int    `json:"responseCode,omitempty"`
+	Error         string `json:"error,omitempty"`
+	NextAttemptAt string `json:"nextAttemptAt,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+// Delivery is a claimed pending delivery with everything needed to send it
+type Delivery struct {
+	Id             string          `db:"id"`
+	WebhookId      string          `db:"webhook_id"`
+	Url            string          `db:"url"`
+	Secret         string          `db:"secret"`
+	EventId        string          `db:"event_id"`
+	EventType      string          `db:"event_type"`
+	Payload        json.RawMessage `db:"payload"`
+	EventCreatedAt string          `db:"event_created_at"`
+	Attempts       int32           `db:"attempts"`
+}
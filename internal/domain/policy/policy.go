@@ -0,0 +1,86 @@
+package policy
+
+import "slices"
+
+// Role of employee in organization, every membership has exactly one
+type Role string
+
+const (
+	RoleOwner              Role = "owner"
+	RoleProcurementManager Role = "procurement_manager"
+	RoleReviewer           Role = "reviewer"
+	RoleBidder             Role = "bidder"
+	RoleViewer             Role = "viewer"
+)
+
+type Permission string
+
+const (
+	// ManageOrganization covers editing and deleting organization
+	ManageOrganization Permission = "organization.manage"
+	ViewMembers        Permission = "members.view"
+	ManageMembers      Permission = "members.manage"
+	ManageWebhooks     Permission = "webhooks.manage"
+	// ViewTenders gives access to not published tenders of organization, their history and bids
+	ViewTenders   Permission = "tenders.view"
+	ManageTenders Permission = "tenders.manage"
+	DecideBids    Permission = "bids.decide"
+	FeedbackBids  Permission = "bids.feedback"
+	ViewReviews   Permission = "reviews.view"
+	// ViewBids and SubmitBids are about bids authored by organization itself
+	ViewBids   Permission = "bids.view"
+	SubmitBids Permission = "bids.submit"
+)
+
+var permissions = map[Role][]Permission{
+	RoleOwner: {
+		ManageOrganization, ViewMembers, ManageMembers, ManageWebhooks,
+		ViewTenders, ManageTenders, DecideBids, FeedbackBids, ViewReviews,
+		ViewBids, SubmitBids,
+	},
+	RoleProcurementManager: {
+		ViewMembers, ManageWebhooks,
+		ViewTenders, ManageTenders, DecideBids, FeedbackBids, ViewReviews,
+		ViewBids, SubmitBids,
+	},
+	RoleReviewer: {
+		ViewMembers,
+		ViewTenders, DecideBids, FeedbackBids, ViewReviews,
+		ViewBids,
+	},
+	RoleBidder: {
+		ViewMembers,
+		ViewBids, SubmitBids,
+	},
+	RoleViewer: {
+		ViewMembers,
+		ViewTenders, ViewReviews,
+		ViewBids,
+	},
+}
+
+// Roles are listed from the most powerful one
+func Roles() []Role {
+	return []Role{RoleOwner, RoleProcurementManager, RoleReviewer, RoleBidder, RoleViewer}
+}
+
+func Valid(role string) bool {
+	_, ok := permissions[Role(role)]
+	return ok
+}
+
+// Can tells whether role grants permission, unknown roles grant nothing
+func Can(role Role, permission Permission) bool {
+	return slices.Contains(permissions[role], permission)
+}
+
+// RolesWith is used where storage has to count members able to do something
+func RolesWith(permission Permission) []string {
+	var roles []string
+	for _, role := range Roles() {
+		if Can(role, permission) {
+			roles = append(roles, string(role))
+		}
+	}
+	return roles
+}
@@ -0,0 +1,29 @@
+package policy
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCan(t *testing.T) {
+	assert.True(t, Can(RoleOwner, ManageMembers))
+	assert.False(t, Can(RoleProcurementManager, ManageMembers))
+	assert.True(t, Can(RoleReviewer, DecideBids))
+	assert.False(t, Can(RoleBidder, ViewTenders))
+	assert.False(t, Can(RoleViewer, DecideBids))
+	assert.False(t, Can("admin", ViewMembers))
+}
+
+func TestRolesWith(t *testing.T) {
+	assert.Equal(t, []string{"owner", "procurement_manager", "reviewer"}, RolesWith(DecideBids))
+	assert.Equal(t, []string{"owner"}, RolesWith(ManageOrganization))
+}
+
+// every permission must be granted to owner, otherwise nobody could ever get it
+func TestOwnerHasEverything(t *testing.T) {
+	for _, role := range Roles() {
+		for _, permission := range permissions[role] {
+			assert.True(t, Can(RoleOwner, permission), permission)
+		}
+	}
+}
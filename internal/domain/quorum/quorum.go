@@ -0,0 +1,127 @@
+package quorum
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Kind of decision policy, it is chosen when tender is created and never changes
+type Kind string
+
+const (
+	// Unanimous needs approval of every member able to decide
+	Unanimous Kind = "unanimous"
+	// Majority needs approval of more than half of members able to decide
+	Majority Kind = "majority"
+	// Fixed needs Required approvals, or every member if there are fewer of them
+	Fixed Kind = "fixed"
+	// Approvers lets vote only listed users, Required of them must approve, all by default
+	Approvers Kind = "approvers"
+	// Single is decided by the first vote
+	Single Kind = "single"
+)
+
+const (
+	Approved = "Approved"
+	Rejected = "Rejected"
+)
+
+type Policy struct {
+	Kind      Kind     `json:"kind"`
+	Required  int      `json:"required,omitempty"`
+	Approvers []string `json:"approvers,omitempty"`
+}
+
+// Default is the rule tenders had before policies appeared
+func Default() Policy {
+	return Policy{Kind: Fixed, Required: 3}
+}
+
+func (p Policy) Validate() error {
+	switch p.Kind {
+	case Unanimous, Majority, Single:
+		if p.Required != 0 || len(p.Approvers) != 0 {
+			return fmt.Errorf("policy %s takes neither required nor approvers", p.Kind)
+		}
+	case Fixed:
+		if p.Required < 1 {
+			return fmt.Errorf("policy %s needs at least one required approval", p.Kind)
+		}
+		if len(p.Approvers) != 0 {
+			return fmt.Errorf("policy %s takes no approvers", p.Kind)
+		}
+	case Approvers:
+		if len(p.Approvers) == 0 {
+			return fmt.Errorf("policy %s needs approvers", p.Kind)
+		}
+		sorted := slices.Clone(p.Approvers)
+		slices.Sort(sorted)
+		if len(slices.Compact(sorted)) != len(p.Approvers) {
+			return fmt.Errorf("approvers must not repeat")
+		}
+		if p.Required < 0 || p.Required > len(p.Approvers) {
+			return fmt.Errorf("required must not exceed number of approvers")
+		}
+	default:
+		return fmt.Errorf("unknown decision policy %q", p.Kind)
+	}
+	return nil
+}
+
+// CanVote tells whether policy lets user vote, roles are checked separately
+func (p Policy) CanVote(userId string) bool {
+	if p.Kind != Approvers {
+		return true
+	}
+	return slices.Contains(p.Approvers, userId)
+}
+
+// Tally is the state of voting on a bid
+type Tally struct {
+	Approvals  int `json:"approvals"`
+	Rejections int `json:"rejections"`
+	// Required approvals out of Voters
+	Required int `json:"required"`
+	Voters   int `json:"voters"`
+	// Outcome is Approved or Rejected once voting is over, empty before
+	Outcome string `json:"outcome"`
+}
+
+// Count applies policy to votes, members is the number of members able to decide.
+// Bid is rejected as soon as rejections leave too few voters to approve it
+func (p Policy) Count(approvals int, rejections int, members int) Tally {
+	voters := members
+	required := 1
+	switch p.Kind {
+	case Unanimous:
+		required = voters
+	case Majority:
+		required = voters/2 + 1
+	case Fixed:
+		required = min(p.Required, voters)
+	case Approvers:
+		voters = len(p.Approvers)
+		required = voters
+		if p.Required > 0 {
+			required = p.Required
+		}
+	case Single:
+		voters = 1
+	}
+	required = max(required, 1)
+	voters = max(voters, required)
+
+	tally := Tally{
+		Approvals:  approvals,
+		Rejections: rejections,
+		Required:   required,
+		Voters:     voters,
+	}
+	switch {
+	case approvals >= required:
+		tally.Outcome = Approved
+	case voters-rejections < required:
+		tally.Outcome = Rejected
+	}
+	return tally
+}
@@ -0,0 +1,58 @@
+package quorum
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     Policy
+		approvals  int
+		rejections int
+		members    int
+		required   int
+		outcome    string
+	}{
+		{name: "default is capped by members", policy: Default(), approvals: 2, members: 2, required: 2, outcome: Approved},
+		{name: "default waits for three", policy: Default(), approvals: 2, members: 5, required: 3},
+		{name: "default rejects when approval is impossible", policy: Default(), approvals: 1, rejections: 3, members: 5, required: 3, outcome: Rejected},
+		{name: "single rejection is not final for default", policy: Default(), rejections: 1, members: 5, required: 3},
+		{name: "unanimous", policy: Policy{Kind: Unanimous}, approvals: 3, members: 3, required: 3, outcome: Approved},
+		{name: "unanimous fails on any rejection", policy: Policy{Kind: Unanimous}, approvals: 2, rejections: 1, members: 4, required: 4, outcome: Rejected},
+		{name: "majority", policy: Policy{Kind: Majority}, approvals: 3, members: 5, required: 3, outcome: Approved},
+		{name: "majority tie is rejected", policy: Policy{Kind: Majority}, approvals: 2, rejections: 2, members: 4, required: 3, outcome: Rejected},
+		{name: "majority pending", policy: Policy{Kind: Majority}, approvals: 1, rejections: 1, members: 4, required: 3},
+		{name: "fixed", policy: Policy{Kind: Fixed, Required: 2}, approvals: 2, members: 10, required: 2, outcome: Approved},
+		{name: "all approvers", policy: Policy{Kind: Approvers, Approvers: []string{"a", "b"}}, approvals: 1, members: 10, required: 2},
+		{name: "some approvers", policy: Policy{Kind: Approvers, Approvers: []string{"a", "b", "c"}, Required: 2}, approvals: 1, rejections: 2, members: 10, required: 2, outcome: Rejected},
+		{name: "single approves", policy: Policy{Kind: Single}, approvals: 1, members: 10, required: 1, outcome: Approved},
+		{name: "single rejects", policy: Policy{Kind: Single}, rejections: 1, members: 10, required: 1, outcome: Rejected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tally := tt.policy.Count(tt.approvals, tt.rejections, tt.members)
+			assert.Equal(t, tt.required, tally.Required)
+			assert.Equal(t, tt.outcome, tally.Outcome)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Default().Validate())
+	assert.NoError(t, Policy{Kind: Majority}.Validate())
+	assert.NoError(t, Policy{Kind: Approvers, Approvers: []string{"a", "b"}, Required: 1}.Validate())
+	assert.Error(t, Policy{Kind: "quorum"}.Validate())
+	assert.Error(t, Policy{Kind: Fixed}.Validate())
+	assert.Error(t, Policy{Kind: Single, Required: 1}.Validate())
+	assert.Error(t, Policy{Kind: Approvers}.Validate())
+	assert.Error(t, Policy{Kind: Approvers, Approvers: []string{"a", "a"}}.Validate())
+	assert.Error(t, Policy{Kind: Approvers, Approvers: []string{"a"}, Required: 2}.Validate())
+}
+
+func TestCanVote(t *testing.T) {
+	assert.True(t, Policy{Kind: Majority}.CanVote("a"))
+	assert.True(t, Policy{Kind: Approvers, Approvers: []string{"a"}}.CanVote("a"))
+	assert.False(t, Policy{Kind: Approvers, Approvers: []string{"a"}}.CanVote("b"))
+}
@@ -0,0 +1,19 @@
+package request
+
+type Register struct {
+	InviteToken string `json:"inviteToken" validate:"required,max=100"`
+	Password    string `json:"password" validate:"required,min=8,max=72"`
+}
+type InviteEmployee struct {
+	EmployeeId string `param:"employeeId" validate:"required,uuid4"`
+}
+type IssueToken struct {
+	Username string `json:"username" validate:"required,max=50"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+type RefreshToken struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+type RevokeToken struct {
+	All bool `query:"all"`
+}
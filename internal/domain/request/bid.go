@@ -1,57 +1,166 @@
 package request
 
+import (
+	"time"
+	"zadanie-6105/internal/domain/model"
+)
+
 type CreateBid struct {
 	Name        string `json:"name" validate:"required"`
 	Description string `json:"description" validate:"required"`
 	TenderId    string `json:"tenderId" validate:"required"`
 	AuthorType  string `json:"authorType" validate:"required,oneof=Organization User"`
-	AuthorId    string `json:"authorId" validate:"required"`
+	AuthorId    string `json:"authorId" validate:"omitempty,uuid4"`
+	Offer       *Offer `json:"offer"`
+}
+type Offer struct {
+	Amount           model.Decimal `json:"amount" validate:"required,gt=0,decimal=18.2"`
+	Currency         string        `json:"currency" validate:"required,iso4217"`
+	DeliveryDeadline string        `json:"deliveryDeadline" validate:"omitempty,datetime=2006-01-02"`
+	WarrantyMonths   int32         `json:"warrantyMonths" validate:"gte=0,lte=600"`
+	Items            []OfferItem   `json:"items" validate:"max=100,dive"`
+}
+type OfferItem struct {
+	Name      string        `json:"name" validate:"required,max=250"`
+	Quantity  model.Decimal `json:"quantity" validate:"gt=0,decimal=18.3"`
+	UnitPrice model.Decimal `json:"unitPrice" validate:"gte=0,decimal=18.2"`
 }
 type GetBidsByUser struct {
-	Limit    int32  `query:"limit" validate:"gte=0"`
-	Offset   int32  `query:"offset" validate:"gte=0"`
-	Username string `query:"username" validate:"required,max=50"`
+	Limit  int32  `query:"limit" validate:"gte=0"`
+	Offset int32  `query:"offset" validate:"gte=0"`
+	Cursor string `query:"cursor" validate:"max=1000"`
+	Sort   string `query:"sort" validate:"omitempty,oneof=name created_at version"`
+	Order  string `query:"order" validate:"omitempty,oneof=asc desc"`
+	// empty means both
+	Authors string `query:"authors" validate:"omitempty,oneof=personal organization both"`
 }
 type BidsForTender struct {
 	TenderId string `param:"tenderId" validate:"required,uuid4"`
 	Limit    int32  `query:"limit" validate:"gte=0"`
 	Offset   int32  `query:"offset" validate:"gte=0"`
-	Username string `query:"username" validate:"required,max=50"`
+	Cursor   string `query:"cursor" validate:"max=1000"`
+	Sort     string `query:"sort" validate:"omitempty,oneof=name created_at version"`
+	Order    string `query:"order" validate:"omitempty,oneof=asc desc"`
 }
 type BidStatus struct {
-	BidId    string `param:"bidId" validate:"required,uuid4"`
-	Username string `query:"username" validate:"required,max=50"`
+	BidId string `param:"bidId" validate:"required,uuid4"`
 }
 type UpdateBidStatus struct {
-	BidId    string `param:"bidId" validate:"required,uuid4"`
-	Status   string `query:"status" validate:"required,oneof=Created Published Canceled"`
-	Username string `query:"username" validate:"required,max=50"`
+	BidId   string `param:"bidId" validate:"required,uuid4"`
+	Status  string `query:"status" validate:"required,oneof=Created Published Canceled"`
+	IfMatch string `header:"If-Match"`
 }
 type EditBid struct {
 	BidId       string `param:"bidId" validate:"required,uuid4"`
-	Username    string `query:"username" validate:"required,max=50"`
 	Name        string `json:"name" validate:"max=100"`
 	Description string `json:"description" validate:"max=500"`
+	Offer       *Offer `json:"offer"`
+	IfMatch     string `header:"If-Match"`
 }
 type SubmitDecision struct {
-	BidId    string `param:"bidId" validate:"required,uuid4"`
-	Decision string `query:"decision" validate:"required,oneof=Approved Rejected"`
-	Username string `query:"username" validate:"required,max=50"`
+	BidId          string `param:"bidId" validate:"required,uuid4"`
+	Decision       string `query:"decision" validate:"required,oneof=Approved Rejected"`
+	Comment        string `query:"comment" validate:"max=1000"`
+	OrganizationId string `query:"organizationId" validate:"omitempty,uuid4"`
+	IfMatch        string `header:"If-Match"`
+}
+type Decisions struct {
+	BidId string `param:"bidId" validate:"required,uuid4"`
 }
 type Feedback struct {
-	BidId       string `param:"bidId" validate:"required,uuid4"`
-	BidFeedback string `query:"bidFeedback" validate:"required,max=1000"`
-	Username    string `query:"username" validate:"required,max=50"`
+	BidId          string `param:"bidId" validate:"required,uuid4"`
+	BidFeedback    string `query:"bidFeedback" validate:"required,max=1000"`
+	OrganizationId string `query:"organizationId" validate:"omitempty,uuid4"`
+	// Ratings are optional scores by criteria sent in body
+	Ratings map[string]int `json:"ratings" validate:"dive,keys,required,max=50,endkeys,min=1,max=5"`
 }
 type RollbackBid struct {
-	BidId    string `param:"bidId" validate:"required,uuid4"`
-	Version  int32  `param:"version" validate:"required,gt=0"`
-	Username string `query:"username" validate:"required,max=50"`
+	BidId   string `param:"bidId" validate:"required,uuid4"`
+	Version int32  `param:"version" validate:"required,gt=0"`
+	IfMatch string `header:"If-Match"`
+}
+
+// ReviewAuthor is either user or organization who authored bids
+type ReviewAuthor struct {
+	AuthorUsername       string `query:"authorUsername" validate:"required_without=AuthorOrganizationId,excluded_with=AuthorOrganizationId,max=50"`
+	AuthorOrganizationId string `query:"authorOrganizationId" validate:"omitempty,uuid4"`
 }
 type Reviews struct {
-	TenderId          string `param:"tenderId" validate:"required,uuid4" `
-	AuthorUsername    string `query:"authorUsername" validate:"required,max=50"`
-	RequesterUsername string `query:"requesterUsername" validate:"required,max=50"`
-	Limit             int32  `query:"limit" validate:"gte=0"`
-	Offset            int32  `query:"offset" validate:"gte=0"`
+	TenderId string `param:"tenderId" validate:"required,uuid4" `
+	ReviewAuthor
+	// Scope organization widens reviews to all tenders of organization owning the tender
+	Scope       string     `query:"scope" validate:"omitempty,oneof=tender organization"`
+	BidId       string     `query:"bidId" validate:"omitempty,uuid4"`
+	CreatedFrom *time.Time `query:"createdFrom"`
+	CreatedTo   *time.Time `query:"createdTo"`
+	Limit       int32      `query:"limit" validate:"gte=0"`
+	Offset      int32      `query:"offset" validate:"gte=0"`
+	Cursor      string     `query:"cursor" validate:"max=1000"`
+	// reviews have no name and version
+	Sort  string `query:"sort" validate:"omitempty,oneof=created_at"`
+	Order string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
+type Reputation struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+	ReviewAuthor
+}
+type Thread struct {
+	BidId  string `param:"bidId" validate:"required,uuid4"`
+	Limit  int32  `query:"limit" validate:"gte=0"`
+	Offset int32  `query:"offset" validate:"gte=0"`
+	Cursor string `query:"cursor" validate:"max=1000"`
+	// thread is ordered by time only, oldest first by default
+	Order string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
+type PostMessage struct {
+	BidId string `param:"bidId" validate:"required,uuid4"`
+	Text  string `json:"text" validate:"required,max=1000"`
+}
+type MarkThreadRead struct {
+	BidId string `param:"bidId" validate:"required,uuid4"`
+}
+type BidVersions struct {
+	BidId string `param:"bidId" validate:"required,uuid4"`
+}
+type BidVersion struct {
+	BidId   string `param:"bidId" validate:"required,uuid4"`
+	Version int32  `param:"version" validate:"required,gt=0"`
+}
+type BidVersionsDiff struct {
+	BidId string `param:"bidId" validate:"required,uuid4"`
+	From  int32  `query:"from" validate:"required,gt=0"`
+	To    int32  `query:"to" validate:"required,gt=0"`
+}
+
+// BidAttachments lists attachments of current version unless Version is set
+type BidAttachments struct {
+	BidId   string `param:"bidId" validate:"required,uuid4"`
+	Version int32  `query:"version" validate:"gte=0"`
+}
+
+// UploadBidAttachment carries file in multipart field "file"
+type UploadBidAttachment struct {
+	BidId   string `param:"bidId" validate:"required,uuid4"`
+	IfMatch string `header:"If-Match"`
+}
+type BidAttachment struct {
+	BidId        string `param:"bidId" validate:"required,uuid4"`
+	AttachmentId string `param:"attachmentId" validate:"required,uuid4"`
+}
+type DeleteBidAttachment struct {
+	BidId        string `param:"bidId" validate:"required,uuid4"`
+	AttachmentId string `param:"attachmentId" validate:"required,uuid4"`
+	IfMatch      string `header:"If-Match"`
+}
+type SearchBids struct {
+	Query          string     `query:"q" validate:"required,max=200"`
+	Status         []string   `query:"status" validate:"dive,oneof=Created Published Canceled"`
+	OrganizationId string     `query:"organizationId" validate:"omitempty,uuid4"`
+	ServiceType    []string   `query:"service_type" validate:"dive,max=50"`
+	CreatedFrom    *time.Time `query:"createdFrom"`
+	CreatedTo      *time.Time `query:"createdTo"`
+	Limit          int32      `query:"limit" validate:"gte=0"`
+	Offset         int32      `query:"offset" validate:"gte=0"`
+	// results are ordered by relevance only, most relevant first
+	Cursor string `query:"cursor" validate:"max=1000"`
 }
@@ -0,0 +1,17 @@
+package request
+
+type Categories struct {
+	// Deprecated lists deprecated categories too
+	Deprecated bool `query:"deprecated"`
+}
+type CreateCategory struct {
+	// Code is what tenders use as serviceType, it can not be changed later
+	Code       string `json:"code" validate:"required,max=50,alphanum"`
+	Name       string `json:"name" validate:"required,max=100"`
+	ParentCode string `json:"parentCode" validate:"omitempty,max=50"`
+}
+type EditCategory struct {
+	Code       string `param:"code" validate:"required,max=50"`
+	Name       string `json:"name" validate:"max=100"`
+	Deprecated *bool  `json:"deprecated"`
+}
@@ -0,0 +1,30 @@
+package request
+
+type GetEmployees struct {
+	Limit  int32  `query:"limit" validate:"gte=0"`
+	Offset int32  `query:"offset" validate:"gte=0"`
+	Cursor string `query:"cursor" validate:"max=1000"`
+	// employees are sorted by username as name
+	Sort  string `query:"sort" validate:"omitempty,oneof=name created_at"`
+	Order string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
+type GetEmployee struct {
+	EmployeeId string `param:"employeeId" validate:"required,uuid4"`
+}
+type CreateEmployee struct {
+	Username  string `json:"username" validate:"required,max=50"`
+	FirstName string `json:"firstName" validate:"max=50"`
+	LastName  string `json:"lastName" validate:"max=50"`
+	Password  string `json:"password" validate:"required,min=8,max=72"`
+	// OrganizationId may be omitted if caller manages members of only one organization
+	OrganizationId string `json:"organizationId" validate:"omitempty,uuid4"`
+	Role           string `json:"role" validate:"omitempty,oneof=owner procurement_manager reviewer bidder viewer"`
+}
+type EditEmployee struct {
+	EmployeeId string `param:"employeeId" validate:"required,uuid4"`
+	FirstName  string `json:"firstName" validate:"max=50"`
+	LastName   string `json:"lastName" validate:"max=50"`
+}
+type DeleteEmployee struct {
+	EmployeeId string `param:"employeeId" validate:"required,uuid4"`
+}
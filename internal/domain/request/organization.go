@@ -0,0 +1,64 @@
+package request
+
+type GetOrganizations struct {
+	Limit  int32  `query:"limit" validate:"gte=0"`
+	Offset int32  `query:"offset" validate:"gte=0"`
+	Cursor string `query:"cursor" validate:"max=1000"`
+	Sort   string `query:"sort" validate:"omitempty,oneof=name created_at"`
+	Order  string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
+type GetOrganization struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+}
+type CreateOrganization struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description" validate:"max=1000"`
+	Type        string `json:"type" validate:"required,oneof=IE LLC JSC"`
+}
+type EditOrganization struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	Name           string `json:"name" validate:"max=100"`
+	Description    string `json:"description" validate:"max=1000"`
+	Type           string `json:"type" validate:"omitempty,oneof=IE LLC JSC"`
+}
+type DeleteOrganization struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+}
+type OrganizationResponsibles struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+}
+type AddResponsible struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	Username       string `json:"username" validate:"required,max=50"`
+	Role           string `json:"role" validate:"omitempty,oneof=owner procurement_manager reviewer bidder viewer"`
+}
+type SetRole struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	UserId         string `param:"userId" validate:"required,uuid4"`
+	Role           string `json:"role" validate:"required,oneof=owner procurement_manager reviewer bidder viewer"`
+}
+type RemoveResponsible struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	UserId         string `param:"userId" validate:"required,uuid4"`
+}
+type CreateWebhook struct {
+	OrganizationId string   `param:"organizationId" validate:"required,uuid4"`
+	Url            string   `json:"url" validate:"required,http_url,max=2048"`
+	Events         []string `json:"events" validate:"dive,oneof=tender.created tender.status_changed bid.created bid.status_changed bid.decision_applied bid.feedback_left bid.feedback_replied"`
+}
+type OrganizationWebhooks struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+}
+type DeleteWebhook struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	WebhookId      string `param:"webhookId" validate:"required,uuid4"`
+}
+type WebhookDeliveries struct {
+	OrganizationId string `param:"organizationId" validate:"required,uuid4"`
+	WebhookId      string `param:"webhookId" validate:"required,uuid4"`
+	Limit          int32  `query:"limit" validate:"gte=0"`
+	Offset         int32  `query:"offset" validate:"gte=0"`
+	Cursor         string `query:"cursor" validate:"max=1000"`
+	// deliveries are ordered by time only, newest first by default
+	Order string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
@@ -1,40 +1,107 @@
 package request
 
+import "time"
+
 type GetTender struct {
 	Limit       int32    `query:"limit" validate:"gte=0"`
 	Offset      int32    `query:"offset" validate:"gte=0"`
-	ServiceType []string `query:"service_type" validate:"dive,oneof=Construction Delivery Manufacture"`
+	ServiceType []string `query:"service_type" validate:"dive,max=50"`
+	// cursor is X-Next-Cursor of the previous page, offset is ignored with it
+	Cursor string `query:"cursor" validate:"max=1000"`
+	Sort   string `query:"sort" validate:"omitempty,oneof=name created_at version"`
+	Order  string `query:"order" validate:"omitempty,oneof=asc desc"`
 }
 type CreateTender struct {
-	Name            string `json:"name" validate:"required,max=100"`
-	Description     string `json:"description" validate:"required,max=500"`
-	ServiceType     string `json:"serviceType" validate:"required,oneof=Construction Delivery Manufacture"`
-	OrganizationId  string `json:"organizationId" validate:"required,uuid4"`
-	CreatorUsername string `json:"creatorUsername" validate:"required,max=50"`
+	Name           string `json:"name" validate:"required,max=100"`
+	Description    string `json:"description" validate:"required,max=500"`
+	ServiceType    string `json:"serviceType" validate:"required,max=50"`
+	OrganizationId string `json:"organizationId" validate:"required,uuid4"`
+	// RFC 3339, bids are accepted until submission deadline,
+	// tender is closed automatically after decision deadline
+	SubmissionDeadline *time.Time `json:"submissionDeadline"`
+	DecisionDeadline   *time.Time `json:"decisionDeadline"`
+	// default is fixed policy with 3 required approvals
+	DecisionPolicy *DecisionPolicy `json:"decisionPolicy"`
+}
+type DecisionPolicy struct {
+	Kind      string   `json:"kind" validate:"required,oneof=unanimous majority fixed approvers single"`
+	Required  int      `json:"required" validate:"gte=0"`
+	Approvers []string `json:"approvers" validate:"dive,uuid4"`
 }
 type GetTenderByUser struct {
-	Limit    int32  `query:"limit" validate:"gte=0"`
-	Offset   int32  `query:"offset" validate:"gte=0"`
-	Username string `query:"username" validate:"required,max=50"`
+	Limit  int32  `query:"limit" validate:"gte=0"`
+	Offset int32  `query:"offset" validate:"gte=0"`
+	Cursor string `query:"cursor" validate:"max=1000"`
+	Sort   string `query:"sort" validate:"omitempty,oneof=name created_at version"`
+	Order  string `query:"order" validate:"omitempty,oneof=asc desc"`
 }
 type TenderStatus struct {
 	TenderId string `param:"tenderId" validate:"required,uuid4"`
-	Username string `query:"username" validate:"max=50"`
+}
+type TenderTally struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
 }
 type UpdateTenderStatus struct {
 	TenderId string `param:"tenderId" validate:"required,uuid4"`
 	Status   string `query:"status" validate:"required,oneof=Created Published Closed"`
-	Username string `query:"username" validate:"required,max=50"`
+	IfMatch  string `header:"If-Match"`
 }
 type EditTender struct {
-	TenderId    string `param:"tenderId" validate:"required"`
-	Username    string `query:"username" validate:"required"`
-	Name        string `json:"name" validate:"max=100"`
-	Description string `json:"description" validate:"max=500"`
-	ServiceType string `json:"serviceType" validate:"omitempty,oneof=Construction Delivery Manufacture"`
+	TenderId           string     `param:"tenderId" validate:"required"`
+	Name               string     `json:"name" validate:"max=100"`
+	Description        string     `json:"description" validate:"max=500"`
+	ServiceType        string     `json:"serviceType" validate:"omitempty,max=50"`
+	SubmissionDeadline *time.Time `json:"submissionDeadline"`
+	DecisionDeadline   *time.Time `json:"decisionDeadline"`
+	IfMatch            string     `header:"If-Match"`
 }
 type RollbackTender struct {
 	TenderId string `param:"tenderId" validate:"required,uuid4"`
 	Version  int32  `param:"version" validate:"required,gt=0"`
-	Username string `query:"username" validate:"required,max=50"`
+	IfMatch  string `header:"If-Match"`
+}
+type TenderVersions struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+}
+type TenderVersion struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+	Version  int32  `param:"version" validate:"required,gt=0"`
+}
+type TenderVersionsDiff struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+	From     int32  `query:"from" validate:"required,gt=0"`
+	To       int32  `query:"to" validate:"required,gt=0"`
+}
+
+// TenderAttachments lists attachments of current version unless Version is set
+type TenderAttachments struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+	Version  int32  `query:"version" validate:"gte=0"`
+}
+
+// UploadTenderAttachment carries file in multipart field "file"
+type UploadTenderAttachment struct {
+	TenderId string `param:"tenderId" validate:"required,uuid4"`
+	IfMatch  string `header:"If-Match"`
+}
+type TenderAttachment struct {
+	TenderId     string `param:"tenderId" validate:"required,uuid4"`
+	AttachmentId string `param:"attachmentId" validate:"required,uuid4"`
+}
+type DeleteTenderAttachment struct {
+	TenderId     string `param:"tenderId" validate:"required,uuid4"`
+	AttachmentId string `param:"attachmentId" validate:"required,uuid4"`
+	IfMatch      string `header:"If-Match"`
+}
+type SearchTenders struct {
+	Query          string     `query:"q" validate:"required,max=200"`
+	Status         []string   `query:"status" validate:"dive,oneof=Created Published Closed"`
+	OrganizationId string     `query:"organizationId" validate:"omitempty,uuid4"`
+	ServiceType    []string   `query:"service_type" validate:"dive,max=50"`
+	CreatedFrom    *time.Time `query:"createdFrom"`
+	CreatedTo      *time.Time `query:"createdTo"`
+	Limit          int32      `query:"limit" validate:"gte=0"`
+	Offset         int32      `query:"offset" validate:"gte=0"`
+	// results are ordered by relevance only, most relevant first
+	Cursor string `query:"cursor" validate:"max=1000"`
 }
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+	"zadanie-6105/internal/domain/model"
+)
+
+type callerKey struct{}
+
+// WithCaller puts authenticated employee into context, so service layer does not depend on echo.
+func WithCaller(ctx context.Context, caller model.Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFromContext(ctx context.Context) (model.Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(model.Caller)
+	return caller, ok
+}
@@ -0,0 +1,71 @@
+package cursor
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"zadanie-6105/internal/domain/model"
+)
+
+var ErrMalformed = errors.New("cursor is malformed")
+
+// Encode makes opaque cursor, clients must pass it back as is
+func Encode(c model.Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func Decode(s string) (model.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return model.Cursor{}, ErrMalformed
+	}
+	var c model.Cursor
+	err = json.Unmarshal(raw, &c)
+	if err != nil || c.Id == "" {
+		return model.Cursor{}, ErrMalformed
+	}
+	switch c.Sort {
+	case model.SortName:
+	case model.SortCreatedAt:
+		_, err = time.Parse(time.RFC3339Nano, c.Value)
+	case model.SortVersion:
+		_, err = strconv.Atoi(c.Value)
+	case model.SortRank:
+		_, err = strconv.ParseFloat(c.Value, 64)
+	default:
+		err = ErrMalformed
+	}
+	if err != nil {
+		return model.Cursor{}, ErrMalformed
+	}
+
+	return c, nil
+}
+
+// Compare orders items by sort value and id like ORDER BY value, id,
+// values are typed by sort field
+func Compare(sort string, aValue string, aId string, bValue string, bId string) int {
+	var c int
+	switch sort {
+	case model.SortCreatedAt:
+		a, _ := time.Parse(time.RFC3339Nano, aValue)
+		b, _ := time.Parse(time.RFC3339Nano, bValue)
+		c = a.Compare(b)
+	case model.SortVersion:
+		a, _ := strconv.Atoi(aValue)
+		b, _ := strconv.Atoi(bValue)
+		c = cmp.Compare(a, b)
+	case model.SortRank:
+		a, _ := strconv.ParseFloat(aValue, 64)
+		b, _ := strconv.ParseFloat(bValue, 64)
+		c = cmp.Compare(a, b)
+	default:
+		c = strings.Compare(aValue, bValue)
+	}
+	return cmp.Or(c, strings.Compare(aId, bId))
+}
@@ -0,0 +1,38 @@
+package cursor
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	c := model.Cursor{Sort: model.SortCreatedAt, Desc: true, Value: "2024-10-01T12:00:00.123456Z", Id: "id"}
+
+	got, err := Decode(Encode(c))
+	require.NoError(t, err)
+	assert.Equal(t, c, got)
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	for _, s := range []string{
+		"not base64!",
+		Encode(model.Cursor{Sort: model.SortName, Value: "a"}),
+		Encode(model.Cursor{Sort: "status", Value: "a", Id: "id"}),
+		Encode(model.Cursor{Sort: model.SortVersion, Value: "one", Id: "id"}),
+		Encode(model.Cursor{Sort: model.SortCreatedAt, Value: "yesterday", Id: "id"}),
+		Encode(model.Cursor{Sort: model.SortRank, Value: "high", Id: "id"}),
+	} {
+		_, err := Decode(s)
+		assert.ErrorIs(t, err, ErrMalformed, s)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	assert.Negative(t, Compare(model.SortVersion, "2", "b", "10", "a"))
+	assert.Negative(t, Compare(model.SortName, "a", "b", "b", "a"))
+	assert.Negative(t, Compare(model.SortName, "a", "a", "a", "b"))
+	assert.Negative(t, Compare(model.SortCreatedAt, "2024-10-01T12:00:00.9Z", "b", "2024-10-01T12:00:01Z", "a"))
+	assert.Negative(t, Compare(model.SortRank, "0.5", "b", "1.25", "a"))
+}
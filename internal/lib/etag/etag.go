@@ -0,0 +1,36 @@
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrMalformed = errors.New("If-Match header is malformed")
+
+// Format makes strong ETag from resource version
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Parse returns version from If-Match header.
+// Empty header and "*" match any version, for them 0 is returned
+func Parse(header string) (int32, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+	// versions are compared as is, so weak ETags are fine too
+	header = strings.TrimPrefix(header, "W/")
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, ErrMalformed
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 32)
+	if err != nil || version <= 0 {
+		return 0, ErrMalformed
+	}
+
+	return int32(version), nil
+}
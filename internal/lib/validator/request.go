@@ -2,10 +2,20 @@ package validator
 
 import (
 	"github.com/go-playground/validator/v10"
+	"reflect"
+	"strconv"
+	"strings"
+	"zadanie-6105/internal/domain/model"
 )
 
 func Validate(request interface{}) error {
 	validate := validator.New(validator.WithRequiredStructEnabled())
+	// decimals are compared as numbers, so gt=0 means positive and not non-empty
+	validate.RegisterCustomTypeFunc(func(v reflect.Value) any {
+		return v.Interface().(model.Decimal).Float64()
+	}, model.Decimal(""))
+	// decimal=18.2 means value fits NUMERIC(18, 2) as is, otherwise postgres would round it or fail on overflow
+	validate.RegisterValidation("decimal", validateDecimal)
 
 	err := validate.Struct(request)
 	if err != nil {
@@ -13,3 +23,18 @@ func Validate(request interface{}) error {
 	}
 	return nil
 }
+
+func validateDecimal(fl validator.FieldLevel) bool {
+	precision, scale, _ := strings.Cut(fl.Param(), ".")
+	p, err := strconv.Atoi(precision)
+	if err != nil {
+		panic("decimal precision is not a number: " + fl.Param())
+	}
+	sc, err := strconv.Atoi(scale)
+	if err != nil {
+		panic("decimal scale is not a number: " + fl.Param())
+	}
+	// fl.Field() is float64 made by custom type func, so decimal itself is taken from parent
+	d, ok := fl.Parent().FieldByName(fl.StructFieldName()).Interface().(model.Decimal)
+	return ok && d.Fits(p, sc)
+}
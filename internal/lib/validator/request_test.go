@@ -0,0 +1,48 @@
+package validator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/request"
+)
+
+func TestValidate_OfferDecimals(t *testing.T) {
+	offer := func(amount model.Decimal, quantity model.Decimal) request.CreateBid {
+		return request.CreateBid{
+			Name:        "bid",
+			Description: "bid",
+			TenderId:    "tender",
+			AuthorType:  "User",
+			Offer: &request.Offer{
+				Amount:   amount,
+				Currency: "RUB",
+				Items:    []request.OfferItem{{Name: "Cement", Quantity: quantity, UnitPrice: "10.50"}},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		amount   model.Decimal
+		quantity model.Decimal
+		valid    bool
+	}{
+		{name: "exact", amount: "150000.50", quantity: "1.125", valid: true},
+		{name: "trailing zeros", amount: "1.500", quantity: "2.0000", valid: true},
+		{name: "largest amount", amount: "9999999999999999.99", quantity: "1", valid: true},
+		{name: "amount would be rounded", amount: "1.005", quantity: "1"},
+		{name: "amount overflows", amount: "10000000000000000", quantity: "1"},
+		{name: "quantity would be rounded", amount: "1", quantity: "0.0005"},
+		{name: "not positive", amount: "0.00", quantity: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(offer(tt.amount, tt.quantity))
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
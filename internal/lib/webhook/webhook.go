@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+	"zadanie-6105/internal/domain/model"
+)
+
+const (
+	SignatureHeader = "X-Signature"
+	TimestampHeader = "X-Timestamp"
+	EventHeader     = "X-Event-Type"
+	DeliveryHeader  = "X-Delivery-Id"
+
+	// MaxAttempts after which delivery is marked as failed
+	MaxAttempts = 8
+
+	firstRetry = 10 * time.Second
+	maxRetry   = time.Hour
+)
+
+// Body is what receiver gets, data is event payload as it was written to outbox
+type Body struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Sign returns hex HMAC-SHA256 of "timestamp.body", timestamp is included
+// so receivers can reject replayed requests
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns delay before the next attempt, attempt starts from 1
+func Backoff(attempt int32) time.Duration {
+	delay := firstRetry
+	for i := int32(1); i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetry {
+			return maxRetry
+		}
+	}
+	return delay
+}
+
+// ErrNotPublic is returned for receivers in loopback, private and link-local networks,
+// otherwise webhooks and their delivery log would let organizations probe services next to the server
+var ErrNotPublic = errors.New("webhook address is not public")
+
+// notPublic are networks net/netip does not classify, "this network" and carrier-grade NAT
+var notPublic = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+type Sender struct {
+	client       *http.Client
+	allowPrivate bool
+}
+
+// NewSender refuses to connect to non-public addresses unless allowPrivate is set, it is for local development
+func NewSender(timeout time.Duration, allowPrivate bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		// address is checked after resolving, so host resolving to another address than at creation is caught too
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !public(addrPort.Addr()) {
+				return ErrNotPublic
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// proxy would connect to receiver instead of the dialer above
+	transport.Proxy = nil
+
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// redirects are not followed, receiver must give the final url
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		allowPrivate: allowPrivate,
+	}
+}
+
+// CheckURL resolves host of receiver and refuses it if any of its addresses is not public
+func (s *Sender) CheckURL(ctx context.Context, rawURL string) error {
+	if s.allowPrivate {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s", u.Hostname())
+	}
+	for _, addr := range addrs {
+		if !public(addr) {
+			return ErrNotPublic
+		}
+	}
+	return nil
+}
+
+func public(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range notPublic {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send posts signed event, any non 2xx response is an error.
+// Response code is returned whenever receiver answered
+func (s *Sender) Send(ctx context.Context, delivery model.Delivery) (int, error) {
+	body, err := json.Marshal(Body{
+		Id:        delivery.EventId,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.EventCreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.Id)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// body is drained so connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestSendSignsBody(t *testing.T) {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	delivery := model.Delivery{
+		Id:             "delivery",
+		Url:            server.URL,
+		Secret:         "secret",
+		EventId:        "event",
+		EventType:      model.EventBidCreated,
+		Payload:        json.RawMessage(`{"bidId":"bid"}`),
+		EventCreatedAt: "2024-01-01T00:00:00Z",
+	}
+	code, err := NewSender(time.Second, true).Send(context.Background(), delivery)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+
+	assert.Equal(t, model.EventBidCreated, gotHeader.Get(EventHeader))
+	assert.Equal(t, "delivery", gotHeader.Get(DeliveryHeader))
+	assert.Equal(t, Sign("secret", gotHeader.Get(TimestampHeader), gotBody), gotHeader.Get(SignatureHeader))
+
+	var body Body
+	require.NoError(t, json.Unmarshal(gotBody, &body))
+	assert.Equal(t, "event", body.Id)
+	assert.JSONEq(t, `{"bidId":"bid"}`, string(body.Data))
+}
+
+func TestSendFailsOnNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	code, err := NewSender(time.Second, true).Send(context.Background(), model.Delivery{Url: server.URL, Payload: json.RawMessage(`{}`)})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+// test server listens on loopback, so it is what a probe of local service looks like
+func TestSendRefusesPrivateAddress(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	_, err := NewSender(time.Second, false).Send(context.Background(), model.Delivery{Url: server.URL, Payload: json.RawMessage(`{}`)})
+	require.ErrorIs(t, err, ErrNotPublic)
+	assert.False(t, called)
+}
+
+func TestCheckURL(t *testing.T) {
+	sender := NewSender(time.Second, false)
+	for _, rawURL := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://10.1.2.3/hook",
+		"http://192.168.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+		"http://0.0.0.0/hook",
+		"http://100.64.0.1/hook",
+	} {
+		assert.ErrorIs(t, sender.CheckURL(context.Background(), rawURL), ErrNotPublic, rawURL)
+	}
+	assert.NoError(t, sender.CheckURL(context.Background(), "https://93.184.216.34/hook"))
+	assert.NoError(t, NewSender(time.Second, true).CheckURL(context.Background(), "http://127.0.0.1/hook"))
+}
+
+func TestSignDependsOnTimestamp(t *testing.T) {
+	body := []byte(`{}`)
+	assert.Equal(t, Sign("secret", "1", body), Sign("secret", "1", body))
+	assert.NotEqual(t, Sign("secret", "1", body), Sign("secret", "2", body))
+	assert.NotEqual(t, Sign("secret", "1", body), Sign("other", "1", body))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, Backoff(1))
+	assert.Equal(t, 20*time.Second, Backoff(2))
+	assert.Equal(t, 80*time.Second, Backoff(4))
+	assert.Equal(t, time.Hour, Backoff(20))
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+// AddAttachment appends to a copy of ids, versions in history share the old slice
+func (s *Storage) AddAttachment(ctx context.Context, attachment model.AttachmentDB, expectedVersion int32) (model.AttachmentDB, int, error) {
+	defer s.lock()()
+
+	attachment.Id = newId()
+	attachment.CreatedAt = now()
+	version, err := s.updateAttachments(attachment.OwnerType, attachment.OwnerId, expectedVersion, func(ids []string) ([]string, bool) {
+		return append(slices.Clone(ids), attachment.Id), true
+	})
+	if err != nil {
+		return model.AttachmentDB{}, 0, err
+	}
+	s.data.attachments[attachment.Id] = attachment
+
+	return attachment, version, nil
+}
+
+func (s *Storage) Attachments(ctx context.Context, ownerType string, ownerId string, version int32) ([]model.AttachmentDB, error) {
+	defer s.lock()()
+
+	var ids []string
+	switch ownerType {
+	case model.OwnerTender:
+		tender, ok := s.data.tenders[ownerId]
+		if version != 0 && tender.Version != int(version) {
+			tender, ok = s.data.tenderVersions[ownerId][int(version)]
+		}
+		if ok {
+			ids = tender.Attachments
+		}
+	case model.OwnerBid:
+		bid, ok := s.data.bids[ownerId]
+		if version != 0 && bid.Version != int(version) {
+			bid, ok = s.data.bidVersions[ownerId][int(version)]
+		}
+		if ok {
+			ids = bid.Attachments
+		}
+	default:
+		return nil, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+	}
+
+	attachments := make([]model.AttachmentDB, 0, len(ids))
+	for _, id := range ids {
+		attachments = append(attachments, s.data.attachments[id])
+	}
+	return attachments, nil
+}
+
+func (s *Storage) Attachment(ctx context.Context, ownerType string, ownerId string, attachmentId string) (model.AttachmentDB, error) {
+	defer s.lock()()
+
+	attachment, ok := s.data.attachments[attachmentId]
+	if !ok || attachment.OwnerType != ownerType || attachment.OwnerId != ownerId {
+		return model.AttachmentDB{}, errs.NotFound(fmt.Errorf("attachment not found"))
+	}
+	return attachment, nil
+}
+
+// RemoveAttachment keeps metadata, older versions of owner still refer to it
+func (s *Storage) RemoveAttachment(ctx context.Context, ownerType string, ownerId string, attachmentId string, expectedVersion int32) (int, error) {
+	defer s.lock()()
+
+	return s.updateAttachments(ownerType, ownerId, expectedVersion, func(ids []string) ([]string, bool) {
+		if !slices.Contains(ids, attachmentId) {
+			return nil, false
+		}
+		return slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+			return id == attachmentId
+		}), true
+	})
+}
+
+// updateAttachments saves owner to history with new ids and returns new version of owner,
+// nothing is changed when update refuses
+func (s *Storage) updateAttachments(ownerType string, ownerId string, expectedVersion int32, update func(ids []string) ([]string, bool)) (int, error) {
+	switch ownerType {
+	case model.OwnerTender:
+		tender, ok := s.data.tenders[ownerId]
+		if !ok {
+			return 0, errs.NotFound(fmt.Errorf("tender not found"))
+		}
+		ids, ok := update(tender.Attachments)
+		if !ok {
+			return 0, errs.NotFound(fmt.Errorf("attachment not found"))
+		}
+		tender, err := s.tenderForUpdate(ownerId, expectedVersion)
+		if err != nil {
+			return 0, err
+		}
+		tender.Attachments = ids
+		s.data.tenders[ownerId] = tender
+		return tender.Version, nil
+	case model.OwnerBid:
+		bid, ok := s.data.bids[ownerId]
+		if !ok {
+			return 0, errs.NotFound(fmt.Errorf("bid not found"))
+		}
+		ids, ok := update(bid.Attachments)
+		if !ok {
+			return 0, errs.NotFound(fmt.Errorf("attachment not found"))
+		}
+		bid, err := s.bidForUpdate(ownerId, expectedVersion)
+		if err != nil {
+			return 0, err
+		}
+		bid.Attachments = ids
+		s.data.bids[ownerId] = bid
+		return bid.Version, nil
+	default:
+		return 0, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+	}
+}
+
+func (s *Storage) deleteAttachments(ownerType string, ownerId string) {
+	for id, attachment := range s.data.attachments {
+		if attachment.OwnerType == ownerType && attachment.OwnerId == ownerId {
+			delete(s.data.attachments, id)
+		}
+	}
+}
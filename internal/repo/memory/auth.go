@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func (s *Storage) Credentials(ctx context.Context, username string) (model.CredentialsDB, error) {
+	defer s.lock()()
+
+	employee, ok := s.employeeByName(username)
+	if !ok {
+		return model.CredentialsDB{}, errs.Unauthorized(fmt.Errorf("invalid username or password"))
+	}
+	hash, ok := s.data.credentials[employee.Id]
+	if !ok {
+		return model.CredentialsDB{}, errs.Unauthorized(fmt.Errorf("invalid username or password"))
+	}
+
+	return model.CredentialsDB{
+		EmployeeId:   employee.Id,
+		Username:     employee.Username,
+		PasswordHash: hash,
+	}, nil
+}
+
+func (s *Storage) SetCredentials(ctx context.Context, employeeId string, passwordHash string) error {
+	defer s.lock()()
+
+	if _, ok := s.data.credentials[employeeId]; ok {
+		return errs.Conflict(fmt.Errorf("user is already registered"))
+	}
+	s.data.credentials[employeeId] = passwordHash
+
+	return nil
+}
+
+func (s *Storage) CreateInvite(ctx context.Context, employeeId string, createdBy string, tokenHash string, ttl time.Duration) error {
+	defer s.lock()()
+
+	s.data.invites[tokenHash] = invite{
+		employeeId: employeeId,
+		createdBy:  createdBy,
+		expiresAt:  time.Now().Add(ttl),
+	}
+
+	return nil
+}
+
+func (s *Storage) UseInvite(ctx context.Context, tokenHash string) (string, error) {
+	defer s.lock()()
+
+	invite, ok := s.data.invites[tokenHash]
+	if !ok || !invite.expiresAt.After(time.Now()) {
+		return "", errs.Unauthorized(fmt.Errorf("invite is invalid or expired"))
+	}
+	delete(s.data.invites, tokenHash)
+
+	return invite.employeeId, nil
+}
+
+func (s *Storage) CreateSession(ctx context.Context, employeeId string, refreshTokenHash string, ttl time.Duration) (string, error) {
+	defer s.lock()()
+
+	session := session{
+		id:               newId(),
+		employeeId:       employeeId,
+		refreshTokenHash: refreshTokenHash,
+		expiresAt:        time.Now().Add(ttl),
+	}
+	s.data.sessions[session.id] = session
+
+	return session.id, nil
+}
+
+func (s *Storage) ActiveSession(ctx context.Context, sessionId string) (model.SessionDB, error) {
+	defer s.lock()()
+
+	session, ok := s.data.sessions[sessionId]
+	if !ok || !session.active() {
+		return model.SessionDB{}, errs.Unauthorized(fmt.Errorf("session is expired or revoked"))
+	}
+
+	return s.sessionDB(session), nil
+}
+
+func (s *Storage) RotateSession(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, ttl time.Duration) (model.SessionDB, error) {
+	defer s.lock()()
+
+	for id, session := range s.data.sessions {
+		if session.refreshTokenHash != refreshTokenHash || !session.active() {
+			continue
+		}
+		session.refreshTokenHash = newRefreshTokenHash
+		session.expiresAt = time.Now().Add(ttl)
+		s.data.sessions[id] = session
+
+		return s.sessionDB(session), nil
+	}
+
+	return model.SessionDB{}, errs.Unauthorized(fmt.Errorf("refresh token is expired or revoked"))
+}
+
+func (s *Storage) RevokeSession(ctx context.Context, sessionId string) error {
+	defer s.lock()()
+
+	session, ok := s.data.sessions[sessionId]
+	if ok && session.revokedAt == nil {
+		revokedAt := time.Now()
+		session.revokedAt = &revokedAt
+		s.data.sessions[sessionId] = session
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeEmployeeSessions(ctx context.Context, employeeId string) error {
+	defer s.lock()()
+
+	revokedAt := time.Now()
+	for id, session := range s.data.sessions {
+		if session.employeeId == employeeId && session.revokedAt == nil {
+			session.revokedAt = &revokedAt
+			s.data.sessions[id] = session
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) EmployeeIdByName(ctx context.Context, username string) (string, error) {
+	defer s.lock()()
+
+	employee, ok := s.employeeByName(username)
+	if !ok {
+		return "", errs.Unauthorized(fmt.Errorf("user not found"))
+	}
+
+	return employee.Id, nil
+}
+
+func (s session) active() bool {
+	return s.revokedAt == nil && s.expiresAt.After(time.Now())
+}
+
+func (s *Storage) sessionDB(session session) model.SessionDB {
+	return model.SessionDB{
+		Id:         session.id,
+		EmployeeId: session.employeeId,
+		Username:   s.data.employees[session.employeeId].Username,
+		ExpiresAt:  session.expiresAt,
+		RevokedAt:  session.revokedAt,
+	}
+}
@@ -0,0 +1,320 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func (s *Storage) CreateBid(ctx context.Context, name string, description string, tenderId string, authorType string, authorId string, offer *model.Offer) (string, error) {
+	defer s.lock()()
+
+	if _, ok := s.data.tenders[tenderId]; !ok {
+		return "", errs.Internal(fmt.Errorf("tender not found"))
+	}
+
+	bid := model.BidDB{
+		Id:          newId(),
+		Name:        name,
+		Description: description,
+		Status:      "Created",
+		TenderId:    tenderId,
+		AuthorType:  authorType,
+		AuthorId:    authorId,
+		Version:     1,
+		CreatedAt:   now(),
+		Offer:       copyOffer(offer),
+	}
+	s.data.bids[bid.Id] = bid
+	s.addBidEvent(model.EventBidCreated, bid, nil)
+
+	return bid.Id, nil
+}
+
+func (s *Storage) GetBidsById(ctx context.Context, query model.PageQuery, authorIds []string) ([]model.BidDB, int, error) {
+	defer s.lock()()
+
+	var bids []model.BidDB
+	for _, bid := range s.data.bids {
+		if slices.Contains(authorIds, bid.AuthorId) {
+			bids = append(bids, bid)
+		}
+	}
+	bids, total := keysetPage(bids, query)
+
+	return bids, total, nil
+}
+
+func (s *Storage) BidsForTender(ctx context.Context, tenderId string, viewer string, query model.PageQuery) ([]model.BidDB, int, error) {
+	defer s.lock()()
+
+	employee, _ := s.employeeByName(viewer)
+
+	var bids []model.BidDB
+	for _, bid := range s.data.bids {
+		if bid.TenderId != tenderId {
+			continue
+		}
+		if viewer == "" || bid.Status != "Created" || bid.AuthorId == employee.Id {
+			bids = append(bids, bid)
+		}
+	}
+	bids, total := keysetPage(bids, query)
+
+	return bids, total, nil
+}
+
+func (s *Storage) BidStatus(ctx context.Context, bidId string) (string, error) {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return "", errs.Internal(fmt.Errorf("bid not found"))
+	}
+
+	return bid.Status, nil
+}
+
+func (s *Storage) UpdateBidStatus(ctx context.Context, bidId string, status string, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	bid, err := s.bidForUpdate(bidId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	bid.Status = status
+	s.data.bids[bidId] = bid
+	s.addBidEvent(model.EventBidStatusChanged, bid, nil)
+
+	return bidId, nil
+}
+
+func (s *Storage) EditBid(ctx context.Context, bidId string, name string, description string, offer *model.Offer, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	bid, err := s.bidForUpdate(bidId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	if name != "" {
+		bid.Name = name
+	}
+	if description != "" {
+		bid.Description = description
+	}
+	if offer != nil {
+		bid.Offer = copyOffer(offer)
+	}
+	s.data.bids[bidId] = bid
+
+	return bidId, nil
+}
+
+func (s *Storage) SubmitDecision(ctx context.Context, bidId string, responsibleId string, decision string, comment string) error {
+	defer s.lock()()
+
+	if s.hasVoted(bidId, responsibleId) {
+		return errs.Internal(fmt.Errorf("failed to submit decision.."))
+	}
+	s.data.votes[bidId] = append(s.data.votes[bidId], model.DecisionDB{
+		BidId:         bidId,
+		ResponsibleId: responsibleId,
+		Decision:      decision,
+		Comment:       comment,
+		CreatedAt:     now(),
+	})
+
+	return nil
+}
+
+func (s *Storage) Decisions(ctx context.Context, bidId string) ([]model.DecisionDB, error) {
+	defer s.lock()()
+
+	decisions := make([]model.DecisionDB, len(s.data.votes[bidId]))
+	for i, vote := range s.data.votes[bidId] {
+		vote.Username = s.data.employees[vote.ResponsibleId].Username
+		decisions[i] = vote
+	}
+
+	return decisions, nil
+}
+
+func (s *Storage) hasVoted(bidId string, responsibleId string) bool {
+	return slices.ContainsFunc(s.data.votes[bidId], func(vote model.DecisionDB) bool {
+		return vote.ResponsibleId == responsibleId
+	})
+}
+
+func (s *Storage) ApplyDecision(ctx context.Context, bidId string, decision string) error {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return nil
+	}
+	bid.Decision = decision
+	s.data.bids[bidId] = bid
+	s.addBidEvent(model.EventBidDecisionApplied, bid, nil)
+
+	return nil
+}
+
+func (s *Storage) RollbackBid(ctx context.Context, bidId string, version int32, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	old, ok := s.data.bidVersions[bidId][int(version)]
+	if !ok {
+		return "", errs.NotFound(fmt.Errorf("no such bid version"))
+	}
+	bid, err := s.bidForUpdate(bidId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	bid.Name = old.Name
+	bid.Description = old.Description
+	bid.Decision = old.Decision
+	bid.Status = old.Status
+	bid.TenderId = old.TenderId
+	bid.AuthorType = old.AuthorType
+	bid.AuthorId = old.AuthorId
+	bid.Offer = old.Offer
+	bid.Attachments = old.Attachments
+	s.data.bids[bidId] = bid
+
+	return bidId, nil
+}
+
+func (s *Storage) Reviews(ctx context.Context, scope model.ReviewScope, filter model.ReviewFilter, query model.PageQuery) ([]model.Feedback, int, error) {
+	defer s.lock()()
+
+	var reviews []model.Feedback
+	for _, message := range s.data.feedback {
+		bid := s.data.bids[message.BidId]
+		if message.Side == model.SideOrganization && bid.AuthorType == scope.AuthorType && bid.AuthorId == scope.AuthorId &&
+			s.inReviewScope(bid, message, scope, filter) {
+			reviews = append(reviews, model.Feedback{
+				Id:          message.Id,
+				Description: message.Text,
+				CreatedAt:   message.CreatedAt,
+				Ratings:     message.Ratings,
+			})
+		}
+	}
+	query.Sort = model.SortCreatedAt
+	reviews, total := keysetPage(reviews, query)
+
+	return reviews, total, nil
+}
+
+// inReviewScope matches review by tender, organization, bid and creation time like WHERE of Reviews query
+func (s *Storage) inReviewScope(bid model.BidDB, message model.ThreadMessageDB, scope model.ReviewScope, filter model.ReviewFilter) bool {
+	if scope.TenderId != "" && bid.TenderId != scope.TenderId {
+		return false
+	}
+	if scope.OrganizationId != "" && s.data.tenders[bid.TenderId].OrganizationId != scope.OrganizationId {
+		return false
+	}
+	if filter.BidId != "" && bid.Id != filter.BidId {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339Nano, message.CreatedAt)
+	if err != nil {
+		return false
+	}
+	if filter.CreatedFrom != nil && created.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !created.Before(*filter.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+func (s *Storage) Reputation(ctx context.Context, authorType string, authorId string) (model.ReputationDB, error) {
+	defer s.lock()()
+
+	var reputation model.ReputationDB
+	for _, bid := range s.data.bids {
+		if bid.AuthorType != authorType || bid.AuthorId != authorId {
+			continue
+		}
+		switch bid.Decision {
+		case "Approved":
+			reputation.Won++
+		case "Rejected":
+			reputation.Lost++
+		}
+	}
+	for _, message := range s.data.feedback {
+		bid := s.data.bids[message.BidId]
+		if message.Side != model.SideOrganization || bid.AuthorType != authorType || bid.AuthorId != authorId {
+			continue
+		}
+		reputation.Reviews++
+		for criterion, score := range message.Ratings {
+			i := slices.IndexFunc(reputation.Criteria, func(c model.CriterionDB) bool { return c.Criterion == criterion })
+			if i < 0 {
+				i = len(reputation.Criteria)
+				reputation.Criteria = append(reputation.Criteria, model.CriterionDB{Criterion: criterion})
+			}
+			reputation.Criteria[i].Count++
+			reputation.Criteria[i].Sum += score
+		}
+	}
+	slices.SortFunc(reputation.Criteria, func(a, b model.CriterionDB) int { return strings.Compare(a.Criterion, b.Criterion) })
+
+	return reputation, nil
+}
+
+// bidForUpdate checks expected version, saves current state to history
+// and returns bid with bumped version, like UPDATE ... version = version + 1
+func (s *Storage) bidForUpdate(bidId string, expectedVersion int32) (model.BidDB, error) {
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("bid not found"))
+	}
+	if expectedVersion != 0 && int32(bid.Version) != expectedVersion {
+		return model.BidDB{}, errs.VersionMismatch(fmt.Errorf("bid version mismatch"))
+	}
+
+	if s.data.bidVersions[bidId] == nil {
+		s.data.bidVersions[bidId] = map[int]model.BidDB{}
+	}
+	s.data.bidVersions[bidId][bid.Version] = bid
+	bid.Version++
+
+	return bid, nil
+}
+
+func (s *Storage) deleteBid(bidId string) {
+	delete(s.data.bids, bidId)
+	delete(s.data.bidVersions, bidId)
+	delete(s.data.votes, bidId)
+	s.data.feedback = slices.DeleteFunc(s.data.feedback, func(message model.ThreadMessageDB) bool {
+		return message.BidId == bidId
+	})
+	delete(s.data.threadReads, bidId)
+	s.deleteAttachments(model.OwnerBid, bidId)
+}
+
+// copyOffer detaches offer from caller, stored offers are never modified in place
+// so bid snapshots in history and transaction can share them.
+// Numbers get scale of postgres columns, so both repos give them back the same
+func copyOffer(offer *model.Offer) *model.Offer {
+	if offer == nil {
+		return nil
+	}
+	c := *offer
+	c.Amount = offer.Amount.WithScale(model.MoneyScale)
+	c.Items = slices.Clone(offer.Items)
+	for i, item := range c.Items {
+		item.Quantity = item.Quantity.WithScale(model.QuantityScale)
+		item.UnitPrice = item.UnitPrice.WithScale(model.MoneyScale)
+		c.Items[i] = item
+	}
+	return &c
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+// defaultCategories are the service types every catalog starts with, as in migration 0014_categories
+var defaultCategories = []string{"Construction", "Delivery", "Manufacture"}
+
+func (s *Storage) Categories(ctx context.Context) ([]model.CategoryDB, error) {
+	defer s.lock()()
+
+	categories := make([]model.CategoryDB, 0, len(s.data.categories))
+	for _, category := range s.data.categories {
+		categories = append(categories, category)
+	}
+	slices.SortFunc(categories, func(a, b model.CategoryDB) int {
+		return cmp.Compare(a.Code, b.Code)
+	})
+
+	return categories, nil
+}
+
+func (s *Storage) CreateCategory(ctx context.Context, code string, name string, parentCode string) (model.CategoryDB, error) {
+	defer s.lock()()
+
+	if _, ok := s.data.categories[code]; ok {
+		return model.CategoryDB{}, errs.Conflict(fmt.Errorf("category %s already exists", code))
+	}
+	if _, ok := s.data.categories[parentCode]; parentCode != "" && !ok {
+		return model.CategoryDB{}, errs.Internal(fmt.Errorf("parent category %s does not exist", parentCode))
+	}
+	category := model.CategoryDB{
+		Code:       code,
+		Name:       name,
+		ParentCode: parentCode,
+		CreatedAt:  now(),
+	}
+	s.data.categories[code] = category
+
+	return category, nil
+}
+
+func (s *Storage) EditCategory(ctx context.Context, code string, name string, deprecated *bool) (model.CategoryDB, error) {
+	defer s.lock()()
+
+	category, ok := s.data.categories[code]
+	if !ok {
+		return model.CategoryDB{}, errs.NotFound(fmt.Errorf("category not found"))
+	}
+	if name != "" {
+		category.Name = name
+	}
+	if deprecated != nil {
+		category.Deprecated = *deprecated
+	}
+	s.data.categories[code] = category
+
+	return category, nil
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func (s *Storage) Employees(ctx context.Context, query model.PageQuery) ([]model.EmployeeDB, int, error) {
+	defer s.lock()()
+
+	employees := make([]model.EmployeeDB, 0, len(s.data.employees))
+	for _, employee := range s.data.employees {
+		employees = append(employees, employee)
+	}
+	employees, total := keysetPage(employees, query)
+
+	return employees, total, nil
+}
+
+func (s *Storage) Employee(ctx context.Context, employeeId string) (model.EmployeeDB, error) {
+	defer s.lock()()
+
+	employee, ok := s.data.employees[employeeId]
+	if !ok {
+		return model.EmployeeDB{}, errs.NotFound(fmt.Errorf("employee not found"))
+	}
+
+	return employee, nil
+}
+
+func (s *Storage) CreateEmployee(ctx context.Context, username string, firstName string, lastName string) (string, error) {
+	defer s.lock()()
+
+	if _, ok := s.employeeByName(username); ok {
+		return "", errs.Conflict(fmt.Errorf("username is already taken"))
+	}
+
+	createdAt := now()
+	employee := model.EmployeeDB{
+		Id:        newId(),
+		Username:  username,
+		FirstName: firstName,
+		LastName:  lastName,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	s.data.employees[employee.Id] = employee
+
+	return employee.Id, nil
+}
+
+func (s *Storage) EditEmployee(ctx context.Context, employeeId string, firstName string, lastName string) error {
+	defer s.lock()()
+
+	employee, ok := s.data.employees[employeeId]
+	if !ok {
+		return errs.NotFound(fmt.Errorf("employee not found"))
+	}
+	if firstName != "" {
+		employee.FirstName = firstName
+	}
+	if lastName != "" {
+		employee.LastName = lastName
+	}
+	employee.UpdatedAt = now()
+	s.data.employees[employeeId] = employee
+
+	return nil
+}
+
+func (s *Storage) DeleteEmployee(ctx context.Context, employeeId string) error {
+	defer s.lock()()
+
+	employee, ok := s.data.employees[employeeId]
+	if !ok {
+		return errs.NotFound(fmt.Errorf("employee not found"))
+	}
+	for _, tender := range s.data.tenders {
+		if tender.CreatorUsername == employee.Username {
+			return errs.Conflict(fmt.Errorf("employee has created tenders"))
+		}
+	}
+
+	// same cascades as foreign keys in postgres
+	delete(s.data.employees, employeeId)
+	delete(s.data.credentials, employeeId)
+	for id, session := range s.data.sessions {
+		if session.employeeId == employeeId {
+			delete(s.data.sessions, id)
+		}
+	}
+	for hash, invite := range s.data.invites {
+		if invite.employeeId == employeeId {
+			delete(s.data.invites, hash)
+		}
+	}
+	s.data.responsibles = slices.DeleteFunc(s.data.responsibles, func(r responsible) bool {
+		return r.userId == employeeId
+	})
+
+	return nil
+}
+
+func (s *Storage) employeeByName(username string) (model.EmployeeDB, bool) {
+	for _, employee := range s.data.employees {
+		if employee.Username == username {
+			return employee, true
+		}
+	}
+	return model.EmployeeDB{}, false
+}
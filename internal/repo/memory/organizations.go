@@ -0,0 +1,182 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+func (s *Storage) Organizations(ctx context.Context, query model.PageQuery) ([]model.OrganizationDB, int, error) {
+	defer s.lock()()
+
+	organizations := make([]model.OrganizationDB, 0, len(s.data.organizations))
+	for _, organization := range s.data.organizations {
+		organizations = append(organizations, organization)
+	}
+	organizations, total := keysetPage(organizations, query)
+
+	return organizations, total, nil
+}
+
+func (s *Storage) Organization(ctx context.Context, organizationId string) (model.OrganizationDB, error) {
+	defer s.lock()()
+
+	organization, ok := s.data.organizations[organizationId]
+	if !ok {
+		return model.OrganizationDB{}, errs.NotFound(fmt.Errorf("organization not found"))
+	}
+
+	return organization, nil
+}
+
+func (s *Storage) CreateOrganization(ctx context.Context, name string, description string, organizationType string, responsibleId string) (string, error) {
+	defer s.lock()()
+
+	createdAt := now()
+	organization := model.OrganizationDB{
+		Id:          newId(),
+		Name:        name,
+		Description: description,
+		Type:        organizationType,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	s.data.organizations[organization.Id] = organization
+	// creator becomes the first owner, otherwise nobody could manage organization
+	s.data.responsibles = append(s.data.responsibles, responsible{
+		organizationId: organization.Id,
+		userId:         responsibleId,
+		role:           string(policy.RoleOwner),
+	})
+
+	return organization.Id, nil
+}
+
+func (s *Storage) EditOrganization(ctx context.Context, organizationId string, name string, description string, organizationType string) error {
+	defer s.lock()()
+
+	organization, ok := s.data.organizations[organizationId]
+	if !ok {
+		return errs.NotFound(fmt.Errorf("organization not found"))
+	}
+	if name != "" {
+		organization.Name = name
+	}
+	if description != "" {
+		organization.Description = description
+	}
+	if organizationType != "" {
+		organization.Type = organizationType
+	}
+	organization.UpdatedAt = now()
+	s.data.organizations[organizationId] = organization
+
+	return nil
+}
+
+func (s *Storage) DeleteOrganization(ctx context.Context, organizationId string) error {
+	defer s.lock()()
+
+	if _, ok := s.data.organizations[organizationId]; !ok {
+		return errs.NotFound(fmt.Errorf("organization not found"))
+	}
+
+	delete(s.data.organizations, organizationId)
+	s.data.responsibles = slices.DeleteFunc(s.data.responsibles, func(r responsible) bool {
+		return r.organizationId == organizationId
+	})
+	for id, tender := range s.data.tenders {
+		if tender.OrganizationId == organizationId {
+			s.deleteTender(id)
+		}
+	}
+	for id, webhook := range s.data.webhooks {
+		if webhook.OrganizationId == organizationId {
+			s.deleteWebhook(id)
+		}
+	}
+	s.data.events = slices.DeleteFunc(s.data.events, func(e event) bool {
+		return e.organizationId == organizationId
+	})
+
+	return nil
+}
+
+func (s *Storage) Responsibles(ctx context.Context, organizationId string) ([]model.MemberDB, error) {
+	defer s.lock()()
+
+	var responsibles []model.MemberDB
+	for _, r := range s.data.responsibles {
+		if r.organizationId != organizationId {
+			continue
+		}
+		if employee, ok := s.data.employees[r.userId]; ok {
+			responsibles = append(responsibles, model.MemberDB{EmployeeDB: employee, Role: r.role})
+		}
+	}
+	slices.SortFunc(responsibles, func(a, b model.MemberDB) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+
+	return responsibles, nil
+}
+
+func (s *Storage) AddResponsible(ctx context.Context, organizationId string, userId string, role string) error {
+	defer s.lock()()
+
+	if s.isResponsible(organizationId, userId) {
+		return errs.Conflict(fmt.Errorf("user is already responsible for organization"))
+	}
+	s.data.responsibles = append(s.data.responsibles, responsible{
+		organizationId: organizationId,
+		userId:         userId,
+		role:           role,
+	})
+
+	return nil
+}
+
+func (s *Storage) SetRole(ctx context.Context, organizationId string, userId string, role string) error {
+	defer s.lock()()
+
+	for i, r := range s.data.responsibles {
+		if r.organizationId == organizationId && r.userId == userId {
+			s.data.responsibles[i].role = role
+			return nil
+		}
+	}
+
+	return errs.NotFound(fmt.Errorf("user is not responsible for organization"))
+}
+
+func (s *Storage) RemoveResponsible(ctx context.Context, organizationId string, userId string) error {
+	defer s.lock()()
+
+	if !s.isResponsible(organizationId, userId) {
+		return errs.NotFound(fmt.Errorf("user is not responsible for organization"))
+	}
+	s.data.responsibles = slices.DeleteFunc(s.data.responsibles, func(r responsible) bool {
+		return r.organizationId == organizationId && r.userId == userId
+	})
+
+	return nil
+}
+
+// isResponsible tells about membership with any role
+func (s *Storage) isResponsible(organizationId string, userId string) bool {
+	_, ok := s.roleOf(organizationId, userId)
+	return ok
+}
+
+func (s *Storage) roleOf(organizationId string, userId string) (string, bool) {
+	for _, r := range s.data.responsibles {
+		if r.organizationId == organizationId && r.userId == userId {
+			return r.role, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"zadanie-6105/internal/domain/model"
+)
+
+// there is no stemming here, a word matches if name or description contains it,
+// every word of the query must match
+var queryWord = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+func (s *Storage) SearchTenders(ctx context.Context, filter model.SearchFilter, organizationIds []string, query model.PageQuery) ([]model.TenderSearchDB, int, error) {
+	defer s.lock()()
+
+	words := searchWords(filter.Query)
+
+	var results []model.TenderSearchDB
+	for _, tender := range s.data.tenders {
+		if tender.Status != "Published" && !slices.Contains(organizationIds, tender.OrganizationId) {
+			continue
+		}
+		if !matchesFilter(filter, tender.Status, tender.OrganizationId, tender.ServiceType, tender.CreatedAt) {
+			continue
+		}
+		rank, ok := searchRank(words, tender.Name, tender.Description)
+		if !ok {
+			continue
+		}
+		results = append(results, model.TenderSearchDB{
+			TenderDB:             tender,
+			Rank:                 rank,
+			NameHighlight:        highlight(tender.Name, words),
+			DescriptionHighlight: highlight(tender.Description, words),
+		})
+	}
+	query.Sort = model.SortRank
+	results, total := keysetPage(results, query)
+
+	return results, total, nil
+}
+
+func (s *Storage) SearchBids(ctx context.Context, filter model.SearchFilter, authorIds []string, organizationIds []string, query model.PageQuery) ([]model.BidSearchDB, int, error) {
+	defer s.lock()()
+
+	words := searchWords(filter.Query)
+
+	var results []model.BidSearchDB
+	for _, bid := range s.data.bids {
+		tender := s.data.tenders[bid.TenderId]
+		visible := slices.Contains(authorIds, bid.AuthorId) ||
+			(bid.Status != "Created" && slices.Contains(organizationIds, tender.OrganizationId))
+		if !visible {
+			continue
+		}
+		if !matchesFilter(filter, bid.Status, tender.OrganizationId, tender.ServiceType, bid.CreatedAt) {
+			continue
+		}
+		rank, ok := searchRank(words, bid.Name, bid.Description)
+		if !ok {
+			continue
+		}
+		results = append(results, model.BidSearchDB{
+			BidDB:                bid,
+			Rank:                 rank,
+			NameHighlight:        highlight(bid.Name, words),
+			DescriptionHighlight: highlight(bid.Description, words),
+		})
+	}
+	query.Sort = model.SortRank
+	results, total := keysetPage(results, query)
+
+	return results, total, nil
+}
+
+func matchesFilter(filter model.SearchFilter, status string, organizationId string, serviceType string, createdAt string) bool {
+	if len(filter.Statuses) != 0 && !slices.Contains(filter.Statuses, status) {
+		return false
+	}
+	if filter.OrganizationId != "" && filter.OrganizationId != organizationId {
+		return false
+	}
+	if len(filter.ServiceTypes) != 0 && !slices.Contains(filter.ServiceTypes, serviceType) {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return false
+	}
+	if filter.CreatedFrom != nil && created.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !created.Before(*filter.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+func searchWords(query string) []string {
+	return queryWord.FindAllString(strings.ToLower(query), -1)
+}
+
+// searchRank weighs name matches like postgres weight A over B for description
+func searchRank(words []string, name string, description string) (float64, bool) {
+	if len(words) == 0 {
+		return 0, false
+	}
+	name, description = strings.ToLower(name), strings.ToLower(description)
+
+	var rank float64
+	for _, word := range words {
+		inName, inDescription := strings.Count(name, word), strings.Count(description, word)
+		if inName == 0 && inDescription == 0 {
+			return 0, false
+		}
+		rank += float64(inName) + 0.4*float64(inDescription)
+	}
+	return rank, true
+}
+
+func highlight(text string, words []string) string {
+	lower := strings.ToLower(text)
+	// offsets below are shared by both strings
+	if len(lower) != len(text) {
+		return text
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		matched := 0
+		for _, word := range words {
+			if strings.HasPrefix(lower[i:], word) && len(word) > matched {
+				matched = len(word)
+			}
+		}
+		if matched == 0 {
+			b.WriteByte(text[i])
+			i++
+			continue
+		}
+		b.WriteString(model.HighlightStart + text[i:i+matched] + model.HighlightStop)
+		i += matched
+	}
+	return b.String()
+}
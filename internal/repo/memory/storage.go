@@ -0,0 +1,245 @@
+package memory
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+	"zadanie-6105/internal/lib/cursor"
+	"zadanie-6105/internal/repo"
+)
+
+var (
+	_ repo.Checkers   = (*Storage)(nil)
+	_ repo.Transactor = (*Storage)(nil)
+	_ repo.Tx         = (*Storage)(nil)
+)
+
+// Storage keeps everything in memory, it mirrors postgres.Storage behaviour
+// and is used for unit tests and demos without database
+type Storage struct {
+	log *slog.Logger
+
+	mu   *sync.Mutex
+	data *state
+	// inTx is set for storage passed to InTransaction, mu is already held then
+	inTx bool
+}
+
+type responsible struct {
+	organizationId string
+	userId         string
+	role           string
+}
+
+type session struct {
+	id               string
+	employeeId       string
+	refreshTokenHash string
+	expiresAt        time.Time
+	revokedAt        *time.Time
+}
+
+type invite struct {
+	employeeId string
+	createdBy  string
+	expiresAt  time.Time
+}
+
+type state struct {
+	organizations map[string]model.OrganizationDB
+	employees     map[string]model.EmployeeDB
+	// slice keeps insertion order, so CheckMemberships is stable
+	responsibles []responsible
+	credentials  map[string]string
+	sessions     map[string]session
+	// invites are by hash of token
+	invites map[string]invite
+
+	tenders        map[string]model.TenderDB
+	tenderVersions map[string]map[int]model.TenderDB
+	bids           map[string]model.BidDB
+	bidVersions    map[string]map[int]model.BidDB
+	// votes are decisions of responsibles by bid in order they were submitted
+	votes            map[string][]model.DecisionDB
+	decisionPolicies map[string]quorum.Policy
+	// feedback is messages of all threads in order they were posted,
+	// threadReads is when employee read thread of bid last time
+	feedback    []model.ThreadMessageDB
+	threadReads map[string]map[string]time.Time
+
+	// events and deliveries are kept in insertion order
+	events     []event
+	webhooks   map[string]model.WebhookDB
+	deliveries []model.WebhookDeliveryDB
+
+	// attachments stay after they are removed from owner, old versions refer to them
+	attachments map[string]model.AttachmentDB
+
+	// categories are service types of tenders by code
+	categories map[string]model.CategoryDB
+}
+
+func New(log *slog.Logger) *Storage {
+	categories := make(map[string]model.CategoryDB, len(defaultCategories))
+	for _, code := range defaultCategories {
+		categories[code] = model.CategoryDB{Code: code, Name: code, CreatedAt: now()}
+	}
+
+	return &Storage{
+		log: log,
+		mu:  &sync.Mutex{},
+		data: &state{
+			organizations:    map[string]model.OrganizationDB{},
+			employees:        map[string]model.EmployeeDB{},
+			credentials:      map[string]string{},
+			sessions:         map[string]session{},
+			invites:          map[string]invite{},
+			tenders:          map[string]model.TenderDB{},
+			tenderVersions:   map[string]map[int]model.TenderDB{},
+			bids:             map[string]model.BidDB{},
+			bidVersions:      map[string]map[int]model.BidDB{},
+			votes:            map[string][]model.DecisionDB{},
+			decisionPolicies: map[string]quorum.Policy{},
+			threadReads:      map[string]map[string]time.Time{},
+			webhooks:         map[string]model.WebhookDB{},
+			attachments:      map[string]model.AttachmentDB{},
+			categories:       categories,
+		},
+	}
+}
+
+// lock returns unlock func, inside transaction the lock is already taken
+func (s *Storage) lock() func() {
+	if s.inTx {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// InTransaction holds the lock for the whole fn, so transactions are serializable,
+// state is restored from snapshot if fn returns error
+func (s *Storage) InTransaction(ctx context.Context, fn func(tx repo.Tx) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.data.clone()
+	err := fn(&Storage{
+		log:  s.log,
+		mu:   s.mu,
+		data: s.data,
+		inTx: true,
+	})
+	if err != nil {
+		s.log.Debug("rolling back transaction", slog.String("op", "Memory.InTransaction"))
+		*s.data = *snapshot
+		return err
+	}
+
+	return nil
+}
+
+func (d *state) clone() *state {
+	c := &state{
+		organizations:    maps.Clone(d.organizations),
+		employees:        maps.Clone(d.employees),
+		responsibles:     slices.Clone(d.responsibles),
+		credentials:      maps.Clone(d.credentials),
+		sessions:         maps.Clone(d.sessions),
+		invites:          maps.Clone(d.invites),
+		tenders:          maps.Clone(d.tenders),
+		tenderVersions:   make(map[string]map[int]model.TenderDB, len(d.tenderVersions)),
+		bids:             maps.Clone(d.bids),
+		bidVersions:      make(map[string]map[int]model.BidDB, len(d.bidVersions)),
+		votes:            make(map[string][]model.DecisionDB, len(d.votes)),
+		decisionPolicies: maps.Clone(d.decisionPolicies),
+		feedback:         slices.Clone(d.feedback),
+		threadReads:      make(map[string]map[string]time.Time, len(d.threadReads)),
+		events:           slices.Clone(d.events),
+		webhooks:         maps.Clone(d.webhooks),
+		deliveries:       slices.Clone(d.deliveries),
+		attachments:      maps.Clone(d.attachments),
+		categories:       maps.Clone(d.categories),
+	}
+	for id, versions := range d.tenderVersions {
+		c.tenderVersions[id] = maps.Clone(versions)
+	}
+	for id, versions := range d.bidVersions {
+		c.bidVersions[id] = maps.Clone(versions)
+	}
+	for id, votes := range d.votes {
+		c.votes[id] = slices.Clone(votes)
+	}
+	for id, reads := range d.threadReads {
+		c.threadReads[id] = maps.Clone(reads)
+	}
+	return c
+}
+
+// newId returns random uuid v4, request validators expect exactly this version
+func newId() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// now is formatted the same way database/sql scans timestamps into strings
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// page applies limit and offset the way postgres queries do, limit 0 means no limit
+func page[T any](items []T, limit int32, offset int32) []T {
+	if int(offset) >= len(items) {
+		return nil
+	}
+	items = items[offset:]
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+type sortable interface {
+	SortKey(sort string) (string, string)
+}
+
+// keysetPage sorts items like ORDER BY sort field, id and returns page after cursor
+// together with total count of items
+func keysetPage[T sortable](items []T, query model.PageQuery) ([]T, int) {
+	compare := func(aValue string, aId string, bValue string, bId string) int {
+		c := cursor.Compare(query.Sort, aValue, aId, bValue, bId)
+		if query.Desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(items, func(a, b T) int {
+		aValue, aId := a.SortKey(query.Sort)
+		bValue, bId := b.SortKey(query.Sort)
+		return compare(aValue, aId, bValue, bId)
+	})
+	total := len(items)
+
+	if query.After != nil {
+		items = slices.DeleteFunc(items, func(item T) bool {
+			value, id := item.SortKey(query.Sort)
+			return compare(value, id, query.After.Value, query.After.Id) <= 0
+		})
+	}
+
+	return page(items, query.Limit, query.Offset), total
+}
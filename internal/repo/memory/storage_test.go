@@ -0,0 +1,750 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+	"zadanie-6105/internal/repo"
+)
+
+func newStorage(t *testing.T) *Storage {
+	t.Helper()
+	return New(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+}
+
+func requireKind(t *testing.T, err error, kind errs.Kind) {
+	t.Helper()
+	var domainErr *errs.Error
+	require.True(t, errors.As(err, &domainErr), "expected domain error, got %v", err)
+	require.Equal(t, kind, domainErr.Kind)
+}
+
+// seed creates organization with one responsible and a published tender
+func seed(t *testing.T, s *Storage) (employeeId string, organizationId string, tenderId string) {
+	t.Helper()
+	ctx := context.Background()
+
+	employeeId, err := s.CreateEmployee(ctx, "boss", "Ivan", "Ivanov")
+	require.NoError(t, err)
+	organizationId, err = s.CreateOrganization(ctx, "Org", "", "LLC", employeeId)
+	require.NoError(t, err)
+	tenderId, err = s.CreateTender(ctx, "Tender", "desc", "Delivery", organizationId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+	_, err = s.ChangeTenderStatus(ctx, tenderId, "Published", 0)
+	require.NoError(t, err)
+
+	return employeeId, organizationId, tenderId
+}
+
+func TestTenderVersions(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, _, tenderId := seed(t, s)
+
+	_, err := s.EditTender(ctx, tenderId, "Renamed", "", "", nil, nil, 0)
+	require.NoError(t, err)
+
+	tender, err := s.CheckTender(ctx, tenderId)
+	require.NoError(t, err)
+	assert.Equal(t, 3, tender.Version)
+	assert.Equal(t, "Renamed", tender.Name)
+	assert.Equal(t, "desc", tender.Description)
+
+	require.NoError(t, s.CheckTenderVersion(ctx, tenderId, 1))
+	require.NoError(t, s.CheckTenderVersion(ctx, tenderId, 2))
+	requireKind(t, s.CheckTenderVersion(ctx, tenderId, 3), errs.KindNotFound)
+
+	_, err = s.RollbackTender(ctx, tenderId, 1, 0)
+	require.NoError(t, err)
+
+	tender, err = s.CheckTender(ctx, tenderId)
+	require.NoError(t, err)
+	assert.Equal(t, 4, tender.Version)
+	assert.Equal(t, "Tender", tender.Name)
+	assert.Equal(t, "Created", tender.Status)
+}
+
+func TestVersionsIncludeCurrent(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, _, tenderId := seed(t, s)
+
+	versions, err := s.TenderVersions(ctx, tenderId)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, []string{"Created", "Published"}, []string{versions[0].Status, versions[1].Status})
+
+	current, err := s.TenderVersion(ctx, tenderId, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "Published", current.Status)
+	_, err = s.TenderVersion(ctx, tenderId, 3)
+	requireKind(t, err, errs.KindNotFound)
+}
+
+func TestExpiredTenders(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, _, tenderId := seed(t, s)
+
+	deadline := time.Now().Add(time.Hour)
+	_, err := s.EditTender(ctx, tenderId, "", "", "", nil, &deadline, 0)
+	require.NoError(t, err)
+
+	expired, err := s.ExpiredTenders(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	expired, err = s.ExpiredTenders(ctx, deadline)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, tenderId, expired[0].Id)
+
+	// deadline is versioned like any other field
+	_, err = s.RollbackTender(ctx, tenderId, 2, 0)
+	require.NoError(t, err)
+	expired, err = s.ExpiredTenders(ctx, deadline)
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+}
+
+func TestConditionalUpdate(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, _, tenderId := seed(t, s)
+
+	_, err := s.EditTender(ctx, tenderId, "Stale", "", "", nil, nil, 1)
+	requireKind(t, err, errs.KindVersionMismatch)
+
+	_, err = s.EditTender(ctx, tenderId, "Fresh", "", "", nil, nil, 2)
+	require.NoError(t, err)
+
+	tender, err := s.CheckTender(ctx, tenderId)
+	require.NoError(t, err)
+	assert.Equal(t, "Fresh", tender.Name)
+	assert.Equal(t, 3, tender.Version)
+}
+
+func TestBidOfferVersions(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, tenderId := seed(t, s)
+
+	offer := &model.Offer{
+		Amount:   "1000.10",
+		Currency: "RUB",
+		Items:    []model.OfferItem{{Name: "Cement", Quantity: "10", UnitPrice: "100"}},
+	}
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, offer)
+	require.NoError(t, err)
+	offer.Items[0].Name = "changed by caller"
+
+	_, err = s.EditBid(ctx, bidId, "", "", &model.Offer{Amount: "900", Currency: "RUB", WarrantyMonths: 12}, 0)
+	require.NoError(t, err)
+	_, err = s.EditBid(ctx, bidId, "Renamed", "", nil, 0)
+	require.NoError(t, err)
+
+	bid, err := s.CheckBid(ctx, bidId)
+	require.NoError(t, err)
+	require.NotNil(t, bid.Offer)
+	assert.Equal(t, model.Decimal("900.00"), bid.Offer.Amount)
+	assert.Equal(t, int32(12), bid.Offer.WarrantyMonths)
+	assert.Empty(t, bid.Offer.Items)
+
+	_, err = s.RollbackBid(ctx, bidId, 1, 0)
+	require.NoError(t, err)
+
+	bid, err = s.CheckBid(ctx, bidId)
+	require.NoError(t, err)
+	require.NotNil(t, bid.Offer)
+	assert.Equal(t, model.Decimal("1000.10"), bid.Offer.Amount)
+	assert.Equal(t, []model.OfferItem{{Name: "Cement", Quantity: "10.000", UnitPrice: "100.00"}}, bid.Offer.Items)
+}
+
+func TestInTransactionRollsBack(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, tenderId := seed(t, s)
+
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, nil)
+	require.NoError(t, err)
+
+	failure := errors.New("failure")
+	err = s.InTransaction(ctx, func(tx repo.Tx) error {
+		if err := tx.SubmitDecision(ctx, bidId, employeeId, "Approved", ""); err != nil {
+			return err
+		}
+		if err := tx.ApplyDecision(ctx, bidId, "Approved"); err != nil {
+			return err
+		}
+		if _, err := tx.ChangeTenderStatus(ctx, tenderId, "Closed", 0); err != nil {
+			return err
+		}
+		return failure
+	})
+	require.ErrorIs(t, err, failure)
+
+	bid, err := s.CheckBid(ctx, bidId)
+	require.NoError(t, err)
+	assert.Empty(t, bid.Decision)
+
+	count, err := s.CheckBidDecisionCount(ctx, bidId, "Approved")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	tender, err := s.CheckTender(ctx, tenderId)
+	require.NoError(t, err)
+	assert.Equal(t, "Published", tender.Status)
+	assert.Equal(t, 2, tender.Version)
+	requireKind(t, s.CheckTenderVersion(ctx, tenderId, 2), errs.KindNotFound)
+}
+
+func TestInTransactionCommits(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, tenderId := seed(t, s)
+
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, nil)
+	require.NoError(t, err)
+
+	err = s.InTransaction(ctx, func(tx repo.Tx) error {
+		if _, err := tx.LockTender(ctx, tenderId); err != nil {
+			return err
+		}
+		if _, err := tx.LockBid(ctx, bidId); err != nil {
+			return err
+		}
+		return tx.SubmitDecision(ctx, bidId, employeeId, "Approved", "fits the budget")
+	})
+	require.NoError(t, err)
+
+	count, err := s.CheckBidDecisionCount(ctx, bidId, "Approved")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = s.CheckBidDecisionCount(ctx, bidId, "Rejected")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	decisions, err := s.Decisions(ctx, bidId)
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	assert.Equal(t, "boss", decisions[0].Username)
+	assert.Equal(t, "fits the budget", decisions[0].Comment)
+	requireKind(t, s.CheckSameSubmitter(ctx, bidId, "boss"), errs.KindForbidden)
+}
+
+func TestDecisionPolicy(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, organizationId, tenderId := seed(t, s)
+
+	decisionPolicy, err := s.CheckDecisionPolicy(ctx, tenderId)
+	require.NoError(t, err)
+	assert.Equal(t, quorum.Default(), decisionPolicy)
+
+	approvers := quorum.Policy{Kind: quorum.Approvers, Approvers: []string{employeeId}}
+	otherTenderId, err := s.CreateTender(ctx, "Other", "desc", "Delivery", organizationId, "boss", nil, nil, approvers)
+	require.NoError(t, err)
+	decisionPolicy, err = s.CheckDecisionPolicy(ctx, otherTenderId)
+	require.NoError(t, err)
+	assert.Equal(t, approvers, decisionPolicy)
+
+	require.NoError(t, s.DeleteOrganization(ctx, organizationId))
+	_, err = s.CheckDecisionPolicy(ctx, otherTenderId)
+	requireKind(t, err, errs.KindNotFound)
+}
+
+func TestDeleteOrganizationCascades(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, organizationId, tenderId := seed(t, s)
+
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, nil)
+	require.NoError(t, err)
+	_, err = s.PostMessage(ctx, bidId, employeeId, "Organization", "good", nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteOrganization(ctx, organizationId))
+
+	_, err = s.CheckTender(ctx, tenderId)
+	requireKind(t, err, errs.KindNotFound)
+	_, err = s.CheckBid(ctx, bidId)
+	requireKind(t, err, errs.KindNotFound)
+	memberships, err := s.CheckMemberships(ctx, employeeId)
+	require.NoError(t, err)
+	assert.Empty(t, memberships)
+
+	reviews, _, err := s.Reviews(ctx, model.ReviewScope{AuthorType: "User", AuthorId: employeeId}, model.ReviewFilter{}, model.PageQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, reviews)
+}
+
+func TestDeleteEmployee_KeepsTenders(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, tenderId := seed(t, s)
+
+	err := s.DeleteEmployee(ctx, employeeId)
+	requireKind(t, err, errs.KindConflict)
+	_, err = s.CheckTender(ctx, tenderId)
+	require.NoError(t, err)
+
+	otherId, err := s.CreateEmployee(ctx, "other", "", "")
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteEmployee(ctx, otherId))
+}
+
+func TestBidAccessChecks(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, _, tenderId := seed(t, s)
+
+	authorId, err := s.CreateEmployee(ctx, "author", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateEmployee(ctx, "stranger", "", "")
+	require.NoError(t, err)
+
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", authorId, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CheckBidAuthorByUsername(ctx, bidId, "author"))
+	requireKind(t, s.CheckBidAuthorByUsername(ctx, bidId, "stranger"), errs.KindForbidden)
+}
+
+func TestRoles(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	ownerId, organizationId, _ := seed(t, s)
+
+	reviewerId, err := s.CreateEmployee(ctx, "reviewer", "", "")
+	require.NoError(t, err)
+	require.NoError(t, s.AddResponsible(ctx, organizationId, reviewerId, "reviewer"))
+
+	role, err := s.CheckRole(ctx, organizationId, ownerId)
+	require.NoError(t, err)
+	assert.Equal(t, "owner", role)
+	role, err = s.CheckRole(ctx, organizationId, reviewerId)
+	require.NoError(t, err)
+	assert.Equal(t, "reviewer", role)
+
+	count, err := s.CheckResponsibleCount(ctx, organizationId, []string{"owner"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, s.SetRole(ctx, organizationId, reviewerId, "owner"))
+	count, err = s.CheckResponsibleCount(ctx, organizationId, []string{"owner"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	members, err := s.Responsibles(ctx, organizationId)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "owner", members[1].Role)
+
+	require.NoError(t, s.RemoveResponsible(ctx, organizationId, reviewerId))
+	_, err = s.CheckRole(ctx, organizationId, reviewerId)
+	requireKind(t, err, errs.KindForbidden)
+	requireKind(t, s.SetRole(ctx, organizationId, reviewerId, "viewer"), errs.KindNotFound)
+}
+
+func TestOutboxFanOut(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, organizationId, tenderId := seed(t, s)
+
+	allId, err := s.CreateWebhook(ctx, organizationId, "https://example.com/all", "secret", nil)
+	require.NoError(t, err)
+	bidsId, err := s.CreateWebhook(ctx, organizationId, "https://example.com/bids", "secret", []string{model.EventBidCreated})
+	require.NoError(t, err)
+
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, nil)
+	require.NoError(t, err)
+	// events of rolled back transaction are never dispatched
+	_ = s.InTransaction(ctx, func(tx repo.Tx) error {
+		_, err := tx.UpdateBidStatus(ctx, bidId, "Published", 0)
+		require.NoError(t, err)
+		return errors.New("rollback")
+	})
+
+	dispatched, err := s.FanOutEvents(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, dispatched)
+	dispatched, err = s.FanOutEvents(ctx, 0)
+	require.NoError(t, err)
+	assert.Zero(t, dispatched)
+
+	all, total, err := s.WebhookDeliveries(ctx, allId, model.PageQuery{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, 3, total)
+	bids, _, err := s.WebhookDeliveries(ctx, bidsId, model.PageQuery{})
+	require.NoError(t, err)
+	require.Len(t, bids, 1)
+	assert.Equal(t, model.EventBidCreated, bids[0].EventType)
+
+	now := time.Now()
+	claimed, err := s.ClaimDeliveries(ctx, now, time.Minute, 0)
+	require.NoError(t, err)
+	require.Len(t, claimed, 4)
+	assert.Contains(t, string(claimed[0].Payload), organizationId)
+
+	// claimed deliveries are leased
+	again, err := s.ClaimDeliveries(ctx, now, time.Minute, 0)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	require.NoError(t, s.RecordDelivery(ctx, bids[0].Id, model.DeliveryDelivered, 1, http.StatusOK, "", now))
+	again, err = s.ClaimDeliveries(ctx, now.Add(2*time.Minute), time.Minute, 0)
+	require.NoError(t, err)
+	assert.Len(t, again, 3)
+
+	require.NoError(t, s.DeleteWebhook(ctx, allId))
+	all, _, err = s.WebhookDeliveries(ctx, allId, model.PageQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func TestPage(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, items, page(items, 0, 0))
+	assert.Equal(t, []int{2, 3}, page(items, 2, 1))
+	assert.Equal(t, []int{5}, page(items, 10, 4))
+	assert.Empty(t, page(items, 1, 5))
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, organizationId, _ := seed(t, s)
+
+	hiddenId, err := s.CreateTender(ctx, "Delivery of tender goods", "tender for goods", "Delivery", organizationId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+
+	relevant := model.PageQuery{Desc: true}
+	results, total, err := s.SearchTenders(ctx, model.SearchFilter{Query: "tender"}, []string{organizationId}, relevant)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, 2, total)
+	// description match ranks hidden tender higher
+	assert.Equal(t, hiddenId, results[0].Id)
+	assert.Equal(t, "Delivery of "+model.HighlightStart+"tender"+model.HighlightStop+" goods", results[0].NameHighlight)
+	assert.Equal(t, model.HighlightStart+"Tender"+model.HighlightStop, results[1].NameHighlight)
+
+	results, _, err = s.SearchTenders(ctx, model.SearchFilter{Query: "tender"}, nil, relevant)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.NotEqual(t, hiddenId, results[0].Id)
+
+	results, _, err = s.SearchTenders(ctx, model.SearchFilter{Query: "tender goods", Statuses: []string{"Created"}}, []string{organizationId}, relevant)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, hiddenId, results[0].Id)
+}
+
+func TestKeysetPages(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	_, organizationId, _ := seed(t, s)
+	for _, name := range []string{"B", "A", "C"} {
+		tenderId, err := s.CreateTender(ctx, name, "desc", "Delivery", organizationId, "boss", nil, nil, quorum.Default())
+		require.NoError(t, err)
+		_, err = s.ChangeTenderStatus(ctx, tenderId, "Published", 0)
+		require.NoError(t, err)
+	}
+	_, err := s.CreateTender(ctx, "Hidden", "desc", "Delivery", organizationId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+
+	query := model.PageQuery{Limit: 2, Sort: model.SortName, Desc: true}
+	tenders, total, err := s.Tenders(ctx, query, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	require.Len(t, tenders, 2)
+	assert.Equal(t, []string{"Tender", "C"}, []string{tenders[0].Name, tenders[1].Name})
+
+	value, id := tenders[1].SortKey(query.Sort)
+	query.After = &model.Cursor{Sort: query.Sort, Desc: true, Value: value, Id: id}
+	tenders, total, err = s.Tenders(ctx, query, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	require.Len(t, tenders, 2)
+	assert.Equal(t, []string{"B", "A"}, []string{tenders[0].Name, tenders[1].Name})
+}
+
+func TestBidsOfSeveralAuthors(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, organizationId, tenderId := seed(t, s)
+
+	for _, bid := range []struct{ name, authorType, authorId string }{
+		{"A", "User", employeeId},
+		{"B", "Organization", organizationId},
+		{"C", "User", employeeId},
+	} {
+		_, err := s.CreateBid(ctx, bid.name, "desc", tenderId, bid.authorType, bid.authorId, nil)
+		require.NoError(t, err)
+	}
+
+	authors := []string{employeeId, organizationId}
+	bids, total, err := s.GetBidsById(ctx, model.PageQuery{Limit: 2, Sort: model.SortName}, authors)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, bids, 2)
+	assert.Equal(t, []string{"A", "B"}, []string{bids[0].Name, bids[1].Name})
+
+	bids, _, err = s.GetBidsById(ctx, model.PageQuery{Limit: 2, Offset: 2, Sort: model.SortName}, authors)
+	require.NoError(t, err)
+	require.Len(t, bids, 1)
+	assert.Equal(t, "C", bids[0].Name)
+}
+
+func TestMemberships(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, organizationId, tenderId := seed(t, s)
+
+	otherId, err := s.CreateOrganization(ctx, "Other", "", "LLC", employeeId)
+	require.NoError(t, err)
+	require.NoError(t, s.SetRole(ctx, otherId, employeeId, "viewer"))
+	otherTenderId, err := s.CreateTender(ctx, "Other tender", "desc", "Delivery", otherId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+
+	memberships, err := s.CheckMemberships(ctx, employeeId)
+	require.NoError(t, err)
+	require.Len(t, memberships, 2)
+	assert.Equal(t, organizationId, memberships[0].Id)
+	assert.Equal(t, "owner", memberships[0].Role)
+	assert.Equal(t, otherId, memberships[1].Id)
+	assert.Equal(t, "viewer", memberships[1].Role)
+
+	tenders, total, err := s.TendersByOrganizations(ctx, model.PageQuery{Sort: model.SortName}, []string{organizationId, otherId})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.ElementsMatch(t, []string{tenderId, otherTenderId}, []string{tenders[0].Id, tenders[1].Id})
+
+	_, total, err = s.TendersByOrganizations(ctx, model.PageQuery{Sort: model.SortName}, []string{otherId})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
+
+func TestThread(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	bossId, _, tenderId := seed(t, s)
+
+	bidderId, err := s.CreateEmployee(ctx, "bidder", "Petr", "Petrov")
+	require.NoError(t, err)
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", bidderId, nil)
+	require.NoError(t, err)
+
+	question, err := s.PostMessage(ctx, bidId, bossId, "Organization", "@bidder cheaper?", []string{"bidder"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "boss", question.AuthorUsername)
+
+	messages, total, err := s.Thread(ctx, bidId, bidderId, model.PageQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.True(t, messages[0].Unread)
+	assert.Equal(t, []string{"bidder"}, messages[0].Mentions)
+
+	require.NoError(t, s.MarkThreadRead(ctx, bidId, bidderId))
+	_, err = s.PostMessage(ctx, bidId, bidderId, "Bidder", "no", nil, nil)
+	require.NoError(t, err)
+
+	messages, total, err = s.Thread(ctx, bidId, bidderId, model.PageQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	assert.False(t, messages[0].Unread)
+	assert.False(t, messages[1].Unread, "own messages are never unread")
+
+	messages, _, err = s.Thread(ctx, bidId, bossId, model.PageQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, true}, []bool{messages[0].Unread, messages[1].Unread})
+
+	// replies of bidder are not reviews
+	reviews, total, err := s.Reviews(ctx, model.ReviewScope{AuthorType: "User", AuthorId: bidderId}, model.ReviewFilter{}, model.PageQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.Equal(t, question.Id, reviews[0].Id)
+}
+
+func TestReputation(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	bossId, _, tenderId := seed(t, s)
+
+	bidderId, err := s.CreateEmployee(ctx, "bidder", "Petr", "Petrov")
+	require.NoError(t, err)
+	won, err := s.CreateBid(ctx, "Won", "desc", tenderId, "User", bidderId, nil)
+	require.NoError(t, err)
+	lost, err := s.CreateBid(ctx, "Lost", "desc", tenderId, "User", bidderId, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.ApplyDecision(ctx, won, "Approved"))
+	require.NoError(t, s.ApplyDecision(ctx, lost, "Rejected"))
+
+	_, err = s.PostMessage(ctx, won, bossId, "Organization", "fine", nil, map[string]int{"quality": 5, "price": 2})
+	require.NoError(t, err)
+	_, err = s.PostMessage(ctx, lost, bossId, "Organization", "slow", nil, map[string]int{"quality": 3})
+	require.NoError(t, err)
+	_, err = s.PostMessage(ctx, lost, bidderId, "Bidder", "sorry", nil, nil)
+	require.NoError(t, err)
+
+	reputation, err := s.Reputation(ctx, "User", bidderId)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReputationDB{
+		Won:     1,
+		Lost:    1,
+		Reviews: 2,
+		Criteria: []model.CriterionDB{
+			{Criterion: "price", Count: 1, Sum: 2},
+			{Criterion: "quality", Count: 2, Sum: 8},
+		},
+	}, reputation)
+
+	reviews, _, err := s.Reviews(ctx, model.ReviewScope{AuthorType: "User", AuthorId: bidderId}, model.ReviewFilter{}, model.PageQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"quality": 5, "price": 2}, reviews[0].Ratings)
+}
+
+func TestReviewsScope(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	bossId, organizationId, tenderId := seed(t, s)
+
+	otherTenderId, err := s.CreateTender(ctx, "Other", "desc", "Delivery", organizationId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+	supplierId, err := s.CreateOrganization(ctx, "Supplier", "", "LLC", bossId)
+	require.NoError(t, err)
+	foreignTenderId, err := s.CreateTender(ctx, "Foreign", "desc", "Delivery", supplierId, "boss", nil, nil, quorum.Default())
+	require.NoError(t, err)
+	bidderId, err := s.CreateEmployee(ctx, "bidder", "Petr", "Petrov")
+	require.NoError(t, err)
+
+	review := func(tenderId string, authorType string, authorId string) string {
+		bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, authorType, authorId, nil)
+		require.NoError(t, err)
+		_, err = s.PostMessage(ctx, bidId, bossId, "Organization", "ok", nil, nil)
+		require.NoError(t, err)
+		return bidId
+	}
+	bidId := review(tenderId, "User", bidderId)
+	review(otherTenderId, "User", bidderId)
+	review(foreignTenderId, "User", bidderId)
+	review(tenderId, "Organization", supplierId)
+
+	user := model.ReviewScope{AuthorType: "User", AuthorId: bidderId}
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name   string
+		scope  model.ReviewScope
+		filter model.ReviewFilter
+		want   int
+	}{
+		{name: "everywhere", scope: user, want: 3},
+		{name: "tender", scope: model.ReviewScope{AuthorType: "User", AuthorId: bidderId, TenderId: tenderId}, want: 1},
+		{name: "organization", scope: model.ReviewScope{AuthorType: "User", AuthorId: bidderId, OrganizationId: organizationId}, want: 2},
+		{name: "bid", scope: user, filter: model.ReviewFilter{BidId: bidId}, want: 1},
+		{name: "created later", scope: user, filter: model.ReviewFilter{CreatedFrom: &future}, want: 0},
+		{name: "created earlier", scope: user, filter: model.ReviewFilter{CreatedTo: &future}, want: 3},
+		{name: "organization author", scope: model.ReviewScope{AuthorType: "Organization", AuthorId: supplierId, TenderId: tenderId}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, total, err := s.Reviews(ctx, tt.scope, tt.filter, model.PageQuery{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, total)
+		})
+	}
+}
+
+func TestAttachmentVersions(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, tenderId := seed(t, s)
+
+	spec, version, err := s.AddAttachment(ctx, model.AttachmentDB{OwnerType: model.OwnerTender, OwnerId: tenderId, Name: "spec.pdf", UploadedBy: employeeId}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, version)
+	drawing, _, err := s.AddAttachment(ctx, model.AttachmentDB{OwnerType: model.OwnerTender, OwnerId: tenderId, Name: "drawing.png", UploadedBy: employeeId}, 0)
+	require.NoError(t, err)
+	_, err = s.RemoveAttachment(ctx, model.OwnerTender, tenderId, spec.Id, 3)
+	requireKind(t, err, errs.KindVersionMismatch)
+	version, err = s.RemoveAttachment(ctx, model.OwnerTender, tenderId, spec.Id, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 5, version)
+	_, err = s.RemoveAttachment(ctx, model.OwnerTender, tenderId, spec.Id, 0)
+	requireKind(t, err, errs.KindNotFound)
+
+	names := func(version int32) []string {
+		attachments, err := s.Attachments(ctx, model.OwnerTender, tenderId, version)
+		require.NoError(t, err)
+		var names []string
+		for _, attachment := range attachments {
+			names = append(names, attachment.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"drawing.png"}, names(0))
+	assert.Equal(t, []string{"spec.pdf", "drawing.png"}, names(4))
+	assert.Empty(t, names(2))
+
+	// removed file stays downloadable for history, but not through another owner
+	_, err = s.Attachment(ctx, model.OwnerTender, tenderId, spec.Id)
+	require.NoError(t, err)
+	_, err = s.Attachment(ctx, model.OwnerBid, tenderId, drawing.Id)
+	requireKind(t, err, errs.KindNotFound)
+
+	_, err = s.RollbackTender(ctx, tenderId, 4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"spec.pdf", "drawing.png"}, names(0))
+	assert.Equal(t, []string{"drawing.png"}, names(5), "history is not changed by rollback")
+}
+
+func TestCategories(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	_, err := s.CreateCategory(ctx, "Food", "Food", "Delivery")
+	require.NoError(t, err)
+	_, err = s.CreateCategory(ctx, "Food", "Food again", "")
+	requireKind(t, err, errs.KindConflict)
+
+	deprecated := true
+	food, err := s.EditCategory(ctx, "Food", "Food delivery", &deprecated)
+	require.NoError(t, err)
+	assert.Equal(t, model.CategoryDB{Code: "Food", Name: "Food delivery", ParentCode: "Delivery", Deprecated: true, CreatedAt: food.CreatedAt}, food)
+	_, err = s.EditCategory(ctx, "Space", "Space", nil)
+	requireKind(t, err, errs.KindNotFound)
+
+	categories, err := s.Categories(ctx)
+	require.NoError(t, err)
+	var codes []string
+	for _, category := range categories {
+		codes = append(codes, category.Code)
+	}
+	assert.Equal(t, []string{"Construction", "Delivery", "Food", "Manufacture"}, codes)
+}
+
+func TestInvites(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+	employeeId, _, _ := seed(t, s)
+
+	require.NoError(t, s.CreateInvite(ctx, employeeId, "", "hash", time.Hour))
+	require.NoError(t, s.CreateInvite(ctx, employeeId, "", "expired", -time.Second))
+
+	used, err := s.UseInvite(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, employeeId, used)
+	_, err = s.UseInvite(ctx, "hash")
+	requireKind(t, err, errs.KindUnauthorized)
+	_, err = s.UseInvite(ctx, "expired")
+	requireKind(t, err, errs.KindUnauthorized)
+}
@@ -0,0 +1,228 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+)
+
+func (s *Storage) CheckRole(ctx context.Context, organizationId string, userId string) (string, error) {
+	defer s.lock()()
+
+	role, ok := s.roleOf(organizationId, userId)
+	if !ok {
+		return "", errs.Forbidden(fmt.Errorf("user is not a member of organization"))
+	}
+
+	return role, nil
+}
+
+func (s *Storage) CheckIdByName(ctx context.Context, username string) (string, error) {
+	defer s.lock()()
+
+	if employee, ok := s.employeeByName(username); ok {
+		return employee.Id, nil
+	}
+	for _, organization := range s.data.organizations {
+		if organization.Name == username {
+			return organization.Id, nil
+		}
+	}
+
+	return "", errs.Unauthorized(fmt.Errorf("user not found"))
+}
+
+func (s *Storage) CheckKnownNames(ctx context.Context, names []string) ([]string, error) {
+	defer s.lock()()
+
+	var known []string
+	for _, name := range names {
+		if _, ok := s.employeeByName(name); ok {
+			known = append(known, name)
+			continue
+		}
+		for _, organization := range s.data.organizations {
+			if organization.Name == name {
+				known = append(known, name)
+				break
+			}
+		}
+	}
+
+	return known, nil
+}
+
+func (s *Storage) CheckCorporateById(ctx context.Context, userId string) (string, error) {
+	defer s.lock()()
+
+	if employee, ok := s.data.employees[userId]; ok {
+		return employee.Username, nil
+	}
+	if organization, ok := s.data.organizations[userId]; ok {
+		return organization.Name, nil
+	}
+
+	return "", errs.Unauthorized(fmt.Errorf("user or organization not found"))
+}
+
+func (s *Storage) CheckMemberships(ctx context.Context, userId string) ([]model.MembershipDB, error) {
+	defer s.lock()()
+
+	var memberships []model.MembershipDB
+	for _, r := range s.data.responsibles {
+		if r.userId != userId {
+			continue
+		}
+		if organization, ok := s.data.organizations[r.organizationId]; ok {
+			memberships = append(memberships, model.MembershipDB{OrganizationDB: organization, Role: r.role})
+		}
+	}
+
+	return memberships, nil
+}
+
+func (s *Storage) CheckResponsibleCount(ctx context.Context, organizationId string, roles []string) (int, error) {
+	defer s.lock()()
+
+	count := 0
+	for _, r := range s.data.responsibles {
+		if r.organizationId == organizationId && slices.Contains(roles, r.role) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (s *Storage) CheckTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	defer s.lock()()
+
+	tender, ok := s.data.tenders[tenderId]
+	if !ok {
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+
+	return tender, nil
+}
+
+func (s *Storage) CheckTenderVersion(ctx context.Context, tenderId string, version int32) error {
+	defer s.lock()()
+
+	if _, ok := s.data.tenderVersions[tenderId][int(version)]; !ok {
+		return errs.NotFound(fmt.Errorf("no such tender version"))
+	}
+
+	return nil
+}
+
+func (s *Storage) CheckBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("bid not found"))
+	}
+
+	return bid, nil
+}
+
+func (s *Storage) CheckBidVersion(ctx context.Context, bidId string, version int32) error {
+	defer s.lock()()
+
+	if _, ok := s.data.bidVersions[bidId][int(version)]; !ok {
+		return errs.NotFound(fmt.Errorf("no such bid version"))
+	}
+
+	return nil
+}
+
+func (s *Storage) CheckBidAuthorByUsername(ctx context.Context, bidId string, username string) error {
+	defer s.lock()()
+
+	return s.checkBidAuthorByUsername(bidId, username)
+}
+
+func (s *Storage) CheckBidDecisionCount(ctx context.Context, bidId string, decision string) (int, error) {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return -1, errs.Internal(fmt.Errorf("failed to get decision count.."))
+	}
+	organizationId := s.data.tenders[bid.TenderId].OrganizationId
+
+	// only responsibles of the tender organization count
+	count := 0
+	for _, vote := range s.data.votes[bidId] {
+		if vote.Decision == decision && s.isResponsible(organizationId, vote.ResponsibleId) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (s *Storage) CheckDecisionPolicy(ctx context.Context, tenderId string) (quorum.Policy, error) {
+	defer s.lock()()
+
+	if _, ok := s.data.tenders[tenderId]; !ok {
+		return quorum.Policy{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+
+	return s.data.decisionPolicies[tenderId], nil
+}
+
+func (s *Storage) CheckSameSubmitter(ctx context.Context, bidId string, username string) error {
+	defer s.lock()()
+
+	employee, ok := s.employeeByName(username)
+	if !ok {
+		return nil
+	}
+	if s.hasVoted(bidId, employee.Id) {
+		return errs.Forbidden(fmt.Errorf("user already sent decision"))
+	}
+
+	return nil
+}
+
+func (s *Storage) CheckBidAvailability(ctx context.Context, bidId string) error {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
+	}
+	if bid.Decision != "" || strings.EqualFold(bid.Status, "Canceled") {
+		return errs.Forbidden(fmt.Errorf("bid is locked"))
+	}
+
+	return nil
+}
+
+func (s *Storage) CheckBidCanceled(ctx context.Context, bidId string) error {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
+	}
+	if strings.EqualFold(bid.Status, "Canceled") {
+		return errs.Forbidden(fmt.Errorf("bid is locked"))
+	}
+
+	return nil
+}
+
+func (s *Storage) checkBidAuthorByUsername(bidId string, username string) error {
+	bid, ok := s.data.bids[bidId]
+	if !ok || s.data.employees[bid.AuthorId].Username != username || username == "" {
+		return errs.Forbidden(fmt.Errorf("user have no access to bid "))
+	}
+
+	return nil
+}
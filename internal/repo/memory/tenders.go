@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+)
+
+func (s *Storage) Tenders(ctx context.Context, query model.PageQuery, serviceTypes []string) ([]model.TenderDB, int, error) {
+	defer s.lock()()
+
+	var tenders []model.TenderDB
+	for _, tender := range s.data.tenders {
+		if tender.Status != "Published" {
+			continue
+		}
+		if len(serviceTypes) == 0 || slices.Contains(serviceTypes, tender.ServiceType) {
+			tenders = append(tenders, tender)
+		}
+	}
+	tenders, total := keysetPage(tenders, query)
+
+	return tenders, total, nil
+}
+
+func (s *Storage) CreateTender(ctx context.Context, name string, description string, serviceType string, organizationId string, creatorUsername string, submissionDeadline *time.Time, decisionDeadline *time.Time, decisionPolicy quorum.Policy) (string, error) {
+	defer s.lock()()
+
+	tender := model.TenderDB{
+		Id:              newId(),
+		Name:            name,
+		Description:     description,
+		ServiceType:     serviceType,
+		Status:          "Created",
+		OrganizationId:  organizationId,
+		CreatorUsername: creatorUsername,
+		Version:         1,
+		CreatedAt:       now(),
+
+		SubmissionDeadline: copyTime(submissionDeadline),
+		DecisionDeadline:   copyTime(decisionDeadline),
+	}
+	s.data.tenders[tender.Id] = tender
+	s.data.decisionPolicies[tender.Id] = decisionPolicy
+	s.addTenderEvent(model.EventTenderCreated, tender)
+
+	return tender.Id, nil
+}
+
+func (s *Storage) TendersByOrganizations(ctx context.Context, query model.PageQuery, organizationIds []string) ([]model.TenderDB, int, error) {
+	defer s.lock()()
+
+	var tenders []model.TenderDB
+	for _, tender := range s.data.tenders {
+		if slices.Contains(organizationIds, tender.OrganizationId) {
+			tenders = append(tenders, tender)
+		}
+	}
+	tenders, total := keysetPage(tenders, query)
+
+	return tenders, total, nil
+}
+
+func (s *Storage) Status(ctx context.Context, tenderId string) (string, error) {
+	defer s.lock()()
+
+	tender, ok := s.data.tenders[tenderId]
+	if !ok {
+		return "", errs.Internal(fmt.Errorf("tender not found"))
+	}
+
+	return tender.Status, nil
+}
+
+func (s *Storage) ExpiredTenders(ctx context.Context, now time.Time) ([]model.TenderDB, error) {
+	defer s.lock()()
+
+	var tenders []model.TenderDB
+	for _, tender := range s.data.tenders {
+		if tender.Status == "Published" && tender.DecisionDeadline != nil && !tender.DecisionDeadline.After(now) {
+			tenders = append(tenders, tender)
+		}
+	}
+	slices.SortFunc(tenders, func(a, b model.TenderDB) int {
+		return a.DecisionDeadline.Compare(*b.DecisionDeadline)
+	})
+
+	return tenders, nil
+}
+
+func (s *Storage) ChangeTenderStatus(ctx context.Context, tenderId string, status string, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	tender, err := s.tenderForUpdate(tenderId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	tender.Status = status
+	s.data.tenders[tenderId] = tender
+	s.addTenderEvent(model.EventTenderStatusChanged, tender)
+
+	return tenderId, nil
+}
+
+func (s *Storage) EditTender(ctx context.Context, tenderId string, name string, description string, serviceType string, submissionDeadline *time.Time, decisionDeadline *time.Time, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	tender, err := s.tenderForUpdate(tenderId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	if name != "" {
+		tender.Name = name
+	}
+	if description != "" {
+		tender.Description = description
+	}
+	if serviceType != "" {
+		tender.ServiceType = serviceType
+	}
+	if submissionDeadline != nil {
+		tender.SubmissionDeadline = copyTime(submissionDeadline)
+	}
+	if decisionDeadline != nil {
+		tender.DecisionDeadline = copyTime(decisionDeadline)
+	}
+	s.data.tenders[tenderId] = tender
+
+	return tenderId, nil
+}
+
+func (s *Storage) RollbackTender(ctx context.Context, tenderId string, version int32, expectedVersion int32) (string, error) {
+	defer s.lock()()
+
+	old, ok := s.data.tenderVersions[tenderId][int(version)]
+	if !ok {
+		return "", errs.NotFound(fmt.Errorf("no such tender version"))
+	}
+	tender, err := s.tenderForUpdate(tenderId, expectedVersion)
+	if err != nil {
+		return "", err
+	}
+	tender.Name = old.Name
+	tender.Description = old.Description
+	tender.ServiceType = old.ServiceType
+	tender.Status = old.Status
+	tender.OrganizationId = old.OrganizationId
+	tender.CreatorUsername = old.CreatorUsername
+	tender.SubmissionDeadline = old.SubmissionDeadline
+	tender.DecisionDeadline = old.DecisionDeadline
+	tender.Attachments = old.Attachments
+	s.data.tenders[tenderId] = tender
+
+	return tenderId, nil
+}
+
+// tenderForUpdate checks expected version, saves current state to history
+// and returns tender with bumped version, like UPDATE ... version = version + 1
+func (s *Storage) tenderForUpdate(tenderId string, expectedVersion int32) (model.TenderDB, error) {
+	tender, ok := s.data.tenders[tenderId]
+	if !ok {
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+	if expectedVersion != 0 && int32(tender.Version) != expectedVersion {
+		return model.TenderDB{}, errs.VersionMismatch(fmt.Errorf("tender version mismatch"))
+	}
+
+	if s.data.tenderVersions[tenderId] == nil {
+		s.data.tenderVersions[tenderId] = map[int]model.TenderDB{}
+	}
+	s.data.tenderVersions[tenderId][tender.Version] = tender
+	tender.Version++
+
+	return tender, nil
+}
+
+func (s *Storage) deleteTender(tenderId string) {
+	delete(s.data.tenders, tenderId)
+	delete(s.data.tenderVersions, tenderId)
+	delete(s.data.decisionPolicies, tenderId)
+	s.deleteAttachments(model.OwnerTender, tenderId)
+	for id, bid := range s.data.bids {
+		if bid.TenderId == tenderId {
+			s.deleteBid(id)
+		}
+	}
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func (s *Storage) PostMessage(ctx context.Context, bidId string, authorId string, side string, text string, mentions []string, ratings map[string]int) (model.ThreadMessageDB, error) {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return model.ThreadMessageDB{}, errs.Internal(fmt.Errorf("failed to post message"))
+	}
+	message := model.ThreadMessageDB{
+		Id:        newId(),
+		BidId:     bidId,
+		AuthorId:  authorId,
+		Side:      side,
+		Text:      text,
+		Mentions:  slices.Clone(mentions),
+		CreatedAt: now(),
+		Ratings:   maps.Clone(ratings),
+	}
+	s.data.feedback = append(s.data.feedback, message)
+	if side == model.SideBidder {
+		s.addBidEvent(model.EventBidFeedbackReplied, bid, map[string]any{"reply": text, "mentions": message.Mentions})
+	} else {
+		s.addBidEvent(model.EventBidFeedbackLeft, bid, map[string]any{"feedback": text, "mentions": message.Mentions})
+	}
+
+	message.AuthorUsername = s.data.employees[authorId].Username
+	return message, nil
+}
+
+func (s *Storage) Thread(ctx context.Context, bidId string, viewerId string, query model.PageQuery) ([]model.ThreadMessageDB, int, error) {
+	defer s.lock()()
+
+	readAt, read := s.data.threadReads[bidId][viewerId]
+	var messages []model.ThreadMessageDB
+	for _, message := range s.data.feedback {
+		if message.BidId != bidId {
+			continue
+		}
+		message.AuthorUsername = s.data.employees[message.AuthorId].Username
+		createdAt, _ := time.Parse(time.RFC3339Nano, message.CreatedAt)
+		message.Unread = message.AuthorId != viewerId && (!read || createdAt.After(readAt))
+		messages = append(messages, message)
+	}
+	query.Sort = model.SortCreatedAt
+	messages, total := keysetPage(messages, query)
+
+	return messages, total, nil
+}
+
+func (s *Storage) MarkThreadRead(ctx context.Context, bidId string, employeeId string) error {
+	defer s.lock()()
+
+	if s.data.threadReads[bidId] == nil {
+		s.data.threadReads[bidId] = map[string]time.Time{}
+	}
+	s.data.threadReads[bidId][employeeId] = time.Now()
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+// LockTender only reads the tender, the transaction already holds the whole storage lock
+func (s *Storage) LockTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	defer s.lock()()
+
+	tender, ok := s.data.tenders[tenderId]
+	if !ok {
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+
+	return tender, nil
+}
+
+func (s *Storage) LockBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	defer s.lock()()
+
+	bid, ok := s.data.bids[bidId]
+	if !ok {
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("bid not found"))
+	}
+
+	return bid, nil
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func (s *Storage) TenderVersions(ctx context.Context, tenderId string) ([]model.TenderDB, error) {
+	defer s.lock()()
+
+	var tenders []model.TenderDB
+	for _, tender := range s.data.tenderVersions[tenderId] {
+		tenders = append(tenders, tender)
+	}
+	if tender, ok := s.data.tenders[tenderId]; ok {
+		tenders = append(tenders, tender)
+	}
+	slices.SortFunc(tenders, func(a, b model.TenderDB) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return tenders, nil
+}
+
+func (s *Storage) TenderVersion(ctx context.Context, tenderId string, version int32) (model.TenderDB, error) {
+	defer s.lock()()
+
+	if tender, ok := s.data.tenders[tenderId]; ok && tender.Version == int(version) {
+		return tender, nil
+	}
+	tender, ok := s.data.tenderVersions[tenderId][int(version)]
+	if !ok {
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("no such tender version"))
+	}
+
+	return tender, nil
+}
+
+func (s *Storage) BidVersions(ctx context.Context, bidId string) ([]model.BidDB, error) {
+	defer s.lock()()
+
+	var bids []model.BidDB
+	for _, bid := range s.data.bidVersions[bidId] {
+		bids = append(bids, bid)
+	}
+	if bid, ok := s.data.bids[bidId]; ok {
+		bids = append(bids, bid)
+	}
+	slices.SortFunc(bids, func(a, b model.BidDB) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return bids, nil
+}
+
+func (s *Storage) BidVersion(ctx context.Context, bidId string, version int32) (model.BidDB, error) {
+	defer s.lock()()
+
+	if bid, ok := s.data.bids[bidId]; ok && bid.Version == int(version) {
+		return bid, nil
+	}
+	bid, ok := s.data.bidVersions[bidId][int(version)]
+	if !ok {
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("no such bid version"))
+	}
+
+	return bid, nil
+}
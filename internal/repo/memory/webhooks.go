@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+type event struct {
+	id             string
+	eventType      string
+	organizationId string
+	payload        []byte
+	createdAt      string
+	dispatched     bool
+}
+
+func (s *Storage) CreateWebhook(ctx context.Context, organizationId string, url string, secret string, events []string) (string, error) {
+	defer s.lock()()
+
+	webhook := model.WebhookDB{
+		Id:             newId(),
+		OrganizationId: organizationId,
+		Url:            url,
+		Secret:         secret,
+		Events:         slices.Clone(events),
+		CreatedAt:      now(),
+	}
+	s.data.webhooks[webhook.Id] = webhook
+
+	return webhook.Id, nil
+}
+
+func (s *Storage) Webhooks(ctx context.Context, organizationId string) ([]model.WebhookDB, error) {
+	defer s.lock()()
+
+	var webhooks []model.WebhookDB
+	for _, webhook := range s.data.webhooks {
+		if webhook.OrganizationId == organizationId {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	slices.SortFunc(webhooks, func(a, b model.WebhookDB) int {
+		return strings.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	return webhooks, nil
+}
+
+func (s *Storage) Webhook(ctx context.Context, webhookId string) (model.WebhookDB, error) {
+	defer s.lock()()
+
+	webhook, ok := s.data.webhooks[webhookId]
+	if !ok {
+		return model.WebhookDB{}, errs.NotFound(fmt.Errorf("webhook not found"))
+	}
+
+	return webhook, nil
+}
+
+func (s *Storage) DeleteWebhook(ctx context.Context, webhookId string) error {
+	defer s.lock()()
+
+	if _, ok := s.data.webhooks[webhookId]; !ok {
+		return errs.NotFound(fmt.Errorf("webhook not found"))
+	}
+	s.deleteWebhook(webhookId)
+
+	return nil
+}
+
+func (s *Storage) WebhookDeliveries(ctx context.Context, webhookId string, query model.PageQuery) ([]model.WebhookDeliveryDB, int, error) {
+	defer s.lock()()
+
+	var deliveries []model.WebhookDeliveryDB
+	for _, delivery := range s.data.deliveries {
+		if delivery.WebhookId == webhookId {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	query.Sort = model.SortCreatedAt
+	deliveries, total := keysetPage(deliveries, query)
+
+	return deliveries, total, nil
+}
+
+func (s *Storage) FanOutEvents(ctx context.Context, limit int32) (int, error) {
+	defer s.lock()()
+
+	dispatched := 0
+	for i := range s.data.events {
+		if limit > 0 && dispatched == int(limit) {
+			break
+		}
+		e := &s.data.events[i]
+		if e.dispatched {
+			continue
+		}
+		for _, webhook := range s.data.webhooks {
+			if webhook.OrganizationId != e.organizationId {
+				continue
+			}
+			if len(webhook.Events) != 0 && !slices.Contains(webhook.Events, e.eventType) {
+				continue
+			}
+			createdAt := now()
+			s.data.deliveries = append(s.data.deliveries, model.WebhookDeliveryDB{
+				Id:            newId(),
+				WebhookId:     webhook.Id,
+				EventId:       e.id,
+				EventType:     e.eventType,
+				Status:        model.DeliveryPending,
+				NextAttemptAt: time.Now(),
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+			})
+		}
+		e.dispatched = true
+		dispatched++
+	}
+
+	return dispatched, nil
+}
+
+func (s *Storage) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int32) ([]model.Delivery, error) {
+	defer s.lock()()
+
+	var due []int
+	for i, delivery := range s.data.deliveries {
+		if delivery.Status == model.DeliveryPending && !delivery.NextAttemptAt.After(now) {
+			due = append(due, i)
+		}
+	}
+	slices.SortStableFunc(due, func(a, b int) int {
+		return s.data.deliveries[a].NextAttemptAt.Compare(s.data.deliveries[b].NextAttemptAt)
+	})
+	if limit > 0 && int(limit) < len(due) {
+		due = due[:limit]
+	}
+
+	var claimed []model.Delivery
+	for _, i := range due {
+		delivery := &s.data.deliveries[i]
+		delivery.NextAttemptAt = now.Add(lease)
+
+		webhook := s.data.webhooks[delivery.WebhookId]
+		e, _ := s.event(delivery.EventId)
+		claimed = append(claimed, model.Delivery{
+			Id:             delivery.Id,
+			WebhookId:      webhook.Id,
+			Url:            webhook.Url,
+			Secret:         webhook.Secret,
+			EventId:        e.id,
+			EventType:      e.eventType,
+			Payload:        e.payload,
+			EventCreatedAt: e.createdAt,
+			Attempts:       delivery.Attempts,
+		})
+	}
+
+	return claimed, nil
+}
+
+func (s *Storage) RecordDelivery(ctx context.Context, deliveryId string, status string, attempts int32, responseCode int, errText string, nextAttemptAt time.Time) error {
+	defer s.lock()()
+
+	for i := range s.data.deliveries {
+		delivery := &s.data.deliveries[i]
+		if delivery.Id != deliveryId {
+			continue
+		}
+		delivery.Status = status
+		delivery.Attempts = attempts
+		delivery.ResponseCode = responseCode
+		delivery.Error = errText
+		delivery.NextAttemptAt = nextAttemptAt
+		delivery.UpdatedAt = now()
+	}
+
+	return nil
+}
+
+func (s *Storage) addTenderEvent(eventType string, tender model.TenderDB) {
+	s.addEvent(eventType, tender.OrganizationId, map[string]any{
+		"tenderId":       tender.Id,
+		"organizationId": tender.OrganizationId,
+		"status":         tender.Status,
+		"version":        tender.Version,
+	})
+}
+
+func (s *Storage) addBidEvent(eventType string, bid model.BidDB, extra map[string]any) {
+	payload := map[string]any{
+		"bidId":    bid.Id,
+		"tenderId": bid.TenderId,
+		"status":   bid.Status,
+		"decision": bid.Decision,
+		"version":  bid.Version,
+	}
+	for k, v := range extra {
+		payload[k] = v
+	}
+	s.addEvent(eventType, s.data.tenders[bid.TenderId].OrganizationId, payload)
+}
+
+func (s *Storage) addEvent(eventType string, organizationId string, payload map[string]any) {
+	data, _ := json.Marshal(payload)
+	s.data.events = append(s.data.events, event{
+		id:             newId(),
+		eventType:      eventType,
+		organizationId: organizationId,
+		payload:        data,
+		createdAt:      now(),
+	})
+}
+
+func (s *Storage) event(eventId string) (event, bool) {
+	for _, e := range s.data.events {
+		if e.id == eventId {
+			return e, true
+		}
+	}
+	return event{}, false
+}
+
+func (s *Storage) deleteWebhook(webhookId string) {
+	delete(s.data.webhooks, webhookId)
+	s.data.deliveries = slices.DeleteFunc(s.data.deliveries, func(d model.WebhookDeliveryDB) bool {
+		return d.WebhookId == webhookId
+	})
+}
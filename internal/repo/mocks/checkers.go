@@ -0,0 +1,902 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	quorum "zadanie-6105/internal/domain/quorum"
+)
+
+// Checkers is an autogenerated mock type for the Checkers type
+type Checkers struct {
+	mock.Mock
+}
+
+type Checkers_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Checkers) EXPECT() *Checkers_Expecter {
+	return &Checkers_Expecter{mock: &_m.Mock}
+}
+
+// CheckBid provides a mock function with given fields: ctx, bidId
+func (_m *Checkers) CheckBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBid")
+	}
+
+	var r0 model.BidDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.BidDB, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.BidDB); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Get(0).(model.BidDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBid'
+type Checkers_CheckBid_Call struct {
+	*mock.Call
+}
+
+// CheckBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Checkers_Expecter) CheckBid(ctx interface{}, bidId interface{}) *Checkers_CheckBid_Call {
+	return &Checkers_CheckBid_Call{Call: _e.mock.On("CheckBid", ctx, bidId)}
+}
+
+func (_c *Checkers_CheckBid_Call) Run(run func(ctx context.Context, bidId string)) *Checkers_CheckBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBid_Call) Return(_a0 model.BidDB, _a1 error) *Checkers_CheckBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckBid_Call) RunAndReturn(run func(context.Context, string) (model.BidDB, error)) *Checkers_CheckBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidAuthorByUsername provides a mock function with given fields: ctx, bidId, username
+func (_m *Checkers) CheckBidAuthorByUsername(ctx context.Context, bidId string, username string) error {
+	ret := _m.Called(ctx, bidId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidAuthorByUsername")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckBidAuthorByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidAuthorByUsername'
+type Checkers_CheckBidAuthorByUsername_Call struct {
+	*mock.Call
+}
+
+// CheckBidAuthorByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - username string
+func (_e *Checkers_Expecter) CheckBidAuthorByUsername(ctx interface{}, bidId interface{}, username interface{}) *Checkers_CheckBidAuthorByUsername_Call {
+	return &Checkers_CheckBidAuthorByUsername_Call{Call: _e.mock.On("CheckBidAuthorByUsername", ctx, bidId, username)}
+}
+
+func (_c *Checkers_CheckBidAuthorByUsername_Call) Run(run func(ctx context.Context, bidId string, username string)) *Checkers_CheckBidAuthorByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBidAuthorByUsername_Call) Return(_a0 error) *Checkers_CheckBidAuthorByUsername_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckBidAuthorByUsername_Call) RunAndReturn(run func(context.Context, string, string) error) *Checkers_CheckBidAuthorByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidAvailability provides a mock function with given fields: ctx, bidId
+func (_m *Checkers) CheckBidAvailability(ctx context.Context, bidId string) error {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidAvailability")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckBidAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidAvailability'
+type Checkers_CheckBidAvailability_Call struct {
+	*mock.Call
+}
+
+// CheckBidAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Checkers_Expecter) CheckBidAvailability(ctx interface{}, bidId interface{}) *Checkers_CheckBidAvailability_Call {
+	return &Checkers_CheckBidAvailability_Call{Call: _e.mock.On("CheckBidAvailability", ctx, bidId)}
+}
+
+func (_c *Checkers_CheckBidAvailability_Call) Run(run func(ctx context.Context, bidId string)) *Checkers_CheckBidAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBidAvailability_Call) Return(_a0 error) *Checkers_CheckBidAvailability_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckBidAvailability_Call) RunAndReturn(run func(context.Context, string) error) *Checkers_CheckBidAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidCanceled provides a mock function with given fields: ctx, bidId
+func (_m *Checkers) CheckBidCanceled(ctx context.Context, bidId string) error {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidCanceled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckBidCanceled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidCanceled'
+type Checkers_CheckBidCanceled_Call struct {
+	*mock.Call
+}
+
+// CheckBidCanceled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Checkers_Expecter) CheckBidCanceled(ctx interface{}, bidId interface{}) *Checkers_CheckBidCanceled_Call {
+	return &Checkers_CheckBidCanceled_Call{Call: _e.mock.On("CheckBidCanceled", ctx, bidId)}
+}
+
+func (_c *Checkers_CheckBidCanceled_Call) Run(run func(ctx context.Context, bidId string)) *Checkers_CheckBidCanceled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBidCanceled_Call) Return(_a0 error) *Checkers_CheckBidCanceled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckBidCanceled_Call) RunAndReturn(run func(context.Context, string) error) *Checkers_CheckBidCanceled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidDecisionCount provides a mock function with given fields: ctx, bidId, decision
+func (_m *Checkers) CheckBidDecisionCount(ctx context.Context, bidId string, decision string) (int, error) {
+	ret := _m.Called(ctx, bidId, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidDecisionCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return rf(ctx, bidId, decision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, bidId, decision)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bidId, decision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckBidDecisionCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidDecisionCount'
+type Checkers_CheckBidDecisionCount_Call struct {
+	*mock.Call
+}
+
+// CheckBidDecisionCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - decision string
+func (_e *Checkers_Expecter) CheckBidDecisionCount(ctx interface{}, bidId interface{}, decision interface{}) *Checkers_CheckBidDecisionCount_Call {
+	return &Checkers_CheckBidDecisionCount_Call{Call: _e.mock.On("CheckBidDecisionCount", ctx, bidId, decision)}
+}
+
+func (_c *Checkers_CheckBidDecisionCount_Call) Run(run func(ctx context.Context, bidId string, decision string)) *Checkers_CheckBidDecisionCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBidDecisionCount_Call) Return(_a0 int, _a1 error) *Checkers_CheckBidDecisionCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckBidDecisionCount_Call) RunAndReturn(run func(context.Context, string, string) (int, error)) *Checkers_CheckBidDecisionCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidVersion provides a mock function with given fields: ctx, bidId, version
+func (_m *Checkers) CheckBidVersion(ctx context.Context, bidId string, version int32) error {
+	ret := _m.Called(ctx, bidId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, bidId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckBidVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidVersion'
+type Checkers_CheckBidVersion_Call struct {
+	*mock.Call
+}
+
+// CheckBidVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - version int32
+func (_e *Checkers_Expecter) CheckBidVersion(ctx interface{}, bidId interface{}, version interface{}) *Checkers_CheckBidVersion_Call {
+	return &Checkers_CheckBidVersion_Call{Call: _e.mock.On("CheckBidVersion", ctx, bidId, version)}
+}
+
+func (_c *Checkers_CheckBidVersion_Call) Run(run func(ctx context.Context, bidId string, version int32)) *Checkers_CheckBidVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckBidVersion_Call) Return(_a0 error) *Checkers_CheckBidVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckBidVersion_Call) RunAndReturn(run func(context.Context, string, int32) error) *Checkers_CheckBidVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckCorporateById provides a mock function with given fields: ctx, userId
+func (_m *Checkers) CheckCorporateById(ctx context.Context, userId string) (string, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckCorporateById")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckCorporateById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCorporateById'
+type Checkers_CheckCorporateById_Call struct {
+	*mock.Call
+}
+
+// CheckCorporateById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *Checkers_Expecter) CheckCorporateById(ctx interface{}, userId interface{}) *Checkers_CheckCorporateById_Call {
+	return &Checkers_CheckCorporateById_Call{Call: _e.mock.On("CheckCorporateById", ctx, userId)}
+}
+
+func (_c *Checkers_CheckCorporateById_Call) Run(run func(ctx context.Context, userId string)) *Checkers_CheckCorporateById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckCorporateById_Call) Return(_a0 string, _a1 error) *Checkers_CheckCorporateById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckCorporateById_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Checkers_CheckCorporateById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckDecisionPolicy provides a mock function with given fields: ctx, tenderId
+func (_m *Checkers) CheckDecisionPolicy(ctx context.Context, tenderId string) (quorum.Policy, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDecisionPolicy")
+	}
+
+	var r0 quorum.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (quorum.Policy, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) quorum.Policy); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(quorum.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckDecisionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDecisionPolicy'
+type Checkers_CheckDecisionPolicy_Call struct {
+	*mock.Call
+}
+
+// CheckDecisionPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *Checkers_Expecter) CheckDecisionPolicy(ctx interface{}, tenderId interface{}) *Checkers_CheckDecisionPolicy_Call {
+	return &Checkers_CheckDecisionPolicy_Call{Call: _e.mock.On("CheckDecisionPolicy", ctx, tenderId)}
+}
+
+func (_c *Checkers_CheckDecisionPolicy_Call) Run(run func(ctx context.Context, tenderId string)) *Checkers_CheckDecisionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckDecisionPolicy_Call) Return(_a0 quorum.Policy, _a1 error) *Checkers_CheckDecisionPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckDecisionPolicy_Call) RunAndReturn(run func(context.Context, string) (quorum.Policy, error)) *Checkers_CheckDecisionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckIdByName provides a mock function with given fields: ctx, username
+func (_m *Checkers) CheckIdByName(ctx context.Context, username string) (string, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIdByName")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckIdByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIdByName'
+type Checkers_CheckIdByName_Call struct {
+	*mock.Call
+}
+
+// CheckIdByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *Checkers_Expecter) CheckIdByName(ctx interface{}, username interface{}) *Checkers_CheckIdByName_Call {
+	return &Checkers_CheckIdByName_Call{Call: _e.mock.On("CheckIdByName", ctx, username)}
+}
+
+func (_c *Checkers_CheckIdByName_Call) Run(run func(ctx context.Context, username string)) *Checkers_CheckIdByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckIdByName_Call) Return(_a0 string, _a1 error) *Checkers_CheckIdByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckIdByName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Checkers_CheckIdByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckKnownNames provides a mock function with given fields: ctx, names
+func (_m *Checkers) CheckKnownNames(ctx context.Context, names []string) ([]string, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckKnownNames")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckKnownNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckKnownNames'
+type Checkers_CheckKnownNames_Call struct {
+	*mock.Call
+}
+
+// CheckKnownNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *Checkers_Expecter) CheckKnownNames(ctx interface{}, names interface{}) *Checkers_CheckKnownNames_Call {
+	return &Checkers_CheckKnownNames_Call{Call: _e.mock.On("CheckKnownNames", ctx, names)}
+}
+
+func (_c *Checkers_CheckKnownNames_Call) Run(run func(ctx context.Context, names []string)) *Checkers_CheckKnownNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckKnownNames_Call) Return(_a0 []string, _a1 error) *Checkers_CheckKnownNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckKnownNames_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *Checkers_CheckKnownNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckMemberships provides a mock function with given fields: ctx, userId
+func (_m *Checkers) CheckMemberships(ctx context.Context, userId string) ([]model.MembershipDB, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckMemberships")
+	}
+
+	var r0 []model.MembershipDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.MembershipDB, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.MembershipDB); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MembershipDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckMemberships'
+type Checkers_CheckMemberships_Call struct {
+	*mock.Call
+}
+
+// CheckMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *Checkers_Expecter) CheckMemberships(ctx interface{}, userId interface{}) *Checkers_CheckMemberships_Call {
+	return &Checkers_CheckMemberships_Call{Call: _e.mock.On("CheckMemberships", ctx, userId)}
+}
+
+func (_c *Checkers_CheckMemberships_Call) Run(run func(ctx context.Context, userId string)) *Checkers_CheckMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckMemberships_Call) Return(_a0 []model.MembershipDB, _a1 error) *Checkers_CheckMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckMemberships_Call) RunAndReturn(run func(context.Context, string) ([]model.MembershipDB, error)) *Checkers_CheckMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckResponsibleCount provides a mock function with given fields: ctx, organizationId, roles
+func (_m *Checkers) CheckResponsibleCount(ctx context.Context, organizationId string, roles []string) (int, error) {
+	ret := _m.Called(ctx, organizationId, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckResponsibleCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (int, error)); ok {
+		return rf(ctx, organizationId, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) int); ok {
+		r0 = rf(ctx, organizationId, roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, organizationId, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckResponsibleCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckResponsibleCount'
+type Checkers_CheckResponsibleCount_Call struct {
+	*mock.Call
+}
+
+// CheckResponsibleCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - roles []string
+func (_e *Checkers_Expecter) CheckResponsibleCount(ctx interface{}, organizationId interface{}, roles interface{}) *Checkers_CheckResponsibleCount_Call {
+	return &Checkers_CheckResponsibleCount_Call{Call: _e.mock.On("CheckResponsibleCount", ctx, organizationId, roles)}
+}
+
+func (_c *Checkers_CheckResponsibleCount_Call) Run(run func(ctx context.Context, organizationId string, roles []string)) *Checkers_CheckResponsibleCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckResponsibleCount_Call) Return(_a0 int, _a1 error) *Checkers_CheckResponsibleCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckResponsibleCount_Call) RunAndReturn(run func(context.Context, string, []string) (int, error)) *Checkers_CheckResponsibleCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckRole provides a mock function with given fields: ctx, organizationId, userId
+func (_m *Checkers) CheckRole(ctx context.Context, organizationId string, userId string) (string, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckRole")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckRole'
+type Checkers_CheckRole_Call struct {
+	*mock.Call
+}
+
+// CheckRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+func (_e *Checkers_Expecter) CheckRole(ctx interface{}, organizationId interface{}, userId interface{}) *Checkers_CheckRole_Call {
+	return &Checkers_CheckRole_Call{Call: _e.mock.On("CheckRole", ctx, organizationId, userId)}
+}
+
+func (_c *Checkers_CheckRole_Call) Run(run func(ctx context.Context, organizationId string, userId string)) *Checkers_CheckRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckRole_Call) Return(_a0 string, _a1 error) *Checkers_CheckRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckRole_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *Checkers_CheckRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSameSubmitter provides a mock function with given fields: ctx, bidId, username
+func (_m *Checkers) CheckSameSubmitter(ctx context.Context, bidId string, username string) error {
+	ret := _m.Called(ctx, bidId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSameSubmitter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckSameSubmitter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSameSubmitter'
+type Checkers_CheckSameSubmitter_Call struct {
+	*mock.Call
+}
+
+// CheckSameSubmitter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - username string
+func (_e *Checkers_Expecter) CheckSameSubmitter(ctx interface{}, bidId interface{}, username interface{}) *Checkers_CheckSameSubmitter_Call {
+	return &Checkers_CheckSameSubmitter_Call{Call: _e.mock.On("CheckSameSubmitter", ctx, bidId, username)}
+}
+
+func (_c *Checkers_CheckSameSubmitter_Call) Run(run func(ctx context.Context, bidId string, username string)) *Checkers_CheckSameSubmitter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckSameSubmitter_Call) Return(_a0 error) *Checkers_CheckSameSubmitter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckSameSubmitter_Call) RunAndReturn(run func(context.Context, string, string) error) *Checkers_CheckSameSubmitter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckTender provides a mock function with given fields: ctx, tenderId
+func (_m *Checkers) CheckTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTender")
+	}
+
+	var r0 model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.TenderDB, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.TenderDB); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(model.TenderDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkers_CheckTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTender'
+type Checkers_CheckTender_Call struct {
+	*mock.Call
+}
+
+// CheckTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *Checkers_Expecter) CheckTender(ctx interface{}, tenderId interface{}) *Checkers_CheckTender_Call {
+	return &Checkers_CheckTender_Call{Call: _e.mock.On("CheckTender", ctx, tenderId)}
+}
+
+func (_c *Checkers_CheckTender_Call) Run(run func(ctx context.Context, tenderId string)) *Checkers_CheckTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckTender_Call) Return(_a0 model.TenderDB, _a1 error) *Checkers_CheckTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkers_CheckTender_Call) RunAndReturn(run func(context.Context, string) (model.TenderDB, error)) *Checkers_CheckTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckTenderVersion provides a mock function with given fields: ctx, tenderId, version
+func (_m *Checkers) CheckTenderVersion(ctx context.Context, tenderId string, version int32) error {
+	ret := _m.Called(ctx, tenderId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTenderVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, tenderId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Checkers_CheckTenderVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTenderVersion'
+type Checkers_CheckTenderVersion_Call struct {
+	*mock.Call
+}
+
+// CheckTenderVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - version int32
+func (_e *Checkers_Expecter) CheckTenderVersion(ctx interface{}, tenderId interface{}, version interface{}) *Checkers_CheckTenderVersion_Call {
+	return &Checkers_CheckTenderVersion_Call{Call: _e.mock.On("CheckTenderVersion", ctx, tenderId, version)}
+}
+
+func (_c *Checkers_CheckTenderVersion_Call) Run(run func(ctx context.Context, tenderId string, version int32)) *Checkers_CheckTenderVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Checkers_CheckTenderVersion_Call) Return(_a0 error) *Checkers_CheckTenderVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Checkers_CheckTenderVersion_Call) RunAndReturn(run func(context.Context, string, int32) error) *Checkers_CheckTenderVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCheckers creates a new instance of Checkers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCheckers(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Checkers {
+	mock := &Checkers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	repo "zadanie-6105/internal/repo"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Transactor is an autogenerated mock type for the Transactor type
+type Transactor struct {
+	mock.Mock
+}
+
+type Transactor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Transactor) EXPECT() *Transactor_Expecter {
+	return &Transactor_Expecter{mock: &_m.Mock}
+}
+
+// InTransaction provides a mock function with given fields: ctx, fn
+func (_m *Transactor) InTransaction(ctx context.Context, fn func(repo.Tx) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(repo.Tx) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Transactor_InTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InTransaction'
+type Transactor_InTransaction_Call struct {
+	*mock.Call
+}
+
+// InTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(repo.Tx) error
+func (_e *Transactor_Expecter) InTransaction(ctx interface{}, fn interface{}) *Transactor_InTransaction_Call {
+	return &Transactor_InTransaction_Call{Call: _e.mock.On("InTransaction", ctx, fn)}
+}
+
+func (_c *Transactor_InTransaction_Call) Run(run func(ctx context.Context, fn func(repo.Tx) error)) *Transactor_InTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(repo.Tx) error))
+	})
+	return _c
+}
+
+func (_c *Transactor_InTransaction_Call) Return(_a0 error) *Transactor_InTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transactor_InTransaction_Call) RunAndReturn(run func(context.Context, func(repo.Tx) error) error) *Transactor_InTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactor creates a new instance of Transactor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transactor {
+	mock := &Transactor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1513 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	quorum "zadanie-6105/internal/domain/quorum"
+)
+
+// Tx is an autogenerated mock type for the Tx type
+type Tx struct {
+	mock.Mock
+}
+
+type Tx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Tx) EXPECT() *Tx_Expecter {
+	return &Tx_Expecter{mock: &_m.Mock}
+}
+
+// AddResponsible provides a mock function with given fields: ctx, organizationId, userId, role
+func (_m *Tx) AddResponsible(ctx context.Context, organizationId string, userId string, role string) error {
+	ret := _m.Called(ctx, organizationId, userId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddResponsible")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, organizationId, userId, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_AddResponsible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddResponsible'
+type Tx_AddResponsible_Call struct {
+	*mock.Call
+}
+
+// AddResponsible is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+//   - role string
+func (_e *Tx_Expecter) AddResponsible(ctx interface{}, organizationId interface{}, userId interface{}, role interface{}) *Tx_AddResponsible_Call {
+	return &Tx_AddResponsible_Call{Call: _e.mock.On("AddResponsible", ctx, organizationId, userId, role)}
+}
+
+func (_c *Tx_AddResponsible_Call) Run(run func(ctx context.Context, organizationId string, userId string, role string)) *Tx_AddResponsible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_AddResponsible_Call) Return(_a0 error) *Tx_AddResponsible_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_AddResponsible_Call) RunAndReturn(run func(context.Context, string, string, string) error) *Tx_AddResponsible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyDecision provides a mock function with given fields: ctx, bidId, decision
+func (_m *Tx) ApplyDecision(ctx context.Context, bidId string, decision string) error {
+	ret := _m.Called(ctx, bidId, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_ApplyDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyDecision'
+type Tx_ApplyDecision_Call struct {
+	*mock.Call
+}
+
+// ApplyDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - decision string
+func (_e *Tx_Expecter) ApplyDecision(ctx interface{}, bidId interface{}, decision interface{}) *Tx_ApplyDecision_Call {
+	return &Tx_ApplyDecision_Call{Call: _e.mock.On("ApplyDecision", ctx, bidId, decision)}
+}
+
+func (_c *Tx_ApplyDecision_Call) Run(run func(ctx context.Context, bidId string, decision string)) *Tx_ApplyDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_ApplyDecision_Call) Return(_a0 error) *Tx_ApplyDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_ApplyDecision_Call) RunAndReturn(run func(context.Context, string, string) error) *Tx_ApplyDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BidsForTender provides a mock function with given fields: ctx, tenderId, viewer, page
+func (_m *Tx) BidsForTender(ctx context.Context, tenderId string, viewer string, page model.PageQuery) ([]model.BidDB, int, error) {
+	ret := _m.Called(ctx, tenderId, viewer, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidsForTender")
+	}
+
+	var r0 []model.BidDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) ([]model.BidDB, int, error)); ok {
+		return rf(ctx, tenderId, viewer, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) []model.BidDB); ok {
+		r0 = rf(ctx, tenderId, viewer, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BidDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PageQuery) int); ok {
+		r1 = rf(ctx, tenderId, viewer, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, model.PageQuery) error); ok {
+		r2 = rf(ctx, tenderId, viewer, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Tx_BidsForTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BidsForTender'
+type Tx_BidsForTender_Call struct {
+	*mock.Call
+}
+
+// BidsForTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - viewer string
+//   - page model.PageQuery
+func (_e *Tx_Expecter) BidsForTender(ctx interface{}, tenderId interface{}, viewer interface{}, page interface{}) *Tx_BidsForTender_Call {
+	return &Tx_BidsForTender_Call{Call: _e.mock.On("BidsForTender", ctx, tenderId, viewer, page)}
+}
+
+func (_c *Tx_BidsForTender_Call) Run(run func(ctx context.Context, tenderId string, viewer string, page model.PageQuery)) *Tx_BidsForTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *Tx_BidsForTender_Call) Return(_a0 []model.BidDB, _a1 int, _a2 error) *Tx_BidsForTender_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Tx_BidsForTender_Call) RunAndReturn(run func(context.Context, string, string, model.PageQuery) ([]model.BidDB, int, error)) *Tx_BidsForTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeTenderStatus provides a mock function with given fields: ctx, tenderId, status, expectedVersion
+func (_m *Tx) ChangeTenderStatus(ctx context.Context, tenderId string, status string, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, tenderId, status, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeTenderStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) (string, error)); ok {
+		return rf(ctx, tenderId, status, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) string); ok {
+		r0 = rf(ctx, tenderId, status, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, tenderId, status, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_ChangeTenderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeTenderStatus'
+type Tx_ChangeTenderStatus_Call struct {
+	*mock.Call
+}
+
+// ChangeTenderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - status string
+//   - expectedVersion int32
+func (_e *Tx_Expecter) ChangeTenderStatus(ctx interface{}, tenderId interface{}, status interface{}, expectedVersion interface{}) *Tx_ChangeTenderStatus_Call {
+	return &Tx_ChangeTenderStatus_Call{Call: _e.mock.On("ChangeTenderStatus", ctx, tenderId, status, expectedVersion)}
+}
+
+func (_c *Tx_ChangeTenderStatus_Call) Run(run func(ctx context.Context, tenderId string, status string, expectedVersion int32)) *Tx_ChangeTenderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *Tx_ChangeTenderStatus_Call) Return(_a0 string, _a1 error) *Tx_ChangeTenderStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_ChangeTenderStatus_Call) RunAndReturn(run func(context.Context, string, string, int32) (string, error)) *Tx_ChangeTenderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBid provides a mock function with given fields: ctx, bidId
+func (_m *Tx) CheckBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBid")
+	}
+
+	var r0 model.BidDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.BidDB, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.BidDB); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Get(0).(model.BidDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBid'
+type Tx_CheckBid_Call struct {
+	*mock.Call
+}
+
+// CheckBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Tx_Expecter) CheckBid(ctx interface{}, bidId interface{}) *Tx_CheckBid_Call {
+	return &Tx_CheckBid_Call{Call: _e.mock.On("CheckBid", ctx, bidId)}
+}
+
+func (_c *Tx_CheckBid_Call) Run(run func(ctx context.Context, bidId string)) *Tx_CheckBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBid_Call) Return(_a0 model.BidDB, _a1 error) *Tx_CheckBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckBid_Call) RunAndReturn(run func(context.Context, string) (model.BidDB, error)) *Tx_CheckBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidAuthorByUsername provides a mock function with given fields: ctx, bidId, username
+func (_m *Tx) CheckBidAuthorByUsername(ctx context.Context, bidId string, username string) error {
+	ret := _m.Called(ctx, bidId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidAuthorByUsername")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckBidAuthorByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidAuthorByUsername'
+type Tx_CheckBidAuthorByUsername_Call struct {
+	*mock.Call
+}
+
+// CheckBidAuthorByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - username string
+func (_e *Tx_Expecter) CheckBidAuthorByUsername(ctx interface{}, bidId interface{}, username interface{}) *Tx_CheckBidAuthorByUsername_Call {
+	return &Tx_CheckBidAuthorByUsername_Call{Call: _e.mock.On("CheckBidAuthorByUsername", ctx, bidId, username)}
+}
+
+func (_c *Tx_CheckBidAuthorByUsername_Call) Run(run func(ctx context.Context, bidId string, username string)) *Tx_CheckBidAuthorByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBidAuthorByUsername_Call) Return(_a0 error) *Tx_CheckBidAuthorByUsername_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckBidAuthorByUsername_Call) RunAndReturn(run func(context.Context, string, string) error) *Tx_CheckBidAuthorByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidAvailability provides a mock function with given fields: ctx, bidId
+func (_m *Tx) CheckBidAvailability(ctx context.Context, bidId string) error {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidAvailability")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckBidAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidAvailability'
+type Tx_CheckBidAvailability_Call struct {
+	*mock.Call
+}
+
+// CheckBidAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Tx_Expecter) CheckBidAvailability(ctx interface{}, bidId interface{}) *Tx_CheckBidAvailability_Call {
+	return &Tx_CheckBidAvailability_Call{Call: _e.mock.On("CheckBidAvailability", ctx, bidId)}
+}
+
+func (_c *Tx_CheckBidAvailability_Call) Run(run func(ctx context.Context, bidId string)) *Tx_CheckBidAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBidAvailability_Call) Return(_a0 error) *Tx_CheckBidAvailability_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckBidAvailability_Call) RunAndReturn(run func(context.Context, string) error) *Tx_CheckBidAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidCanceled provides a mock function with given fields: ctx, bidId
+func (_m *Tx) CheckBidCanceled(ctx context.Context, bidId string) error {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidCanceled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckBidCanceled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidCanceled'
+type Tx_CheckBidCanceled_Call struct {
+	*mock.Call
+}
+
+// CheckBidCanceled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Tx_Expecter) CheckBidCanceled(ctx interface{}, bidId interface{}) *Tx_CheckBidCanceled_Call {
+	return &Tx_CheckBidCanceled_Call{Call: _e.mock.On("CheckBidCanceled", ctx, bidId)}
+}
+
+func (_c *Tx_CheckBidCanceled_Call) Run(run func(ctx context.Context, bidId string)) *Tx_CheckBidCanceled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBidCanceled_Call) Return(_a0 error) *Tx_CheckBidCanceled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckBidCanceled_Call) RunAndReturn(run func(context.Context, string) error) *Tx_CheckBidCanceled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidDecisionCount provides a mock function with given fields: ctx, bidId, decision
+func (_m *Tx) CheckBidDecisionCount(ctx context.Context, bidId string, decision string) (int, error) {
+	ret := _m.Called(ctx, bidId, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidDecisionCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return rf(ctx, bidId, decision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, bidId, decision)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bidId, decision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckBidDecisionCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidDecisionCount'
+type Tx_CheckBidDecisionCount_Call struct {
+	*mock.Call
+}
+
+// CheckBidDecisionCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - decision string
+func (_e *Tx_Expecter) CheckBidDecisionCount(ctx interface{}, bidId interface{}, decision interface{}) *Tx_CheckBidDecisionCount_Call {
+	return &Tx_CheckBidDecisionCount_Call{Call: _e.mock.On("CheckBidDecisionCount", ctx, bidId, decision)}
+}
+
+func (_c *Tx_CheckBidDecisionCount_Call) Run(run func(ctx context.Context, bidId string, decision string)) *Tx_CheckBidDecisionCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBidDecisionCount_Call) Return(_a0 int, _a1 error) *Tx_CheckBidDecisionCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckBidDecisionCount_Call) RunAndReturn(run func(context.Context, string, string) (int, error)) *Tx_CheckBidDecisionCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckBidVersion provides a mock function with given fields: ctx, bidId, version
+func (_m *Tx) CheckBidVersion(ctx context.Context, bidId string, version int32) error {
+	ret := _m.Called(ctx, bidId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBidVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, bidId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckBidVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBidVersion'
+type Tx_CheckBidVersion_Call struct {
+	*mock.Call
+}
+
+// CheckBidVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - version int32
+func (_e *Tx_Expecter) CheckBidVersion(ctx interface{}, bidId interface{}, version interface{}) *Tx_CheckBidVersion_Call {
+	return &Tx_CheckBidVersion_Call{Call: _e.mock.On("CheckBidVersion", ctx, bidId, version)}
+}
+
+func (_c *Tx_CheckBidVersion_Call) Run(run func(ctx context.Context, bidId string, version int32)) *Tx_CheckBidVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckBidVersion_Call) Return(_a0 error) *Tx_CheckBidVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckBidVersion_Call) RunAndReturn(run func(context.Context, string, int32) error) *Tx_CheckBidVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckCorporateById provides a mock function with given fields: ctx, userId
+func (_m *Tx) CheckCorporateById(ctx context.Context, userId string) (string, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckCorporateById")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckCorporateById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCorporateById'
+type Tx_CheckCorporateById_Call struct {
+	*mock.Call
+}
+
+// CheckCorporateById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *Tx_Expecter) CheckCorporateById(ctx interface{}, userId interface{}) *Tx_CheckCorporateById_Call {
+	return &Tx_CheckCorporateById_Call{Call: _e.mock.On("CheckCorporateById", ctx, userId)}
+}
+
+func (_c *Tx_CheckCorporateById_Call) Run(run func(ctx context.Context, userId string)) *Tx_CheckCorporateById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckCorporateById_Call) Return(_a0 string, _a1 error) *Tx_CheckCorporateById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckCorporateById_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Tx_CheckCorporateById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckDecisionPolicy provides a mock function with given fields: ctx, tenderId
+func (_m *Tx) CheckDecisionPolicy(ctx context.Context, tenderId string) (quorum.Policy, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDecisionPolicy")
+	}
+
+	var r0 quorum.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (quorum.Policy, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) quorum.Policy); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(quorum.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckDecisionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDecisionPolicy'
+type Tx_CheckDecisionPolicy_Call struct {
+	*mock.Call
+}
+
+// CheckDecisionPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *Tx_Expecter) CheckDecisionPolicy(ctx interface{}, tenderId interface{}) *Tx_CheckDecisionPolicy_Call {
+	return &Tx_CheckDecisionPolicy_Call{Call: _e.mock.On("CheckDecisionPolicy", ctx, tenderId)}
+}
+
+func (_c *Tx_CheckDecisionPolicy_Call) Run(run func(ctx context.Context, tenderId string)) *Tx_CheckDecisionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckDecisionPolicy_Call) Return(_a0 quorum.Policy, _a1 error) *Tx_CheckDecisionPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckDecisionPolicy_Call) RunAndReturn(run func(context.Context, string) (quorum.Policy, error)) *Tx_CheckDecisionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckIdByName provides a mock function with given fields: ctx, username
+func (_m *Tx) CheckIdByName(ctx context.Context, username string) (string, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIdByName")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckIdByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIdByName'
+type Tx_CheckIdByName_Call struct {
+	*mock.Call
+}
+
+// CheckIdByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *Tx_Expecter) CheckIdByName(ctx interface{}, username interface{}) *Tx_CheckIdByName_Call {
+	return &Tx_CheckIdByName_Call{Call: _e.mock.On("CheckIdByName", ctx, username)}
+}
+
+func (_c *Tx_CheckIdByName_Call) Run(run func(ctx context.Context, username string)) *Tx_CheckIdByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckIdByName_Call) Return(_a0 string, _a1 error) *Tx_CheckIdByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckIdByName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Tx_CheckIdByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckKnownNames provides a mock function with given fields: ctx, names
+func (_m *Tx) CheckKnownNames(ctx context.Context, names []string) ([]string, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckKnownNames")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckKnownNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckKnownNames'
+type Tx_CheckKnownNames_Call struct {
+	*mock.Call
+}
+
+// CheckKnownNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *Tx_Expecter) CheckKnownNames(ctx interface{}, names interface{}) *Tx_CheckKnownNames_Call {
+	return &Tx_CheckKnownNames_Call{Call: _e.mock.On("CheckKnownNames", ctx, names)}
+}
+
+func (_c *Tx_CheckKnownNames_Call) Run(run func(ctx context.Context, names []string)) *Tx_CheckKnownNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckKnownNames_Call) Return(_a0 []string, _a1 error) *Tx_CheckKnownNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckKnownNames_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *Tx_CheckKnownNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckMemberships provides a mock function with given fields: ctx, userId
+func (_m *Tx) CheckMemberships(ctx context.Context, userId string) ([]model.MembershipDB, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckMemberships")
+	}
+
+	var r0 []model.MembershipDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.MembershipDB, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.MembershipDB); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MembershipDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckMemberships'
+type Tx_CheckMemberships_Call struct {
+	*mock.Call
+}
+
+// CheckMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *Tx_Expecter) CheckMemberships(ctx interface{}, userId interface{}) *Tx_CheckMemberships_Call {
+	return &Tx_CheckMemberships_Call{Call: _e.mock.On("CheckMemberships", ctx, userId)}
+}
+
+func (_c *Tx_CheckMemberships_Call) Run(run func(ctx context.Context, userId string)) *Tx_CheckMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckMemberships_Call) Return(_a0 []model.MembershipDB, _a1 error) *Tx_CheckMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckMemberships_Call) RunAndReturn(run func(context.Context, string) ([]model.MembershipDB, error)) *Tx_CheckMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckResponsibleCount provides a mock function with given fields: ctx, organizationId, roles
+func (_m *Tx) CheckResponsibleCount(ctx context.Context, organizationId string, roles []string) (int, error) {
+	ret := _m.Called(ctx, organizationId, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckResponsibleCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (int, error)); ok {
+		return rf(ctx, organizationId, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) int); ok {
+		r0 = rf(ctx, organizationId, roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, organizationId, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckResponsibleCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckResponsibleCount'
+type Tx_CheckResponsibleCount_Call struct {
+	*mock.Call
+}
+
+// CheckResponsibleCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - roles []string
+func (_e *Tx_Expecter) CheckResponsibleCount(ctx interface{}, organizationId interface{}, roles interface{}) *Tx_CheckResponsibleCount_Call {
+	return &Tx_CheckResponsibleCount_Call{Call: _e.mock.On("CheckResponsibleCount", ctx, organizationId, roles)}
+}
+
+func (_c *Tx_CheckResponsibleCount_Call) Run(run func(ctx context.Context, organizationId string, roles []string)) *Tx_CheckResponsibleCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckResponsibleCount_Call) Return(_a0 int, _a1 error) *Tx_CheckResponsibleCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckResponsibleCount_Call) RunAndReturn(run func(context.Context, string, []string) (int, error)) *Tx_CheckResponsibleCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckRole provides a mock function with given fields: ctx, organizationId, userId
+func (_m *Tx) CheckRole(ctx context.Context, organizationId string, userId string) (string, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckRole")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckRole'
+type Tx_CheckRole_Call struct {
+	*mock.Call
+}
+
+// CheckRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+func (_e *Tx_Expecter) CheckRole(ctx interface{}, organizationId interface{}, userId interface{}) *Tx_CheckRole_Call {
+	return &Tx_CheckRole_Call{Call: _e.mock.On("CheckRole", ctx, organizationId, userId)}
+}
+
+func (_c *Tx_CheckRole_Call) Run(run func(ctx context.Context, organizationId string, userId string)) *Tx_CheckRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckRole_Call) Return(_a0 string, _a1 error) *Tx_CheckRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckRole_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *Tx_CheckRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSameSubmitter provides a mock function with given fields: ctx, bidId, username
+func (_m *Tx) CheckSameSubmitter(ctx context.Context, bidId string, username string) error {
+	ret := _m.Called(ctx, bidId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSameSubmitter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckSameSubmitter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSameSubmitter'
+type Tx_CheckSameSubmitter_Call struct {
+	*mock.Call
+}
+
+// CheckSameSubmitter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - username string
+func (_e *Tx_Expecter) CheckSameSubmitter(ctx interface{}, bidId interface{}, username interface{}) *Tx_CheckSameSubmitter_Call {
+	return &Tx_CheckSameSubmitter_Call{Call: _e.mock.On("CheckSameSubmitter", ctx, bidId, username)}
+}
+
+func (_c *Tx_CheckSameSubmitter_Call) Run(run func(ctx context.Context, bidId string, username string)) *Tx_CheckSameSubmitter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckSameSubmitter_Call) Return(_a0 error) *Tx_CheckSameSubmitter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckSameSubmitter_Call) RunAndReturn(run func(context.Context, string, string) error) *Tx_CheckSameSubmitter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckTender provides a mock function with given fields: ctx, tenderId
+func (_m *Tx) CheckTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTender")
+	}
+
+	var r0 model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.TenderDB, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.TenderDB); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(model.TenderDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CheckTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTender'
+type Tx_CheckTender_Call struct {
+	*mock.Call
+}
+
+// CheckTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *Tx_Expecter) CheckTender(ctx interface{}, tenderId interface{}) *Tx_CheckTender_Call {
+	return &Tx_CheckTender_Call{Call: _e.mock.On("CheckTender", ctx, tenderId)}
+}
+
+func (_c *Tx_CheckTender_Call) Run(run func(ctx context.Context, tenderId string)) *Tx_CheckTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckTender_Call) Return(_a0 model.TenderDB, _a1 error) *Tx_CheckTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CheckTender_Call) RunAndReturn(run func(context.Context, string) (model.TenderDB, error)) *Tx_CheckTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckTenderVersion provides a mock function with given fields: ctx, tenderId, version
+func (_m *Tx) CheckTenderVersion(ctx context.Context, tenderId string, version int32) error {
+	ret := _m.Called(ctx, tenderId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTenderVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) error); ok {
+		r0 = rf(ctx, tenderId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_CheckTenderVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTenderVersion'
+type Tx_CheckTenderVersion_Call struct {
+	*mock.Call
+}
+
+// CheckTenderVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - version int32
+func (_e *Tx_Expecter) CheckTenderVersion(ctx interface{}, tenderId interface{}, version interface{}) *Tx_CheckTenderVersion_Call {
+	return &Tx_CheckTenderVersion_Call{Call: _e.mock.On("CheckTenderVersion", ctx, tenderId, version)}
+}
+
+func (_c *Tx_CheckTenderVersion_Call) Run(run func(ctx context.Context, tenderId string, version int32)) *Tx_CheckTenderVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Tx_CheckTenderVersion_Call) Return(_a0 error) *Tx_CheckTenderVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_CheckTenderVersion_Call) RunAndReturn(run func(context.Context, string, int32) error) *Tx_CheckTenderVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmployee provides a mock function with given fields: ctx, username, firstName, lastName
+func (_m *Tx) CreateEmployee(ctx context.Context, username string, firstName string, lastName string) (string, error) {
+	ret := _m.Called(ctx, username, firstName, lastName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmployee")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, username, firstName, lastName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, username, firstName, lastName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, username, firstName, lastName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_CreateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployee'
+type Tx_CreateEmployee_Call struct {
+	*mock.Call
+}
+
+// CreateEmployee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - firstName string
+//   - lastName string
+func (_e *Tx_Expecter) CreateEmployee(ctx interface{}, username interface{}, firstName interface{}, lastName interface{}) *Tx_CreateEmployee_Call {
+	return &Tx_CreateEmployee_Call{Call: _e.mock.On("CreateEmployee", ctx, username, firstName, lastName)}
+}
+
+func (_c *Tx_CreateEmployee_Call) Run(run func(ctx context.Context, username string, firstName string, lastName string)) *Tx_CreateEmployee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_CreateEmployee_Call) Return(_a0 string, _a1 error) *Tx_CreateEmployee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_CreateEmployee_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *Tx_CreateEmployee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockBid provides a mock function with given fields: ctx, bidId
+func (_m *Tx) LockBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockBid")
+	}
+
+	var r0 model.BidDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.BidDB, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.BidDB); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Get(0).(model.BidDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_LockBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockBid'
+type Tx_LockBid_Call struct {
+	*mock.Call
+}
+
+// LockBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *Tx_Expecter) LockBid(ctx interface{}, bidId interface{}) *Tx_LockBid_Call {
+	return &Tx_LockBid_Call{Call: _e.mock.On("LockBid", ctx, bidId)}
+}
+
+func (_c *Tx_LockBid_Call) Run(run func(ctx context.Context, bidId string)) *Tx_LockBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_LockBid_Call) Return(_a0 model.BidDB, _a1 error) *Tx_LockBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_LockBid_Call) RunAndReturn(run func(context.Context, string) (model.BidDB, error)) *Tx_LockBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTender provides a mock function with given fields: ctx, tenderId
+func (_m *Tx) LockTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTender")
+	}
+
+	var r0 model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.TenderDB, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.TenderDB); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(model.TenderDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_LockTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTender'
+type Tx_LockTender_Call struct {
+	*mock.Call
+}
+
+// LockTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *Tx_Expecter) LockTender(ctx interface{}, tenderId interface{}) *Tx_LockTender_Call {
+	return &Tx_LockTender_Call{Call: _e.mock.On("LockTender", ctx, tenderId)}
+}
+
+func (_c *Tx_LockTender_Call) Run(run func(ctx context.Context, tenderId string)) *Tx_LockTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_LockTender_Call) Return(_a0 model.TenderDB, _a1 error) *Tx_LockTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_LockTender_Call) RunAndReturn(run func(context.Context, string) (model.TenderDB, error)) *Tx_LockTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCredentials provides a mock function with given fields: ctx, employeeId, passwordHash
+func (_m *Tx) SetCredentials(ctx context.Context, employeeId string, passwordHash string) error {
+	ret := _m.Called(ctx, employeeId, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCredentials")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, employeeId, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_SetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCredentials'
+type Tx_SetCredentials_Call struct {
+	*mock.Call
+}
+
+// SetCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+//   - passwordHash string
+func (_e *Tx_Expecter) SetCredentials(ctx interface{}, employeeId interface{}, passwordHash interface{}) *Tx_SetCredentials_Call {
+	return &Tx_SetCredentials_Call{Call: _e.mock.On("SetCredentials", ctx, employeeId, passwordHash)}
+}
+
+func (_c *Tx_SetCredentials_Call) Run(run func(ctx context.Context, employeeId string, passwordHash string)) *Tx_SetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_SetCredentials_Call) Return(_a0 error) *Tx_SetCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_SetCredentials_Call) RunAndReturn(run func(context.Context, string, string) error) *Tx_SetCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitDecision provides a mock function with given fields: ctx, bidId, responsibleId, decision, comment
+func (_m *Tx) SubmitDecision(ctx context.Context, bidId string, responsibleId string, decision string, comment string) error {
+	ret := _m.Called(ctx, bidId, responsibleId, decision, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, bidId, responsibleId, decision, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_SubmitDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitDecision'
+type Tx_SubmitDecision_Call struct {
+	*mock.Call
+}
+
+// SubmitDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - responsibleId string
+//   - decision string
+//   - comment string
+func (_e *Tx_Expecter) SubmitDecision(ctx interface{}, bidId interface{}, responsibleId interface{}, decision interface{}, comment interface{}) *Tx_SubmitDecision_Call {
+	return &Tx_SubmitDecision_Call{Call: _e.mock.On("SubmitDecision", ctx, bidId, responsibleId, decision, comment)}
+}
+
+func (_c *Tx_SubmitDecision_Call) Run(run func(ctx context.Context, bidId string, responsibleId string, decision string, comment string)) *Tx_SubmitDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_SubmitDecision_Call) Return(_a0 error) *Tx_SubmitDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_SubmitDecision_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *Tx_SubmitDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBidStatus provides a mock function with given fields: ctx, bidId, status, expectedVersion
+func (_m *Tx) UpdateBidStatus(ctx context.Context, bidId string, status string, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, bidId, status, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBidStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) (string, error)); ok {
+		return rf(ctx, bidId, status, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) string); ok {
+		r0 = rf(ctx, bidId, status, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, bidId, status, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_UpdateBidStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBidStatus'
+type Tx_UpdateBidStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateBidStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - status string
+//   - expectedVersion int32
+func (_e *Tx_Expecter) UpdateBidStatus(ctx interface{}, bidId interface{}, status interface{}, expectedVersion interface{}) *Tx_UpdateBidStatus_Call {
+	return &Tx_UpdateBidStatus_Call{Call: _e.mock.On("UpdateBidStatus", ctx, bidId, status, expectedVersion)}
+}
+
+func (_c *Tx_UpdateBidStatus_Call) Run(run func(ctx context.Context, bidId string, status string, expectedVersion int32)) *Tx_UpdateBidStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *Tx_UpdateBidStatus_Call) Return(_a0 string, _a1 error) *Tx_UpdateBidStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_UpdateBidStatus_Call) RunAndReturn(run func(context.Context, string, string, int32) (string, error)) *Tx_UpdateBidStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseInvite provides a mock function with given fields: ctx, tokenHash
+func (_m *Tx) UseInvite(ctx context.Context, tokenHash string) (string, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseInvite")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_UseInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseInvite'
+type Tx_UseInvite_Call struct {
+	*mock.Call
+}
+
+// UseInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Tx_Expecter) UseInvite(ctx interface{}, tokenHash interface{}) *Tx_UseInvite_Call {
+	return &Tx_UseInvite_Call{Call: _e.mock.On("UseInvite", ctx, tokenHash)}
+}
+
+func (_c *Tx_UseInvite_Call) Run(run func(ctx context.Context, tokenHash string)) *Tx_UseInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Tx_UseInvite_Call) Return(_a0 string, _a1 error) *Tx_UseInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_UseInvite_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Tx_UseInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTx creates a new instance of Tx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Tx {
+	mock := &Tx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+type attachmentOwner struct {
+	table string
+	// column refers to owner both in attachment and in version table
+	column        string
+	versionTable  string
+	insertVersion string
+}
+
+// attachmentOwners tells where ids of attachments are kept for every owner type
+var attachmentOwners = map[string]attachmentOwner{
+	model.OwnerTender: {table: "tender", column: "tender_id", versionTable: "tender_version", insertVersion: insertTenderVersion},
+	model.OwnerBid:    {table: "bid", column: "bid_id", versionTable: "bid_version", insertVersion: insertBidVersion},
+}
+
+const selectAttachment = `
+		SELECT a.id, CASE WHEN a.tender_id IS NULL THEN 'Bid' ELSE 'Tender' END AS owner_type,
+		       CAST(COALESCE(a.tender_id, a.bid_id) AS text) AS owner_id, a.name, a.content_type, a.size,
+		       a.checksum, a.blob_key, COALESCE(CAST(a.uploaded_by AS text), '') AS uploaded_by, a.created_at
+		FROM attachment a`
+
+func (s *Storage) AddAttachment(ctx context.Context, attachment model.AttachmentDB, expectedVersion int32) (model.AttachmentDB, int, error) {
+	const op = "Repo.AddAttachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	owner, ok := attachmentOwners[attachment.OwnerType]
+	if !ok {
+		return model.AttachmentDB{}, 0, errs.Validation(fmt.Errorf("unknown owner type %s", attachment.OwnerType))
+	}
+	var (
+		insertQuery = fmt.Sprintf(`
+		INSERT INTO attachment (%s, name, content_type, size, checksum, blob_key, uploaded_by)
+		VALUES ($1::uuid, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid)
+		RETURNING id;
+`, owner.column)
+		insertValues = []any{
+			attachment.OwnerId, attachment.Name, attachment.ContentType, attachment.Size,
+			attachment.Checksum, attachment.BlobKey, attachment.UploadedBy,
+		}
+		updateQuery = fmt.Sprintf(`
+		UPDATE %s
+		SET attachments = array_append(attachments, $2::uuid),
+			version = version + 1
+		WHERE id = $1::uuid
+		RETURNING version;
+`, owner.table)
+		selectQuery = selectAttachment + `
+		WHERE a.id = $1::uuid;
+`
+		id      string
+		version int
+	)
+
+	tx, err := s.begin(ctx)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return model.AttachmentDB{}, 0, errs.Internal(err)
+	}
+	defer tx.Rollback()
+
+	err = saveVersion(ctx, tx, owner.table, owner.insertVersion, attachment.OwnerId, expectedVersion)
+	if err != nil {
+		log.Error("failed to save owner to history", sl.Err(err))
+		return model.AttachmentDB{}, 0, err
+	}
+
+	err = tx.GetContext(ctx, &id, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to insert attachment", sl.Err(err))
+		return model.AttachmentDB{}, 0, errs.Internal(err)
+	}
+
+	err = tx.GetContext(ctx, &version, updateQuery, attachment.OwnerId, id)
+	if err != nil {
+		log.Error("failed to attach", sl.Err(err))
+		return model.AttachmentDB{}, 0, errs.Internal(err)
+	}
+
+	err = tx.GetContext(ctx, &attachment, selectQuery, id)
+	if err != nil {
+		log.Error("failed to select attachment", sl.Err(err))
+		return model.AttachmentDB{}, 0, errs.Internal(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return model.AttachmentDB{}, 0, errs.Internal(err)
+	}
+
+	return attachment, version, nil
+}
+
+// Attachments keeps order in which files were attached
+func (s *Storage) Attachments(ctx context.Context, ownerType string, ownerId string, version int32) ([]model.AttachmentDB, error) {
+	const op = "Repo.Attachments"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	owner, ok := attachmentOwners[ownerType]
+	if !ok {
+		return nil, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+	}
+	var (
+		query = selectAttachment + fmt.Sprintf(`
+		JOIN (
+			SELECT attachments
+			FROM %s
+			WHERE id = $1::uuid
+			  AND ($2::int = 0 OR version = $2)
+			UNION ALL
+			SELECT attachments
+			FROM %s
+			WHERE %s = $1::uuid
+			  AND $2::int <> 0 AND version = $2
+		) v ON a.id = ANY(v.attachments)
+		ORDER BY array_position(v.attachments, a.id);
+`, owner.table, owner.versionTable, owner.column)
+		attachments []model.AttachmentDB
+	)
+
+	err := s.db.SelectContext(ctx, &attachments, query, ownerId, version)
+	if err != nil {
+		log.Error("failed to select attachments", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return attachments, nil
+}
+
+func (s *Storage) Attachment(ctx context.Context, ownerType string, ownerId string, attachmentId string) (model.AttachmentDB, error) {
+	const op = "Repo.Attachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	owner, ok := attachmentOwners[ownerType]
+	if !ok {
+		return model.AttachmentDB{}, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+	}
+	var (
+		query = selectAttachment + fmt.Sprintf(`
+		WHERE a.id = $1::uuid
+		  AND a.%s = $2::uuid;
+`, owner.column)
+		attachment model.AttachmentDB
+	)
+
+	err := s.db.GetContext(ctx, &attachment, query, attachmentId, ownerId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.AttachmentDB{}, errs.NotFound(fmt.Errorf("attachment not found"))
+	}
+	if err != nil {
+		log.Error("failed to select attachment", sl.Err(err))
+		return model.AttachmentDB{}, errs.Internal(err)
+	}
+
+	return attachment, nil
+}
+
+// RemoveAttachment keeps attachment row, older versions of owner still refer to it
+func (s *Storage) RemoveAttachment(ctx context.Context, ownerType string, ownerId string, attachmentId string, expectedVersion int32) (int, error) {
+	const op = "Repo.RemoveAttachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	owner, ok := attachmentOwners[ownerType]
+	if !ok {
+		return 0, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+	}
+	var (
+		updateQuery = fmt.Sprintf(`
+		UPDATE %s
+		SET attachments = array_remove(attachments, $2::uuid),
+			version = version + 1
+		WHERE id = $1::uuid
+		  AND $2::uuid = ANY(attachments)
+		RETURNING version;
+`, owner.table)
+		version int
+	)
+
+	tx, err := s.begin(ctx)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return 0, errs.Internal(err)
+	}
+	defer tx.Rollback()
+
+	err = saveVersion(ctx, tx, owner.table, owner.insertVersion, ownerId, expectedVersion)
+	if err != nil {
+		log.Error("failed to save owner to history", sl.Err(err))
+		return 0, err
+	}
+
+	err = tx.GetContext(ctx, &version, updateQuery, ownerId, attachmentId)
+	// snapshot is rolled back together with nothing to detach
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, errs.NotFound(fmt.Errorf("attachment not found"))
+	}
+	if err != nil {
+		log.Error("failed to detach", sl.Err(err))
+		return 0, errs.Internal(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return 0, errs.Internal(err)
+	}
+
+	return version, nil
+}
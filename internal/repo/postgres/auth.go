@@ -0,0 +1,307 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+func (s *Storage) Credentials(ctx context.Context, username string) (model.CredentialsDB, error) {
+	const op = "Repo.Credentials"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT c.employee_id, e.username, c.password_hash
+		FROM employee_credentials c
+		JOIN employee e ON e.id = c.employee_id
+		WHERE e.username = $1;
+`
+		selectValues = []any{
+			username,
+		}
+		credentials model.CredentialsDB
+	)
+
+	err := s.db.GetContext(ctx, &credentials, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("credentials not found", sl.Err(err))
+		return model.CredentialsDB{}, errs.Unauthorized(fmt.Errorf("invalid username or password"))
+	}
+
+	return credentials, nil
+}
+
+func (s *Storage) SetCredentials(ctx context.Context, employeeId string, passwordHash string) error {
+	const op = "Repo.SetCredentials"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO employee_credentials (employee_id, password_hash)
+		VALUES ($1::uuid, $2)
+		ON CONFLICT (employee_id) DO NOTHING;
+`
+		insertValues = []any{
+			employeeId, passwordHash,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to set credentials", sl.Err(err))
+		return errs.Internal(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Error("failed to get affected rows", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected == 0 {
+		return errs.Conflict(fmt.Errorf("user is already registered"))
+	}
+
+	return nil
+}
+
+func (s *Storage) CreateInvite(ctx context.Context, employeeId string, createdBy string, tokenHash string, ttl time.Duration) error {
+	const op = "Repo.CreateInvite"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO employee_invite (token_hash, employee_id, created_by, expires_at)
+		VALUES ($1, $2::uuid, NULLIF($3, '')::uuid, CURRENT_TIMESTAMP + $4 * INTERVAL '1 second');
+`
+		insertValues = []any{
+			tokenHash, employeeId, createdBy, int64(ttl.Seconds()),
+		}
+	)
+
+	_, err := s.db.ExecContext(ctx, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to create invite", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) UseInvite(ctx context.Context, tokenHash string) (string, error) {
+	const op = "Repo.UseInvite"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		deleteQuery = `
+		DELETE FROM employee_invite
+		WHERE token_hash = $1
+		AND expires_at > CURRENT_TIMESTAMP
+		RETURNING employee_id;
+`
+		deleteValues = []any{
+			tokenHash,
+		}
+		employeeId string
+	)
+
+	err := s.db.GetContext(ctx, &employeeId, deleteQuery, deleteValues...)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Info("invite not found")
+		return "", errs.Unauthorized(fmt.Errorf("invite is invalid or expired"))
+	}
+	if err != nil {
+		log.Error("failed to use invite", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	return employeeId, nil
+}
+
+func (s *Storage) CreateSession(ctx context.Context, employeeId string, refreshTokenHash string, ttl time.Duration) (string, error) {
+	const op = "Repo.CreateSession"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO auth_session (employee_id, refresh_token_hash, expires_at)
+		VALUES ($1::uuid, $2, CURRENT_TIMESTAMP + $3 * INTERVAL '1 second')
+		RETURNING id;
+`
+		insertValues = []any{
+			employeeId, refreshTokenHash, int64(ttl.Seconds()),
+		}
+		id string
+	)
+
+	err := s.db.GetContext(ctx, &id, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to create session", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) ActiveSession(ctx context.Context, sessionId string) (model.SessionDB, error) {
+	const op = "Repo.ActiveSession"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT s.id, s.employee_id, e.username, s.expires_at, s.revoked_at
+		FROM auth_session s
+		JOIN employee e ON e.id = s.employee_id
+		WHERE s.id = $1::uuid
+		AND s.revoked_at IS NULL
+		AND s.expires_at > CURRENT_TIMESTAMP;
+`
+		selectValues = []any{
+			sessionId,
+		}
+		session model.SessionDB
+	)
+
+	err := s.db.GetContext(ctx, &session, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("session not found", sl.Err(err))
+		return model.SessionDB{}, errs.Unauthorized(fmt.Errorf("session is expired or revoked"))
+	}
+
+	return session, nil
+}
+
+func (s *Storage) RotateSession(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, ttl time.Duration) (model.SessionDB, error) {
+	const op = "Repo.RotateSession"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE auth_session s
+		SET refresh_token_hash = $2,
+		    expires_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 second'
+		FROM employee e
+		WHERE e.id = s.employee_id
+		AND s.refresh_token_hash = $1
+		AND s.revoked_at IS NULL
+		AND s.expires_at > CURRENT_TIMESTAMP
+		RETURNING s.id, s.employee_id, e.username, s.expires_at, s.revoked_at;
+`
+		updateValues = []any{
+			refreshTokenHash, newRefreshTokenHash, int64(ttl.Seconds()),
+		}
+		session model.SessionDB
+	)
+
+	err := s.db.GetContext(ctx, &session, updateQuery, updateValues...)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Info("refresh token not found")
+		return model.SessionDB{}, errs.Unauthorized(fmt.Errorf("refresh token is expired or revoked"))
+	}
+	if err != nil {
+		log.Error("failed to rotate session", sl.Err(err))
+		return model.SessionDB{}, errs.Internal(err)
+	}
+
+	return session, nil
+}
+
+func (s *Storage) RevokeSession(ctx context.Context, sessionId string) error {
+	const op = "Repo.RevokeSession"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE auth_session
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE id = $1::uuid
+		AND revoked_at IS NULL;
+`
+		updateValues = []any{
+			sessionId,
+		}
+	)
+
+	_, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to revoke session", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeEmployeeSessions(ctx context.Context, employeeId string) error {
+	const op = "Repo.RevokeEmployeeSessions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE auth_session
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE employee_id = $1::uuid
+		AND revoked_at IS NULL;
+`
+		updateValues = []any{
+			employeeId,
+		}
+	)
+
+	_, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to revoke sessions", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) EmployeeIdByName(ctx context.Context, username string) (string, error) {
+	const op = "Repo.EmployeeIdByName"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id
+		FROM employee
+		WHERE username = $1;
+`
+		selectValues = []any{
+			username,
+		}
+		id string
+	)
+
+	err := s.db.GetContext(ctx, &id, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("employee not found", sl.Err(err))
+		return "", errs.Unauthorized(fmt.Errorf("user not found"))
+	}
+
+	return id, nil
+}
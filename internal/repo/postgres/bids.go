@@ -1,15 +1,19 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"log/slog"
-	"net/http"
+	"zadanie-6105/internal/domain/errs"
 	model2 "zadanie-6105/internal/domain/model"
 	sl "zadanie-6105/internal/lib/logger/slog"
 )
 
-func (s *Storage) CreateBid(name string, description string, tenderId string, authorType string, authorId string) (string, error) {
+func (s *Storage) CreateBid(ctx context.Context, name string, description string, tenderId string, authorType string, authorId string, offer *model2.Offer) (string, error) {
 	const op = "Repo.CreateBid"
 	log := s.log.With(
 		slog.String("op", op),
@@ -31,36 +35,59 @@ func (s *Storage) CreateBid(name string, description string, tenderId string, au
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	row := tx.QueryRow(insertQuery, insertValues...)
+	row := tx.QueryRowContext(ctx, insertQuery, insertValues...)
 	err = row.Scan(&id)
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	err = saveOffer(ctx, tx, id, offer)
+	if err != nil {
+		log.Error("failed to save offer", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+	err = addBidEvent(ctx, tx, model2.EventBidCreated, id, nil)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) GetBidsById(limit int32, offset int32, authorId string) ([]model2.BidDB, error) {
+// bidSortColumns are shared by bids lists, name may be null
+var bidSortColumns = map[string]string{
+	model2.SortName:      "COALESCE(b.name, '')",
+	model2.SortCreatedAt: "b.createdAt",
+	model2.SortVersion:   "b.version",
+}
+
+// GetBidsById merges bids of all authors into one list, so that paging is consistent
+func (s *Storage) GetBidsById(ctx context.Context, page model2.PageQuery, authorIds []string) ([]model2.BidDB, int, error) {
 	const op = "Repo.GetBidsById"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
+	after, order, afterValues := keyset(page, bidSortColumns, "b.id", 4)
 	var (
+		filter = `
+		FROM bid b
+		WHERE b.authorId = ANY($1::uuid[])
+`
 		selectQuery = `
 		SELECT 
 		    b.id,
@@ -69,65 +96,92 @@ func (s *Storage) GetBidsById(limit int32, offset int32, authorId string) ([]mod
 		    CAST(b.status AS text),
 			b.tenderId,
 			CAST(b.authorType AS text),
-     		COALESCE(e.id, o.id) AS authorId,
+     		b.authorId,
      		b.version,
-     		b.createdAt
-		FROM bid b
-		LEFT JOIN employee e ON  e.id = b.authorId  
-		LEFT JOIN organization o ON o.id = b.authorId  
-		WHERE COALESCE(e.id, o.id) = $3::uuid 
-		ORDER BY name ASC
-		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
-		OFFSET COALESCE($2, 0);
+     		b.createdAt` + filter + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $2 = 0 THEN NULL ELSE $2 END
+		OFFSET COALESCE($3, 0);
 `
-		selectValues = []any{
-			limit, offset, authorId,
-		}
-		bids []model2.BidDB
+		selectValues = append([]any{
+			pq.Array(authorIds), page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*)` + filter
+		bids       []model2.BidDB
+		total      int
 	)
 
-	err := s.db.Select(&bids, selectQuery, selectValues...)
+	err := s.db.SelectContext(ctx, &bids, selectQuery, selectValues...)
 	if err != nil {
 		log.Error("failed to select bids for user", sl.Err(err))
-		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, pq.Array(authorIds))
+	if err != nil {
+		log.Error("failed to count bids for user", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.withOffers(ctx, bids)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return bids, nil
+	return bids, total, nil
 }
 
-func (s *Storage) BidsForTender(tenderId string, limit int32, offset int32) ([]model2.BidDB, error) {
+// BidsForTender hides not published bids from everyone except their authors,
+// empty viewer gets all bids of tender
+func (s *Storage) BidsForTender(ctx context.Context, tenderId string, viewer string, page model2.PageQuery) ([]model2.BidDB, int, error) {
 	const op = "Repo.BidsForTender"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
+	after, order, afterValues := keyset(page, bidSortColumns, "b.id", 5)
 	var (
+		filter = `
+		FROM bid b
+		WHERE b.tenderId = $1
+		  AND ($2 = '' OR b.status <> 'Created' OR b.authorId IN (
+		      SELECT id FROM employee WHERE username = $2))
+`
 		selectQuery = `
-		SELECT id, name, description, CAST(status AS text),
-		       tenderId, CAST(authorType AS text), authorId, version, createdAt
-		FROM bid
-		WHERE tenderId = $3
-		ORDER BY name ASC
-		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
-		OFFSET COALESCE($2, 0)
+		SELECT b.id, b.name, b.description, CAST(b.status AS text),
+		       b.tenderId, CAST(b.authorType AS text), b.authorId, b.version, b.createdAt` + filter + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $3 = 0 THEN NULL ELSE $3 END
+		OFFSET COALESCE($4, 0)
 		;
 `
-		selectValues = []any{
-			limit, offset, tenderId,
-		}
-		bids []model2.BidDB
+		selectValues = append([]any{
+			tenderId, viewer, page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*)` + filter
+		bids       []model2.BidDB
+		total      int
 	)
 
-	err := s.db.Select(&bids, selectQuery, selectValues...)
+	err := s.db.SelectContext(ctx, &bids, selectQuery, selectValues...)
 	if err != nil {
-		log.Error("failed to select tenders for user", err)
-		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to select bids for tender", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, tenderId, viewer)
+	if err != nil {
+		log.Error("failed to count bids for tender", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.withOffers(ctx, bids)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return bids, nil
+	return bids, total, nil
 }
 
-func (s *Storage) BidStatus(bidId string) (string, error) {
+func (s *Storage) BidStatus(ctx context.Context, bidId string) (string, error) {
 	const op = "Repo.BidStatus"
 	log := s.log.With(
 		slog.String("op", op),
@@ -145,137 +199,142 @@ func (s *Storage) BidStatus(bidId string) (string, error) {
 		status string
 	)
 
-	err := s.db.Get(&status, selectQuery, selectValues...)
+	err := s.db.GetContext(ctx, &status, selectQuery, selectValues...)
 	if err != nil {
-		log.Error("failed to get status for bid", err)
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to get status for bid", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
 	return status, nil
 }
 
-func (s *Storage) UpdateBidStatus(bidId string, status string) (string, error) {
+func (s *Storage) UpdateBidStatus(ctx context.Context, bidId string, status string, expectedVersion int32) (string, error) {
 	const op = "Repo.UpdateBidStatus"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO bid_version (bid_id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt)
-		SELECT id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt
-		FROM bid
-		WHERE id = $1::uuid;
-    `
 		updateQuery = `
 		UPDATE bid
 		SET status = $2::bid_status, 
 			version = version + 1
 		WHERE id = $1::uuid
+		  AND ($3::int = 0 OR version = $3)
 		RETURNING id;
 `
-		versionValues = []any{
-			bidId,
-		}
 		updateValues = []any{
-			bidId, status,
+			bidId, status, expectedVersion,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "bid", insertBidVersion, bidId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save bid to history", sl.Err(err))
+		return "", err
 	}
 
-	row := tx.QueryRow(updateQuery, updateValues...)
+	row := tx.QueryRowContext(ctx, updateQuery, updateValues...)
 	err = row.Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) && expectedVersion != 0 {
+		log.Warn("bid version mismatch", slog.Int("expectedVersion", int(expectedVersion)))
+		return "", errs.VersionMismatch(fmt.Errorf("bid version mismatch"))
+	}
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	err = addBidEvent(ctx, tx, model2.EventBidStatusChanged, id, nil)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) EditBid(bidId string, name string, description string) (string, error) {
+func (s *Storage) EditBid(ctx context.Context, bidId string, name string, description string, offer *model2.Offer, expectedVersion int32) (string, error) {
 	const op = "Repo.EditBid"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO bid_version (bid_id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt)
-		SELECT id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt
-		FROM bid
-		WHERE id = $1::uuid;
-    `
 		updateQuery = `
 		UPDATE bid
 		SET name = COALESCE(NULLIF($2, ''), name),
-		    description = COALESCE(NULLIF($3, ''), name),
+		    description = COALESCE(NULLIF($3, ''), description),
 			version = version + 1
 		WHERE id = $1::uuid
+		  AND ($4::int = 0 OR version = $4)
 		RETURNING id;
 `
-		versionValues = []any{
-			bidId,
-		}
 		updateValues = []any{
-			bidId, name, description,
+			bidId, name, description, expectedVersion,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "bid", insertBidVersion, bidId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save bid to history", sl.Err(err))
+		return "", err
 	}
 
-	row := tx.QueryRow(updateQuery, updateValues...)
+	row := tx.QueryRowContext(ctx, updateQuery, updateValues...)
 	err = row.Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) && expectedVersion != 0 {
+		log.Warn("bid version mismatch", slog.Int("expectedVersion", int(expectedVersion)))
+		return "", errs.VersionMismatch(fmt.Errorf("bid version mismatch"))
+	}
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	// offer is replaced as a whole, it stays untouched if not sent
+	if offer != nil {
+		err = saveOffer(ctx, tx, bidId, offer)
+		if err != nil {
+			log.Error("failed to save offer", sl.Err(err))
+			return "", errs.Internal(err)
+		}
 	}
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) SubmitDecision(bidId string, responsibleId string) error {
+func (s *Storage) SubmitDecision(ctx context.Context, bidId string, responsibleId string, decision string, comment string) error {
 	const op = "Support.SubmitDecision"
 	log := s.log.With(
 		slog.String("op", op),
@@ -283,84 +342,98 @@ func (s *Storage) SubmitDecision(bidId string, responsibleId string) error {
 
 	var (
 		insertQuery = `
-		INSERT INTO bid_approval (bid_id, responsible)
-		VALUES ($1, $2);
+		INSERT INTO bid_approval (bid_id, responsible, decision, comment, created_at)
+		VALUES ($1, $2, $3::bid_decision, $4, CURRENT_TIMESTAMP);
 `
 		Values = []any{
-			bidId, responsibleId,
+			bidId, responsibleId, decision, comment,
 		}
 	)
-	_, err := s.db.Exec(insertQuery, Values...)
+	_, err := s.db.ExecContext(ctx, insertQuery, Values...)
 
 	if err != nil {
 		log.Info("failed to submit decision..", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to submit decision.."))
+		return errs.Internal(fmt.Errorf("failed to submit decision.."))
 	}
 	return nil
 }
 
-func (s *Storage) ApplyDecision(bidId string, decision string) error {
-	const op = "Support.ApplyDecision"
+func (s *Storage) Decisions(ctx context.Context, bidId string) ([]model2.DecisionDB, error) {
+	const op = "Repo.Decisions"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		applyQuery = `
-		UPDATE bid
-		SET decision = $2::bid_decision
-		WHERE id = $1;
+		selectQuery = `
+		SELECT ba.bid_id, ba.responsible, COALESCE(e.username, '') AS username,
+		       CAST(ba.decision AS text) AS decision, ba.comment, ba.created_at
+		FROM bid_approval ba
+		LEFT JOIN employee e ON e.id = ba.responsible
+		WHERE ba.bid_id = $1::uuid
+		ORDER BY ba.created_at, ba.responsible;
 `
-		Values = []any{
-			bidId, decision,
-		}
+		decisions []model2.DecisionDB
 	)
-	_, err := s.db.Exec(applyQuery, Values...)
 
+	err := s.db.SelectContext(ctx, &decisions, selectQuery, bidId)
 	if err != nil {
-		log.Info("failed to apply decision..", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to apply decision.."))
+		log.Error("failed to select decisions", sl.Err(err))
+		return nil, errs.Internal(err)
 	}
-	return nil
+
+	return decisions, nil
 }
 
-func (s *Storage) Feedback(bidId string, feedback string) error {
-	const op = "Support.Feedback"
+func (s *Storage) ApplyDecision(ctx context.Context, bidId string, decision string) error {
+	const op = "Support.ApplyDecision"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertQuery = `
-		INSERT INTO feedback (description, bidId)
-		VALUES ($1, $2::uuid);
+		applyQuery = `
+		UPDATE bid
+		SET decision = $2::bid_decision
+		WHERE id = $1;
 `
-		insertValues = []any{
-			feedback, bidId,
+		Values = []any{
+			bidId, decision,
 		}
 	)
-	_, err := s.db.Exec(insertQuery, insertValues...)
+	tx, err := s.begin(ctx)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return errs.Internal(err)
+	}
+	defer tx.Rollback()
 
+	_, err = tx.ExecContext(ctx, applyQuery, Values...)
 	if err != nil {
-		log.Error("failed to leave feedback..", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to leave feedback"))
+		log.Info("failed to apply decision..", sl.Err(err))
+		return errs.Internal(fmt.Errorf("failed to apply decision.."))
+	}
+	err = addBidEvent(ctx, tx, model2.EventBidDecisionApplied, bidId, nil)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return errs.Internal(err)
 	}
 	return nil
 }
 
-func (s *Storage) RollbackBid(bidId string, version int32) (string, error) {
-	const op = "Repo.EditTender"
+func (s *Storage) RollbackBid(ctx context.Context, bidId string, version int32, expectedVersion int32) (string, error) {
+	const op = "Repo.RollbackBid"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO bid_version (bid_id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt)
-		SELECT id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt
-		FROM bid
-		WHERE id = $1::uuid;
-    `
 		updateQuery = `
 		UPDATE bid
 		SET name = v.name,
@@ -370,78 +443,180 @@ func (s *Storage) RollbackBid(bidId string, version int32) (string, error) {
 			tenderId = v.tenderId,
 			authorType = v.authorType,
 			authorId = v.authorId,
+			attachments = v.attachments,
 			version = bid.version + 1
 		FROM bid_version v
 		WHERE bid.id = v.bid_id
 		  AND bid.id = $1
-		  AND v.version = $2;
+		  AND v.version = $2
+		  AND ($3::int = 0 OR bid.version = $3);
 `
-		versionValues = []any{
-			bidId,
-		}
 		updateValues = []any{
-			bidId, version,
+			bidId, version, expectedVersion,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "bid", insertBidVersion, bidId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save bid to history", sl.Err(err))
+		return "", err
 	}
 
-	_, err = tx.Exec(updateQuery, updateValues...)
+	result, err := tx.ExecContext(ctx, updateQuery, updateValues...)
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Error("failed to get affected rows", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+	// current version is checked under lock, so nothing is updated only without such version in history
+	if affected == 0 {
+		log.Warn("bid version not found", slog.Int("version", int(version)))
+		return "", errs.NotFound(fmt.Errorf("bid version not found"))
+	}
+	err = restoreOffer(ctx, tx, bidId, version)
+	if err != nil {
+		log.Error("failed to restore offer", sl.Err(err))
+		return "", errs.Internal(err)
 	}
+	id = bidId
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) Reviews(authorUsername string, limit int32, offset int32) ([]model2.Feedback, error) {
+// Reviews are sorted only by creation time
+func (s *Storage) Reviews(ctx context.Context, scope model2.ReviewScope, filter model2.ReviewFilter, page model2.PageQuery) ([]model2.Feedback, int, error) {
 	const op = "Repo.Reviews"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
+	page.Sort = model2.SortCreatedAt
+	after, order, afterValues := keyset(page, map[string]string{model2.SortCreatedAt: "fb.createdAt"}, "fb.id", 10)
 	var (
-		selectQuery = `
-		SELECT fb.id, fb.description, fb.createdAt
+		where = `
 		FROM feedback fb
 		JOIN bid b ON fb.bidId = b.id
-		JOIN employee e ON b.authorId = e.id
-		WHERE e.username = $1
-		LIMIT CASE WHEN $2 = 0 THEN NULL ELSE $2 END
-		OFFSET COALESCE($3, 0);
+		JOIN tender t ON b.tenderId = t.id
+		WHERE CAST(b.authorType AS text) = $1
+		  AND b.authorId = $2::uuid
+		  AND fb.side = 'Organization'
+		  AND (NULLIF($3, '')::uuid IS NULL OR b.tenderId = NULLIF($3, '')::uuid)
+		  AND (NULLIF($4, '')::uuid IS NULL OR t.organization_id = NULLIF($4, '')::uuid)
+		  AND (NULLIF($5, '')::uuid IS NULL OR b.id = NULLIF($5, '')::uuid)
+		  AND ($6::timestamptz IS NULL OR fb.createdAt >= $6::timestamptz)
+		  AND ($7::timestamptz IS NULL OR fb.createdAt < $7::timestamptz)
 `
-		selectValues = []any{
-			authorUsername, limit, offset,
+		whereValues = []any{
+			scope.AuthorType, scope.AuthorId, scope.TenderId, scope.OrganizationId,
+			filter.BidId, filter.CreatedFrom, filter.CreatedTo,
 		}
-		reviews []model2.Feedback
+		selectQuery = `
+		SELECT fb.id, fb.description, fb.createdAt,
+		       (SELECT jsonb_object_agg(r.criterion, r.score) FROM feedback_rating r WHERE r.feedback_id = fb.id) AS ratings` + where + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $8 = 0 THEN NULL ELSE $8 END
+		OFFSET COALESCE($9, 0);
+`
+		selectValues = append(append(whereValues, page.Limit, page.Offset), afterValues...)
+		countQuery   = `SELECT count(*)` + where
+		rows         []reviewRow
+		total        int
 	)
 
-	err := s.db.Select(&reviews, selectQuery, selectValues...)
+	err := s.db.SelectContext(ctx, &rows, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to get reviews for author", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, whereValues...)
+	if err != nil {
+		log.Error("failed to count reviews for author", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	reviews := make([]model2.Feedback, len(rows))
+	for i, row := range rows {
+		reviews[i], err = row.toModel()
+		if err != nil {
+			log.Error("failed to decode ratings", sl.Err(err))
+			return nil, 0, errs.Internal(err)
+		}
+	}
+	return reviews, total, nil
+}
+
+// reviewRow carries ratings aggregated to json object, NULL when review rates nothing
+type reviewRow struct {
+	model2.Feedback
+	Ratings []byte `db:"ratings"`
+}
+
+func (r reviewRow) toModel() (model2.Feedback, error) {
+	feedback := r.Feedback
+	if r.Ratings == nil {
+		return feedback, nil
+	}
+	err := json.Unmarshal(r.Ratings, &feedback.Ratings)
+	return feedback, err
+}
+
+func (s *Storage) Reputation(ctx context.Context, authorType string, authorId string) (model2.ReputationDB, error) {
+	const op = "Repo.Reputation"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		countQuery = `
+		WITH b AS (SELECT * FROM bid WHERE CAST(authorType AS text) = $1 AND authorId = $2::uuid)
+		SELECT
+		    (SELECT count(*) FROM b WHERE decision = 'Approved') AS won,
+		    (SELECT count(*) FROM b WHERE decision = 'Rejected') AS lost,
+		    (SELECT count(*) FROM feedback fb JOIN b ON fb.bidId = b.id WHERE fb.side = 'Organization') AS reviews;
+`
+		criteriaQuery = `
+		SELECT r.criterion, count(*) AS count, sum(r.score) AS sum
+		FROM feedback_rating r
+		JOIN feedback fb ON r.feedback_id = fb.id
+		JOIN bid b ON fb.bidId = b.id
+		WHERE CAST(b.authorType AS text) = $1 AND b.authorId = $2::uuid AND fb.side = 'Organization'
+		GROUP BY r.criterion
+		ORDER BY r.criterion;
+`
+		reputation model2.ReputationDB
+	)
+
+	err := s.db.GetContext(ctx, &reputation, countQuery, authorType, authorId)
+	if err != nil {
+		log.Error("failed to count reputation", sl.Err(err))
+		return model2.ReputationDB{}, errs.Internal(err)
+	}
+	err = s.db.SelectContext(ctx, &reputation.Criteria, criteriaQuery, authorType, authorId)
 	if err != nil {
-		log.Error("failed to get reviews for author", err)
-		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to sum ratings", sl.Err(err))
+		return model2.ReputationDB{}, errs.Internal(err)
 	}
 
-	return reviews, nil
+	return reputation, nil
 }
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+const selectCategory = `
+		SELECT code, name, COALESCE(parent_code, '') AS parent_code, deprecated, created_at
+		FROM category`
+
+func (s *Storage) Categories(ctx context.Context) ([]model.CategoryDB, error) {
+	const op = "Repo.Categories"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		query = selectCategory + `
+		ORDER BY code;
+`
+		categories []model.CategoryDB
+	)
+
+	err := s.db.SelectContext(ctx, &categories, query)
+	if err != nil {
+		log.Error("failed to select categories", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return categories, nil
+}
+
+func (s *Storage) CreateCategory(ctx context.Context, code string, name string, parentCode string) (model.CategoryDB, error) {
+	const op = "Repo.CreateCategory"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO category (code, name, parent_code)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING code, name, COALESCE(parent_code, '') AS parent_code, deprecated, created_at;
+`
+		category model.CategoryDB
+	)
+
+	err := s.db.GetContext(ctx, &category, insertQuery, code, name, parentCode)
+	if err != nil {
+		var pgErr pgx.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.CategoryDB{}, errs.Conflict(fmt.Errorf("category %s already exists", code))
+		}
+		log.Error("failed to create category", sl.Err(err))
+		return model.CategoryDB{}, errs.Internal(err)
+	}
+
+	return category, nil
+}
+
+func (s *Storage) EditCategory(ctx context.Context, code string, name string, deprecated *bool) (model.CategoryDB, error) {
+	const op = "Repo.EditCategory"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE category
+		SET name = COALESCE(NULLIF($2, ''), name),
+		    deprecated = COALESCE($3, deprecated)
+		WHERE code = $1
+		RETURNING code, name, COALESCE(parent_code, '') AS parent_code, deprecated, created_at;
+`
+		category model.CategoryDB
+	)
+
+	err := s.db.GetContext(ctx, &category, updateQuery, code, name, deprecated)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.CategoryDB{}, errs.NotFound(fmt.Errorf("category not found"))
+	}
+	if err != nil {
+		log.Error("failed to edit category", sl.Err(err))
+		return model.CategoryDB{}, errs.Internal(err)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// employeeSortColumns, employees are sorted by username as their name
+var employeeSortColumns = map[string]string{
+	model.SortName:      "e.username",
+	model.SortCreatedAt: "e.created_at",
+}
+
+func (s *Storage) Employees(ctx context.Context, page model.PageQuery) ([]model.EmployeeDB, int, error) {
+	const op = "Repo.Employees"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	after, order, afterValues := keyset(page, employeeSortColumns, "e.id", 3)
+	var (
+		selectQuery = `
+		SELECT e.id, e.username, COALESCE(e.first_name, '') AS first_name, COALESCE(e.last_name, '') AS last_name,
+		       e.created_at, e.updated_at
+		FROM employee e
+		WHERE ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
+		OFFSET COALESCE($2, 0);
+`
+		selectValues = append([]any{
+			page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*) FROM employee`
+		employees  []model.EmployeeDB
+		total      int
+	)
+
+	err := s.db.SelectContext(ctx, &employees, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select employees", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery)
+	if err != nil {
+		log.Error("failed to count employees", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	return employees, total, nil
+}
+
+func (s *Storage) Employee(ctx context.Context, employeeId string) (model.EmployeeDB, error) {
+	const op = "Repo.Employee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, username, COALESCE(first_name, '') AS first_name, COALESCE(last_name, '') AS last_name,
+		       created_at, updated_at
+		FROM employee
+		WHERE id = $1::uuid;
+`
+		selectValues = []any{
+			employeeId,
+		}
+		employee model.EmployeeDB
+	)
+
+	err := s.db.GetContext(ctx, &employee, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("employee not found", sl.Err(err))
+		return model.EmployeeDB{}, errs.NotFound(fmt.Errorf("employee not found"))
+	}
+
+	return employee, nil
+}
+
+func (s *Storage) CreateEmployee(ctx context.Context, username string, firstName string, lastName string) (string, error) {
+	const op = "Repo.CreateEmployee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO employee (username, first_name, last_name)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+		RETURNING id;
+`
+		insertValues = []any{
+			username, firstName, lastName,
+		}
+		id string
+	)
+
+	err := s.db.GetContext(ctx, &id, insertQuery, insertValues...)
+	if err != nil {
+		var pgErr pgx.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return "", errs.Conflict(fmt.Errorf("username is already taken"))
+		}
+		log.Error("failed to create employee", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) EditEmployee(ctx context.Context, employeeId string, firstName string, lastName string) error {
+	const op = "Repo.EditEmployee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE employee
+		SET first_name = COALESCE(NULLIF($2, ''), first_name),
+		    last_name = COALESCE(NULLIF($3, ''), last_name),
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1::uuid;
+`
+		updateValues = []any{
+			employeeId, firstName, lastName,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to update employee", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("employee not found"))
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteEmployee(ctx context.Context, employeeId string) error {
+	const op = "Repo.DeleteEmployee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		deleteQuery = `
+		DELETE FROM employee
+		WHERE id = $1::uuid;
+`
+		deleteValues = []any{
+			employeeId,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, deleteValues...)
+	if err != nil {
+		// tenders keep their creator, so employee who created any cannot be deleted
+		var pgErr pgx.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return errs.Conflict(fmt.Errorf("employee has created tenders"))
+		}
+		log.Error("failed to delete employee", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("employee not found"))
+	}
+
+	return nil
+}
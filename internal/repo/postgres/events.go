@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// addTenderEvent writes event to outbox, it must be called with the same db
+// as the state change so both are committed together
+func addTenderEvent(ctx context.Context, db dbtx, eventType string, tenderId string) error {
+	const insertQuery = `
+		INSERT INTO outbox_event (type, organization_id, payload)
+		SELECT $1, organization_id,
+		       jsonb_build_object('tenderId', id, 'organizationId', organization_id,
+		                          'status', status, 'version', version)
+		FROM tender
+		WHERE id = $2::uuid;
+`
+	_, err := db.ExecContext(ctx, insertQuery, eventType, tenderId)
+	return err
+}
+
+// addBidEvent writes bid event to outbox of the tender organization,
+// extra fields are merged into payload
+func addBidEvent(ctx context.Context, db dbtx, eventType string, bidId string, extra map[string]any) error {
+	const insertQuery = `
+		INSERT INTO outbox_event (type, organization_id, payload)
+		SELECT $1, t.organization_id,
+		       jsonb_build_object('bidId', b.id, 'tenderId', b.tenderId, 'status', b.status,
+		                          'decision', COALESCE(CAST(b.decision AS text), ''), 'version', b.version)
+		       || $3::jsonb
+		FROM bid b
+		JOIN tender t ON t.id = b.tenderId
+		WHERE b.id = $2::uuid;
+`
+	if extra == nil {
+		extra = map[string]any{}
+	}
+	payload, err := json.Marshal(extra)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, insertQuery, eventType, bidId, string(payload))
+	return err
+}
@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+var (
+	ErrUnknownVersion = errors.New("database schema version is unknown to this build")
+	ErrOutdatedSchema = errors.New("database schema is outdated, run migrate up")
+)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt string
+}
+
+type Migrator struct {
+	log        *slog.Logger
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func NewMigrator(log *slog.Logger, db *sqlx.DB) (*Migrator, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		log:        log,
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// loadMigrations reads embedded files named like 0001_name.up.sql / 0001_name.down.sql
+func loadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		base, direction, found := strings.Cut(strings.TrimSuffix(fileName, ".sql"), ".")
+		if !found || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("bad migration file name: %s", fileName)
+		}
+		number, name, found := strings.Cut(base, "_")
+		if !found {
+			return nil, fmt.Errorf("bad migration file name: %s", fileName)
+		}
+		version, err := strconv.Atoi(number)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("bad migration version: %s", fileName)
+		}
+
+		content, err := migrationsFS.ReadFile(path.Join("migrations", fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d must have both up and down files", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	// versions go one by one, so gaps are most likely a forgotten file
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("migration %d is missing", i+1)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Latest is the schema version this build expects
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the highest applied migration, 0 for empty database
+func (m *Migrator) Version() (int, error) {
+	err := m.ensureTable()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int
+	err = m.db.Get(&version, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations;`)
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// Check refuses to work with schema newer than the build or with pending migrations
+func (m *Migrator) Check() error {
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version > m.Latest() {
+		return fmt.Errorf("%w: database %d, build %d", ErrUnknownVersion, version, m.Latest())
+	}
+	if version < m.Latest() {
+		return fmt.Errorf("%w: database %d, build %d", ErrOutdatedSchema, version, m.Latest())
+	}
+
+	return nil
+}
+
+func (m *Migrator) Up() error {
+	const op = "Migrator.Up"
+	log := m.log.With(
+		slog.String("op", op),
+	)
+
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version > m.Latest() {
+		return fmt.Errorf("%w: database %d, build %d", ErrUnknownVersion, version, m.Latest())
+	}
+
+	for _, migration := range m.migrations[version:] {
+		log.Info("applying migration", slog.Int("version", migration.Version), slog.String("name", migration.Name))
+
+		err = m.apply(migration.Up, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2);`,
+			migration.Version, migration.Name)
+		if err != nil {
+			log.Error("failed to apply migration", slog.Int("version", migration.Version), sl.Err(err))
+			return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Down reverts the given number of last applied migrations
+func (m *Migrator) Down(steps int) error {
+	const op = "Migrator.Down"
+	log := m.log.With(
+		slog.String("op", op),
+	)
+
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version > m.Latest() {
+		return fmt.Errorf("%w: database %d, build %d", ErrUnknownVersion, version, m.Latest())
+	}
+
+	for i := 0; i < steps && version > 0; i++ {
+		migration := m.migrations[version-1]
+		log.Info("reverting migration", slog.Int("version", migration.Version), slog.String("name", migration.Name))
+
+		err = m.apply(migration.Down, `DELETE FROM schema_migrations WHERE version = $1 AND name = $2;`,
+			migration.Version, migration.Name)
+		if err != nil {
+			log.Error("failed to revert migration", slog.Int("version", migration.Version), sl.Err(err))
+			return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		version--
+	}
+
+	return nil
+}
+
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	err := m.ensureTable()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []struct {
+		Version   int    `db:"version"`
+		Name      string `db:"name"`
+		AppliedAt string `db:"applied_at"`
+	}
+	err = m.db.Select(&applied, `SELECT version, name, applied_at FROM schema_migrations ORDER BY version;`)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, MigrationStatus{Version: migration.Version, Name: migration.Name})
+	}
+	for _, a := range applied {
+		if a.Version > len(statuses) {
+			// applied by a newer build
+			statuses = append(statuses, MigrationStatus{Version: a.Version, Name: a.Name, Applied: true, AppliedAt: a.AppliedAt})
+			continue
+		}
+		statuses[a.Version-1].Applied = true
+		statuses[a.Version-1].AppliedAt = a.AppliedAt
+	}
+
+	return statuses, nil
+}
+
+// apply runs migration and bookkeeping in one transaction, so a failed migration leaves no trace
+func (m *Migrator) apply(script string, bookkeeping string, version int, name string) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(script); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(bookkeeping, version, name); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) ensureTable() error {
+	query := `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+	_, err := m.db.Exec(query)
+	return err
+}
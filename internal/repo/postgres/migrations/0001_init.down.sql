@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS bid_approval;
+DROP TABLE IF EXISTS bid_version;
+DROP TABLE IF EXISTS tender_version;
+DROP TABLE IF EXISTS feedback;
+DROP TABLE IF EXISTS bid;
+DROP TABLE IF EXISTS tender;
+DROP TABLE IF EXISTS organization_responsible;
+DROP TABLE IF EXISTS employee;
+DROP TABLE IF EXISTS organization;
+
+DROP TYPE IF EXISTS author_type;
+DROP TYPE IF EXISTS bid_decision;
+DROP TYPE IF EXISTS bid_status;
+DROP TYPE IF EXISTS tender_status;
+DROP TYPE IF EXISTS service_type;
+DROP TYPE IF EXISTS organization_type;
@@ -0,0 +1,134 @@
+-- baseline schema, safe to apply to databases created before migrations existed
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'organization_type') THEN
+        CREATE TYPE organization_type AS ENUM ('IE', 'LLC', 'JSC');
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS organization
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name        VARCHAR(100) NOT NULL,
+    description TEXT,
+    type        organization_type,
+    created_at  TIMESTAMP        DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP        DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS employee (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    username VARCHAR(50) UNIQUE NOT NULL,
+    first_name VARCHAR(50),
+    last_name VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS organization_responsible (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES employee(id) ON DELETE CASCADE
+);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'service_type') THEN
+        CREATE TYPE service_type AS ENUM ('Construction', 'Delivery', 'Manufacture');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'tender_status') THEN
+        CREATE TYPE tender_status AS ENUM ('Created', 'Published', 'Closed');
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS tender (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100),
+    description VARCHAR(500),
+    serviceType service_type,
+    status tender_status,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    creator_username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    version INT DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'bid_status') THEN
+        CREATE TYPE bid_status AS ENUM ('Created', 'Published', 'Canceled');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'bid_decision') THEN
+        CREATE TYPE bid_decision AS ENUM ('','Approved', 'Rejected');
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'author_type') THEN
+        CREATE TYPE author_type AS ENUM ('Organization', 'User');
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS bid (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(250),
+    description VARCHAR(500),
+    decision bid_decision DEFAULT '',
+    status bid_status,
+    tenderId UUID REFERENCES tender(id) ON DELETE CASCADE,
+    authorType author_type,
+    authorId UUID,
+    version INT DEFAULT 1,
+    createdAt TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS feedback (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    description VARCHAR(1000),
+    createdAt TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    bidId UUID REFERENCES bid(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS tender_version (
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    version INT,
+    name VARCHAR(250),
+    description VARCHAR(500),
+    serviceType service_type,
+    status tender_status,
+    organization_id UUID,
+    creator_username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tender_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS bid_version (
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    version INT,
+    name VARCHAR(250),
+    description TEXT,
+    decision bid_decision,
+    status bid_status,
+    tenderId UUID,
+    authorType author_type,
+    authorId UUID,
+    createdAt TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (bid_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS bid_approval (
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    responsible UUID,
+    PRIMARY KEY (bid_id, responsible)
+);
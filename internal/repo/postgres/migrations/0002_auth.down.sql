@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auth_session;
+DROP TABLE IF EXISTS employee_credentials;
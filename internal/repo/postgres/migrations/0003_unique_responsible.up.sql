@@ -0,0 +1,9 @@
+-- duplicates could be inserted by hand before the index existed
+DELETE FROM organization_responsible a
+USING organization_responsible b
+WHERE a.organization_id = b.organization_id
+  AND a.user_id = b.user_id
+  AND a.id > b.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_organization_user
+    ON organization_responsible (organization_id, user_id);
@@ -0,0 +1,9 @@
+ALTER TABLE bid_version
+    DROP COLUMN IF EXISTS items,
+    DROP COLUMN IF EXISTS warranty_months,
+    DROP COLUMN IF EXISTS delivery_deadline,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS amount;
+
+DROP TABLE IF EXISTS bid_offer_item;
+DROP TABLE IF EXISTS bid_offer;
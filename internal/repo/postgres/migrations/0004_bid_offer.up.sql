@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS bid_offer (
+    bid_id UUID PRIMARY KEY REFERENCES bid(id) ON DELETE CASCADE,
+    amount NUMERIC(18, 2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    delivery_deadline DATE,
+    warranty_months INT
+);
+
+CREATE TABLE IF NOT EXISTS bid_offer_item (
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    position INT,
+    name VARCHAR(250) NOT NULL,
+    quantity NUMERIC(18, 3) NOT NULL,
+    unit_price NUMERIC(18, 2) NOT NULL,
+    PRIMARY KEY (bid_id, position)
+);
+
+-- offer snapshot, items are kept as json array in the order of positions
+ALTER TABLE bid_version
+    ADD COLUMN IF NOT EXISTS amount NUMERIC(18, 2),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS delivery_deadline DATE,
+    ADD COLUMN IF NOT EXISTS warranty_months INT,
+    ADD COLUMN IF NOT EXISTS items JSONB;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS tender_published_decision_deadline;
+
+ALTER TABLE tender_version
+    DROP COLUMN IF EXISTS decision_deadline,
+    DROP COLUMN IF EXISTS submission_deadline;
+
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS decision_deadline,
+    DROP COLUMN IF EXISTS submission_deadline;
@@ -0,0 +1,12 @@
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS submission_deadline TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS decision_deadline TIMESTAMPTZ;
+
+ALTER TABLE tender_version
+    ADD COLUMN IF NOT EXISTS submission_deadline TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS decision_deadline TIMESTAMPTZ;
+
+-- scheduler looks only for published tenders with passed decision deadline
+CREATE INDEX IF NOT EXISTS tender_published_decision_deadline
+    ON tender (decision_deadline)
+    WHERE status = 'Published' AND decision_deadline IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS bid_search_vector;
+DROP INDEX IF EXISTS tender_search_vector;
+
+ALTER TABLE bid DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE tender DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,10 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'organization_role') THEN
+        CREATE TYPE organization_role AS ENUM ('owner', 'procurement_manager', 'reviewer', 'bidder', 'viewer');
+    END IF;
+END $$;
+
+-- responsibles could do everything before roles appeared, so they keep it as owners
+ALTER TABLE organization_responsible
+    ADD COLUMN IF NOT EXISTS role organization_role NOT NULL DEFAULT 'owner';
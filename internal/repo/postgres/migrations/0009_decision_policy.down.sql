@@ -0,0 +1,10 @@
+DELETE FROM bid_approval WHERE decision <> 'Approved';
+
+ALTER TABLE bid_approval DROP COLUMN IF EXISTS decision;
+
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS decision_policy,
+    DROP COLUMN IF EXISTS decision_required,
+    DROP COLUMN IF EXISTS decision_approvers;
+
+DROP TYPE IF EXISTS decision_policy;
@@ -0,0 +1,16 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'decision_policy') THEN
+        CREATE TYPE decision_policy AS ENUM ('unanimous', 'majority', 'fixed', 'approvers', 'single');
+    END IF;
+END $$;
+
+-- tenders created before keep the old rule: three approvals or every responsible if there are fewer
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS decision_policy decision_policy NOT NULL DEFAULT 'fixed',
+    ADD COLUMN IF NOT EXISTS decision_required INT NOT NULL DEFAULT 3,
+    ADD COLUMN IF NOT EXISTS decision_approvers UUID[] NOT NULL DEFAULT '{}';
+
+-- only approvals were stored before, rejections were applied at once
+ALTER TABLE bid_approval
+    ADD COLUMN IF NOT EXISTS decision bid_decision NOT NULL DEFAULT 'Approved';
@@ -0,0 +1,3 @@
+ALTER TABLE bid_approval
+    DROP COLUMN IF EXISTS comment,
+    DROP COLUMN IF EXISTS created_at;
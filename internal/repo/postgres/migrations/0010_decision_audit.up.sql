@@ -0,0 +1,4 @@
+-- votes submitted before get time of the migration, their real time is unknown
+ALTER TABLE bid_approval
+    ADD COLUMN IF NOT EXISTS comment VARCHAR(1000) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
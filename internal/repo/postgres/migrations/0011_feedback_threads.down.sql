@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS feedback_read;
+
+DROP INDEX IF EXISTS feedback_thread;
+
+-- replies of bidders were not feedback before threads
+DELETE FROM feedback WHERE side = 'Bidder';
+
+ALTER TABLE feedback
+    DROP COLUMN IF EXISTS author_id,
+    DROP COLUMN IF EXISTS side,
+    DROP COLUMN IF EXISTS mentions;
+
+DROP TYPE IF EXISTS feedback_side;
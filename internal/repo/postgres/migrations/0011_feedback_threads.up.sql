@@ -0,0 +1,22 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'feedback_side') THEN
+        CREATE TYPE feedback_side AS ENUM ('Organization', 'Bidder');
+    END IF;
+END $$;
+
+-- feedback left before threads came from organizations, its authors were not recorded
+ALTER TABLE feedback
+    ADD COLUMN IF NOT EXISTS author_id UUID REFERENCES employee(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS side feedback_side NOT NULL DEFAULT 'Organization',
+    ADD COLUMN IF NOT EXISTS mentions VARCHAR(50)[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS feedback_thread ON feedback (bidId, createdAt, id);
+
+-- messages created after read_at are unread for the employee
+CREATE TABLE IF NOT EXISTS feedback_read (
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    employee_id UUID REFERENCES employee(id) ON DELETE CASCADE,
+    read_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (bid_id, employee_id)
+);
@@ -0,0 +1,7 @@
+-- criteria are configured in service, storage accepts any name
+CREATE TABLE IF NOT EXISTS feedback_rating (
+    feedback_id UUID REFERENCES feedback(id) ON DELETE CASCADE,
+    criterion VARCHAR(50),
+    score SMALLINT NOT NULL CHECK (score BETWEEN 1 AND 5),
+    PRIMARY KEY (feedback_id, criterion)
+);
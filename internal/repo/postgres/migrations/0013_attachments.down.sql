@@ -0,0 +1,13 @@
+ALTER TABLE bid_version
+    DROP COLUMN IF EXISTS attachments;
+
+ALTER TABLE bid
+    DROP COLUMN IF EXISTS attachments;
+
+ALTER TABLE tender_version
+    DROP COLUMN IF EXISTS attachments;
+
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS attachments;
+
+DROP TABLE IF EXISTS attachment;
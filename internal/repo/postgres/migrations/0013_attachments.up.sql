@@ -0,0 +1,30 @@
+-- attachment belongs to exactly one tender or bid, blob content lives outside of database
+CREATE TABLE IF NOT EXISTS attachment (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    blob_key VARCHAR(255) NOT NULL,
+    uploaded_by UUID REFERENCES employee(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((tender_id IS NULL) <> (bid_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS attachment_tender_id ON attachment (tender_id) WHERE tender_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS attachment_bid_id ON attachment (bid_id) WHERE bid_id IS NOT NULL;
+
+-- current set of attachments is versioned together with owner
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS attachments UUID[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE tender_version
+    ADD COLUMN IF NOT EXISTS attachments UUID[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE bid
+    ADD COLUMN IF NOT EXISTS attachments UUID[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE bid_version
+    ADD COLUMN IF NOT EXISTS attachments UUID[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,18 @@
+-- fails while tenders use categories added after the catalog appeared
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'service_type') THEN
+        CREATE TYPE service_type AS ENUM ('Construction', 'Delivery', 'Manufacture');
+    END IF;
+END $$;
+
+ALTER TABLE tender_version
+    ALTER COLUMN serviceType TYPE service_type USING CAST(serviceType AS service_type);
+
+ALTER TABLE tender
+    DROP CONSTRAINT IF EXISTS tender_service_type_fkey;
+
+ALTER TABLE tender
+    ALTER COLUMN serviceType TYPE service_type USING CAST(serviceType AS service_type);
+
+DROP TABLE IF EXISTS category;
@@ -0,0 +1,28 @@
+-- service types become a catalog, code is what tenders refer to and never changes, name may be renamed
+CREATE TABLE IF NOT EXISTS category (
+    code VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    parent_code VARCHAR(50) REFERENCES category(code),
+    deprecated BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS category_parent_code ON category (parent_code);
+
+INSERT INTO category (code, name)
+VALUES ('Construction', 'Construction'),
+       ('Delivery', 'Delivery'),
+       ('Manufacture', 'Manufacture')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE tender
+    ALTER COLUMN serviceType TYPE VARCHAR(50) USING CAST(serviceType AS text);
+
+ALTER TABLE tender
+    ADD CONSTRAINT tender_service_type_fkey FOREIGN KEY (serviceType) REFERENCES category(code);
+
+-- history keeps codes as they were, no foreign key
+ALTER TABLE tender_version
+    ALTER COLUMN serviceType TYPE VARCHAR(50) USING CAST(serviceType AS text);
+
+DROP TYPE IF EXISTS service_type;
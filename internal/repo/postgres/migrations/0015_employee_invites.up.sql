@@ -0,0 +1,11 @@
+-- first password is set only with invite issued by organization manager or at bootstrap,
+-- token itself is never stored
+CREATE TABLE IF NOT EXISTS employee_invite (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    employee_id UUID NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    created_by UUID REFERENCES employee(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS employee_invite_employee_idx ON employee_invite (employee_id);
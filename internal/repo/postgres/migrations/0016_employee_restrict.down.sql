@@ -0,0 +1,9 @@
+ALTER TABLE tender_version
+    DROP CONSTRAINT IF EXISTS tender_version_creator_username_fkey,
+    ADD CONSTRAINT tender_version_creator_username_fkey
+        FOREIGN KEY (creator_username) REFERENCES employee(username) ON DELETE CASCADE;
+
+ALTER TABLE tender
+    DROP CONSTRAINT IF EXISTS tender_creator_username_fkey,
+    ADD CONSTRAINT tender_creator_username_fkey
+        FOREIGN KEY (creator_username) REFERENCES employee(username) ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+-- deleting employee must not take tenders of the organization and bids of other suppliers with it
+ALTER TABLE tender
+    DROP CONSTRAINT IF EXISTS tender_creator_username_fkey,
+    ADD CONSTRAINT tender_creator_username_fkey
+        FOREIGN KEY (creator_username) REFERENCES employee(username) ON DELETE RESTRICT;
+
+ALTER TABLE tender_version
+    DROP CONSTRAINT IF EXISTS tender_version_creator_username_fkey,
+    ADD CONSTRAINT tender_version_creator_username_fkey
+        FOREIGN KEY (creator_username) REFERENCES employee(username) ON DELETE RESTRICT;
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"log/slog"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// insertBidVersion saves current bid with its offer to history, items go to json array
+const insertBidVersion = `
+        INSERT INTO bid_version (bid_id, version, name, description, decision, status, tenderId, authorType, authorId, createdAt,
+                                 amount, currency, delivery_deadline, warranty_months, items, attachments)
+		SELECT b.id, b.version, b.name, b.description, b.decision, b.status, b.tenderId, b.authorType, b.authorId, b.createdAt,
+		       o.amount, o.currency, o.delivery_deadline, o.warranty_months,
+		       (SELECT jsonb_agg(jsonb_build_object('name', i.name, 'quantity', i.quantity, 'unitPrice', i.unit_price)
+		                         ORDER BY i.position)
+		        FROM bid_offer_item i
+		        WHERE i.bid_id = b.id),
+		       b.attachments
+		FROM bid b
+		LEFT JOIN bid_offer o ON o.bid_id = b.id
+		WHERE b.id = $1::uuid;
+    `
+
+type offerRow struct {
+	BidId            string        `db:"bid_id"`
+	Amount           model.Decimal `db:"amount"`
+	Currency         string        `db:"currency"`
+	DeliveryDeadline sql.NullTime  `db:"delivery_deadline"`
+	WarrantyMonths   int32         `db:"warranty_months"`
+}
+
+func (r offerRow) toModel() *model.Offer {
+	return &model.Offer{
+		Amount:           r.Amount,
+		Currency:         r.Currency,
+		DeliveryDeadline: formatDate(r.DeliveryDeadline),
+		WarrantyMonths:   r.WarrantyMonths,
+	}
+}
+
+// formatDate formats DATE column, driver scans it as midnight UTC
+func formatDate(date sql.NullTime) string {
+	if !date.Valid {
+		return ""
+	}
+	return date.Time.Format(time.DateOnly)
+}
+
+type offerItemRow struct {
+	BidId string `db:"bid_id"`
+	model.OfferItem
+}
+
+// saveOffer replaces offer of the bid, nil offer just removes the old one
+func saveOffer(ctx context.Context, db dbtx, bidId string, offer *model.Offer) error {
+	var (
+		deleteItems = `
+		DELETE FROM bid_offer_item
+		WHERE bid_id = $1::uuid;
+`
+		deleteOffer = `
+		DELETE FROM bid_offer
+		WHERE bid_id = $1::uuid;
+`
+		insertOffer = `
+		INSERT INTO bid_offer (bid_id, amount, currency, delivery_deadline, warranty_months)
+		VALUES ($1::uuid, $2, $3, NULLIF($4, '')::date, NULLIF($5, 0));
+`
+		insertItem = `
+		INSERT INTO bid_offer_item (bid_id, position, name, quantity, unit_price)
+		VALUES ($1::uuid, $2, $3, $4, $5);
+`
+	)
+
+	_, err := db.ExecContext(ctx, deleteItems, bidId)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, deleteOffer, bidId)
+	if err != nil {
+		return err
+	}
+	if offer == nil {
+		return nil
+	}
+
+	_, err = db.ExecContext(ctx, insertOffer, bidId, offer.Amount, offer.Currency, offer.DeliveryDeadline, offer.WarrantyMonths)
+	if err != nil {
+		return err
+	}
+	for i, item := range offer.Items {
+		_, err = db.ExecContext(ctx, insertItem, bidId, i+1, item.Name, item.Quantity, item.UnitPrice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreOffer puts offer from bid_version back to offer tables
+func restoreOffer(ctx context.Context, db dbtx, bidId string, version int32) error {
+	var (
+		insertOffer = `
+		INSERT INTO bid_offer (bid_id, amount, currency, delivery_deadline, warranty_months)
+		SELECT bid_id, amount, currency, delivery_deadline, warranty_months
+		FROM bid_version
+		WHERE bid_id = $1::uuid
+		  AND version = $2
+		  AND currency IS NOT NULL;
+`
+		insertItems = `
+		INSERT INTO bid_offer_item (bid_id, position, name, quantity, unit_price)
+		SELECT v.bid_id, i.position, i.item->>'name', (i.item->>'quantity')::numeric, (i.item->>'unitPrice')::numeric
+		FROM bid_version v
+		CROSS JOIN LATERAL jsonb_array_elements(COALESCE(v.items, '[]'::jsonb)) WITH ORDINALITY AS i(item, position)
+		WHERE v.bid_id = $1::uuid
+		  AND v.version = $2;
+`
+	)
+
+	err := saveOffer(ctx, db, bidId, nil)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, insertOffer, bidId, version)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, insertItems, bidId, version)
+	return err
+}
+
+// withOffers loads offers for all bids with two queries
+func (s *Storage) withOffers(ctx context.Context, bids []model.BidDB) error {
+	const op = "Repo.withOffers"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if len(bids) == 0 {
+		return nil
+	}
+
+	var (
+		selectOffers = `
+		SELECT bid_id, amount, currency, delivery_deadline,
+		       COALESCE(warranty_months, 0) AS warranty_months
+		FROM bid_offer
+		WHERE bid_id = ANY($1::uuid[]);
+`
+		selectItems = `
+		SELECT bid_id, name, quantity, unit_price
+		FROM bid_offer_item
+		WHERE bid_id = ANY($1::uuid[])
+		ORDER BY bid_id, position;
+`
+		ids    = make([]string, 0, len(bids))
+		offers []offerRow
+		items  []offerItemRow
+	)
+	for _, bid := range bids {
+		ids = append(ids, bid.Id)
+	}
+
+	err := s.db.SelectContext(ctx, &offers, selectOffers, pq.Array(ids))
+	if err != nil {
+		log.Error("failed to select offers", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if len(offers) == 0 {
+		return nil
+	}
+	err = s.db.SelectContext(ctx, &items, selectItems, pq.Array(ids))
+	if err != nil {
+		log.Error("failed to select offer items", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	byBid := make(map[string]*model.Offer, len(offers))
+	for _, offer := range offers {
+		byBid[offer.BidId] = offer.toModel()
+	}
+	for _, item := range items {
+		if offer, ok := byBid[item.BidId]; ok {
+			offer.Items = append(offer.Items, item.OfferItem)
+		}
+	}
+	for i := range bids {
+		bids[i].Offer = byBid[bids[i].Id]
+	}
+
+	return nil
+}
+
+func (s *Storage) withOffer(ctx context.Context, bid *model.BidDB) error {
+	bids := []model.BidDB{*bid}
+	err := s.withOffers(ctx, bids)
+	if err != nil {
+		return err
+	}
+	bid.Offer = bids[0].Offer
+	return nil
+}
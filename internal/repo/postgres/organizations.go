@@ -0,0 +1,317 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// organizationSortColumns, organizations have no version
+var organizationSortColumns = map[string]string{
+	model.SortName:      "o.name",
+	model.SortCreatedAt: "o.created_at",
+}
+
+func (s *Storage) Organizations(ctx context.Context, page model.PageQuery) ([]model.OrganizationDB, int, error) {
+	const op = "Repo.Organizations"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	after, order, afterValues := keyset(page, organizationSortColumns, "o.id", 3)
+	var (
+		selectQuery = `
+		SELECT o.id, o.name, COALESCE(o.description, '') AS description, COALESCE(CAST(o.type AS text), '') AS type,
+		       o.created_at, o.updated_at
+		FROM organization o
+		WHERE ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
+		OFFSET COALESCE($2, 0);
+`
+		selectValues = append([]any{
+			page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery    = `SELECT count(*) FROM organization`
+		organizations []model.OrganizationDB
+		total         int
+	)
+
+	err := s.db.SelectContext(ctx, &organizations, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select organizations", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery)
+	if err != nil {
+		log.Error("failed to count organizations", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	return organizations, total, nil
+}
+
+func (s *Storage) Organization(ctx context.Context, organizationId string) (model.OrganizationDB, error) {
+	const op = "Repo.Organization"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, name, COALESCE(description, '') AS description, COALESCE(CAST(type AS text), '') AS type,
+		       created_at, updated_at
+		FROM organization
+		WHERE id = $1::uuid;
+`
+		selectValues = []any{
+			organizationId,
+		}
+		organization model.OrganizationDB
+	)
+
+	err := s.db.GetContext(ctx, &organization, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("organization not found", sl.Err(err))
+		return model.OrganizationDB{}, errs.NotFound(fmt.Errorf("organization not found"))
+	}
+
+	return organization, nil
+}
+
+func (s *Storage) CreateOrganization(ctx context.Context, name string, description string, organizationType string, responsibleId string) (string, error) {
+	const op = "Repo.CreateOrganization"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO organization (name, description, type)
+		VALUES ($1, $2, $3::organization_type)
+		RETURNING id;
+`
+		responsibleQuery = `
+		INSERT INTO organization_responsible (organization_id, user_id, role)
+		VALUES ($1::uuid, $2::uuid, 'owner');
+`
+		insertValues = []any{
+			name, description, organizationType,
+		}
+		id string
+	)
+
+	log.Debug("beginning transaction")
+	tx, err := s.begin(ctx)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRowContext(ctx, insertQuery, insertValues...)
+	err = row.Scan(&id)
+	if err != nil {
+		log.Error("failed to scan id", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	// creator becomes the first owner, otherwise nobody could manage organization
+	_, err = tx.ExecContext(ctx, responsibleQuery, id, responsibleId)
+	if err != nil {
+		log.Error("failed to add responsible", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	log.Debug("trying to commit transaction")
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) EditOrganization(ctx context.Context, organizationId string, name string, description string, organizationType string) error {
+	const op = "Repo.EditOrganization"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE organization
+		SET name = COALESCE(NULLIF($2, ''), name),
+		    description = COALESCE(NULLIF($3, ''), description),
+		    type = COALESCE(NULLIF($4, '')::organization_type, type),
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1::uuid;
+`
+		updateValues = []any{
+			organizationId, name, description, organizationType,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to update organization", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("organization not found"))
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteOrganization(ctx context.Context, organizationId string) error {
+	const op = "Repo.DeleteOrganization"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		deleteQuery = `
+		DELETE FROM organization
+		WHERE id = $1::uuid;
+`
+		deleteValues = []any{
+			organizationId,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, deleteValues...)
+	if err != nil {
+		log.Error("failed to delete organization", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("organization not found"))
+	}
+
+	return nil
+}
+
+func (s *Storage) Responsibles(ctx context.Context, organizationId string) ([]model.MemberDB, error) {
+	const op = "Repo.Responsibles"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT e.id, e.username, COALESCE(e.first_name, '') AS first_name, COALESCE(e.last_name, '') AS last_name,
+		       e.created_at, e.updated_at, CAST(resp.role AS text) AS role
+		FROM organization_responsible resp
+		JOIN employee e ON e.id = resp.user_id
+		WHERE resp.organization_id = $1::uuid
+		ORDER BY e.username ASC;
+`
+		selectValues = []any{
+			organizationId,
+		}
+		responsibles []model.MemberDB
+	)
+
+	err := s.db.SelectContext(ctx, &responsibles, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select responsibles", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return responsibles, nil
+}
+
+func (s *Storage) AddResponsible(ctx context.Context, organizationId string, userId string, role string) error {
+	const op = "Repo.AddResponsible"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO organization_responsible (organization_id, user_id, role)
+		SELECT $1::uuid, $2::uuid, CAST($3 AS organization_role)
+		WHERE NOT EXISTS (
+		    SELECT 1
+		    FROM organization_responsible
+		    WHERE organization_id = $1::uuid AND user_id = $2::uuid
+		);
+`
+		insertValues = []any{
+			organizationId, userId, role,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to add responsible", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.Conflict(fmt.Errorf("user is already responsible for organization"))
+	}
+
+	return nil
+}
+
+func (s *Storage) SetRole(ctx context.Context, organizationId string, userId string, role string) error {
+	const op = "Repo.SetRole"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE organization_responsible
+		SET role = CAST($3 AS organization_role)
+		WHERE organization_id = $1::uuid AND user_id = $2::uuid;
+`
+		updateValues = []any{
+			organizationId, userId, role,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to set role", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("user is not responsible for organization"))
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveResponsible(ctx context.Context, organizationId string, userId string) error {
+	const op = "Repo.RemoveResponsible"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		deleteQuery = `
+		DELETE FROM organization_responsible
+		WHERE organization_id = $1::uuid AND user_id = $2::uuid;
+`
+		deleteValues = []any{
+			organizationId, userId,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, deleteValues...)
+	if err != nil {
+		log.Error("failed to remove responsible", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("user is not responsible for organization"))
+	}
+
+	return nil
+}
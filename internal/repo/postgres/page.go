@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"fmt"
+	"zadanie-6105/internal/domain/model"
+)
+
+// sortTypes are casts of cursor values, created_at columns are timestamp without time zone
+var sortTypes = map[string]string{
+	model.SortName:      "text",
+	model.SortCreatedAt: "timestamp",
+	model.SortVersion:   "int",
+	model.SortRank:      "real",
+}
+
+// keyset returns condition "after cursor" and ORDER BY for page. Columns map sort fields
+// to sql expressions, placeholders of the condition are numbered from n and
+// their values are returned only when there is a cursor. Internal callers pass empty page,
+// it is sorted by name
+func keyset(page model.PageQuery, columns map[string]string, id string, n int) (string, string, []any) {
+	if page.Sort == "" {
+		page.Sort = model.SortName
+	}
+	column := columns[page.Sort]
+	direction, compare := "ASC", ">"
+	if page.Desc {
+		direction, compare = "DESC", "<"
+	}
+	order := fmt.Sprintf("%s %s, %s %s", column, direction, id, direction)
+
+	if page.After == nil {
+		return "TRUE", order, nil
+	}
+	after := fmt.Sprintf("(%s, %s) %s ($%d::%s, $%d::uuid)", column, id, compare, n, sortTypes[page.Sort], n+1)
+	return after, order, []any{page.After.Value, page.After.Id}
+}
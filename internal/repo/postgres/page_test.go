@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestKeyset(t *testing.T) {
+	columns := map[string]string{
+		model.SortName:      "t.name",
+		model.SortCreatedAt: "t.createdAt",
+	}
+
+	tests := []struct {
+		name      string
+		page      model.PageQuery
+		wantAfter string
+		wantOrder string
+		wantArgs  []any
+	}{
+		{
+			name:      "empty page is sorted by name",
+			page:      model.PageQuery{},
+			wantAfter: "TRUE",
+			wantOrder: "t.name ASC, t.id ASC",
+		},
+		{
+			name:      "descending",
+			page:      model.PageQuery{Sort: model.SortCreatedAt, Desc: true},
+			wantAfter: "TRUE",
+			wantOrder: "t.createdAt DESC, t.id DESC",
+		},
+		{
+			name: "after cursor",
+			page: model.PageQuery{
+				Sort:  model.SortCreatedAt,
+				After: &model.Cursor{Sort: model.SortCreatedAt, Value: "2024-01-01T00:00:00Z", Id: "id"},
+			},
+			wantAfter: "(t.createdAt, t.id) > ($4::timestamp, $5::uuid)",
+			wantOrder: "t.createdAt ASC, t.id ASC",
+			wantArgs:  []any{"2024-01-01T00:00:00Z", "id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after, order, args := keyset(tt.page, columns, "t.id", 4)
+			assert.Equal(t, tt.wantAfter, after)
+			assert.Equal(t, tt.wantOrder, order)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// headline options are shared by tenders and bids, name is highlighted as a whole.
+// Selection markers are not html, converter escapes the text and turns them into tags
+const (
+	nameHeadline        = `HighlightAll=true, StartSel=` + model.HighlightStart + `, StopSel=` + model.HighlightStop
+	descriptionHeadline = `StartSel=` + model.HighlightStart + `, StopSel=` + model.HighlightStop + `, MaxFragments=2, MaxWords=20, MinWords=5`
+)
+
+// search results are ordered by relevance, rank is computed again in keyset condition
+var (
+	tenderRankColumns = map[string]string{model.SortRank: "ts_rank_cd(t.search_vector, q.query)"}
+	bidRankColumns    = map[string]string{model.SortRank: "ts_rank_cd(b.search_vector, q.query)"}
+)
+
+const searchQuery = `
+		WITH q AS (
+			SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+		)`
+
+// SearchTenders shows published tenders to everyone and the rest only if their organization is in organizationIds
+func (s *Storage) SearchTenders(ctx context.Context, filter model.SearchFilter, organizationIds []string, page model.PageQuery) ([]model.TenderSearchDB, int, error) {
+	const op = "Repo.SearchTenders"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	page.Sort = model.SortRank
+	after, order, afterValues := keyset(page, tenderRankColumns, "t.id", 12)
+	var (
+		where = `
+		FROM tender t, q
+		WHERE t.search_vector @@ q.query
+		  AND (COALESCE(cardinality($2::text[]), 0) = 0 OR CAST(t.status AS text) = ANY($2))
+		  AND (NULLIF($3, '')::uuid IS NULL OR t.organization_id = NULLIF($3, '')::uuid)
+		  AND (COALESCE(cardinality($4::text[]), 0) = 0 OR CAST(t.serviceType AS text) = ANY($4))
+		  AND ($5::timestamptz IS NULL OR t.created_at >= $5::timestamptz)
+		  AND ($6::timestamptz IS NULL OR t.created_at < $6::timestamptz)
+		  AND (t.status = 'Published' OR t.organization_id = ANY($7::uuid[]))
+`
+		selectQuery = searchQuery + `
+		SELECT t.id, t.name, t.description, CAST(t.serviceType AS text) AS servicetype,
+		       CAST(t.status AS text) AS status, t.organization_id, t.creator_username, t.version, t.created_at,
+		       t.submission_deadline, t.decision_deadline,
+		       ts_rank_cd(t.search_vector, q.query) AS rank,
+		       ts_headline('russian', COALESCE(t.name, ''), q.query, $10) AS name_highlight,
+		       ts_headline('russian', COALESCE(t.description, ''), q.query, $11) AS description_highlight` + where + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $8 = 0 THEN NULL ELSE $8 END
+		OFFSET COALESCE($9, 0);
+`
+		filterValues = []any{
+			filter.Query, pq.Array(filter.Statuses), filter.OrganizationId, pq.Array(filter.ServiceTypes),
+			filter.CreatedFrom, filter.CreatedTo, pq.Array(organizationIds),
+		}
+		selectValues = append(append(filterValues,
+			page.Limit, page.Offset, nameHeadline, descriptionHeadline,
+		), afterValues...)
+		countQuery = searchQuery + `
+		SELECT count(*)` + where
+		tenders []model.TenderSearchDB
+		total   int
+	)
+
+	err := s.db.SelectContext(ctx, &tenders, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to search tenders", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, filterValues...)
+	if err != nil {
+		log.Error("failed to count found tenders", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	return tenders, total, nil
+}
+
+// SearchBids shows bids of authorIds and all but not published bids on tenders of organizationIds;
+// organization and service type filter by tender
+func (s *Storage) SearchBids(ctx context.Context, filter model.SearchFilter, authorIds []string, organizationIds []string, page model.PageQuery) ([]model.BidSearchDB, int, error) {
+	const op = "Repo.SearchBids"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	page.Sort = model.SortRank
+	after, order, afterValues := keyset(page, bidRankColumns, "b.id", 13)
+	var (
+		where = `
+		FROM bid b
+		JOIN tender t ON t.id = b.tenderId, q
+		WHERE b.search_vector @@ q.query
+		  AND (COALESCE(cardinality($2::text[]), 0) = 0 OR CAST(b.status AS text) = ANY($2))
+		  AND (NULLIF($3, '')::uuid IS NULL OR t.organization_id = NULLIF($3, '')::uuid)
+		  AND (COALESCE(cardinality($4::text[]), 0) = 0 OR CAST(t.serviceType AS text) = ANY($4))
+		  AND ($5::timestamptz IS NULL OR b.createdAt >= $5::timestamptz)
+		  AND ($6::timestamptz IS NULL OR b.createdAt < $6::timestamptz)
+		  AND (b.authorId = ANY($7::uuid[])
+		       OR (b.status <> 'Created' AND t.organization_id = ANY($8::uuid[])))
+`
+		selectQuery = searchQuery + `
+		SELECT b.id, b.name, COALESCE(b.description, '') AS description,
+		       COALESCE(CAST(b.decision AS text), '') AS decision, CAST(b.status AS text) AS status,
+		       b.tenderId, CAST(b.authorType AS text) AS authortype, b.authorId, b.version, b.createdAt,
+		       ts_rank_cd(b.search_vector, q.query) AS rank,
+		       ts_headline('russian', COALESCE(b.name, ''), q.query, $11) AS name_highlight,
+		       ts_headline('russian', COALESCE(b.description, ''), q.query, $12) AS description_highlight` + where + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $9 = 0 THEN NULL ELSE $9 END
+		OFFSET COALESCE($10, 0);
+`
+		filterValues = []any{
+			filter.Query, pq.Array(filter.Statuses), filter.OrganizationId, pq.Array(filter.ServiceTypes),
+			filter.CreatedFrom, filter.CreatedTo, pq.Array(authorIds), pq.Array(organizationIds),
+		}
+		selectValues = append(append(filterValues,
+			page.Limit, page.Offset, nameHeadline, descriptionHeadline,
+		), afterValues...)
+		countQuery = searchQuery + `
+		SELECT count(*)` + where
+		results []model.BidSearchDB
+		total   int
+	)
+
+	err := s.db.SelectContext(ctx, &results, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to search bids", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, filterValues...)
+	if err != nil {
+		log.Error("failed to count found bids", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	bids := make([]model.BidDB, len(results))
+	for i := range results {
+		bids[i] = results[i].BidDB
+	}
+	err = s.withOffers(ctx, bids)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range results {
+		results[i].Offer = bids[i].Offer
+	}
+
+	return results, total, nil
+}
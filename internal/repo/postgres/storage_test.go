@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+)
+
+// newStorage connects to database from POSTGRES_CONN and migrates it, tests are skipped without it
+func newStorage(t *testing.T) *Storage {
+	t.Helper()
+	conn := os.Getenv("POSTGRES_CONN")
+	if conn == "" {
+		t.Skip("POSTGRES_CONN is not set")
+	}
+
+	db, err := sqlx.Connect("pgx", conn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	migrator, err := NewMigrator(log, db)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Up())
+
+	return New(log, db)
+}
+
+// internal callers such as closing tender or tally list bids without page
+func TestBidsForTender_EmptyPage(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	username := fmt.Sprintf("repo_test_%d", time.Now().UnixNano())
+	employeeId, err := s.CreateEmployee(ctx, username, "Ivan", "Ivanov")
+	require.NoError(t, err)
+	organizationId, err := s.CreateOrganization(ctx, "Org", "", "LLC", employeeId)
+	require.NoError(t, err)
+	tenderId, err := s.CreateTender(ctx, "Tender", "desc", "Delivery", organizationId, username, nil, nil, quorum.Default())
+	require.NoError(t, err)
+	for _, name := range []string{"B", "A"} {
+		_, err = s.CreateBid(ctx, name, "desc", tenderId, "User", employeeId, nil)
+		require.NoError(t, err)
+	}
+
+	bids, total, err := s.BidsForTender(ctx, tenderId, "", model.PageQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	require.Len(t, bids, 2)
+	require.Equal(t, "A", bids[0].Name)
+}
+
+// missing version is 404 even with expected version, stale expected version is 412
+func TestRollbackTender_Errors(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	username := fmt.Sprintf("repo_test_%d", time.Now().UnixNano())
+	employeeId, err := s.CreateEmployee(ctx, username, "Ivan", "Ivanov")
+	require.NoError(t, err)
+	organizationId, err := s.CreateOrganization(ctx, "Org", "", "LLC", employeeId)
+	require.NoError(t, err)
+	tenderId, err := s.CreateTender(ctx, "Tender", "desc", "Delivery", organizationId, username, nil, nil, quorum.Default())
+	require.NoError(t, err)
+	_, err = s.EditTender(ctx, tenderId, "Renamed", "", "", nil, nil, 1)
+	require.NoError(t, err)
+
+	_, err = s.RollbackTender(ctx, tenderId, 5, 2)
+	require.Equal(t, errs.KindNotFound, errs.KindOf(err))
+	_, err = s.RollbackTender(ctx, tenderId, 1, 1)
+	require.Equal(t, errs.KindVersionMismatch, errs.KindOf(err))
+	_, err = s.EditTender(ctx, tenderId, "Stale", "", "", nil, nil, 1)
+	require.Equal(t, errs.KindVersionMismatch, errs.KindOf(err))
+
+	id, err := s.RollbackTender(ctx, tenderId, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, tenderId, id)
+}
+
+// offer comes back with amounts not rounded through float and deadline as date
+func TestOffer_ExactAmountAndDate(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage(t)
+
+	username := fmt.Sprintf("repo_test_%d", time.Now().UnixNano())
+	employeeId, err := s.CreateEmployee(ctx, username, "Ivan", "Ivanov")
+	require.NoError(t, err)
+	organizationId, err := s.CreateOrganization(ctx, "Org", "", "LLC", employeeId)
+	require.NoError(t, err)
+	tenderId, err := s.CreateTender(ctx, "Tender", "desc", "Delivery", organizationId, username, nil, nil, quorum.Default())
+	require.NoError(t, err)
+	offer := &model.Offer{
+		Amount:           "12345678901234.57",
+		Currency:         "RUB",
+		DeliveryDeadline: "2024-12-01",
+		Items:            []model.OfferItem{{Name: "Cement", Quantity: "0.125", UnitPrice: "0.10"}},
+	}
+	bidId, err := s.CreateBid(ctx, "Bid", "desc", tenderId, "User", employeeId, offer)
+	require.NoError(t, err)
+
+	bid, err := s.CheckBid(ctx, bidId)
+	require.NoError(t, err)
+	require.NotNil(t, bid.Offer)
+	require.Equal(t, model.Decimal("12345678901234.57"), bid.Offer.Amount)
+	require.Equal(t, "2024-12-01", bid.Offer.DeliveryDeadline)
+	require.Equal(t, []model.OfferItem{{Name: "Cement", Quantity: "0.125", UnitPrice: "0.10"}}, bid.Offer.Items)
+}
@@ -1,47 +1,52 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"log/slog"
-	"net/http"
 	"strings"
+	"zadanie-6105/internal/domain/errs"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
 	sl "zadanie-6105/internal/lib/logger/slog"
 )
 
-func (s *Storage) CheckResponsibleToTender(tenderId string, username string) error {
-	const op = "Support.CheckResponsibleToTender"
+func (s *Storage) CheckRole(ctx context.Context, organizationId string, userId string) (string, error) {
+	const op = "Support.CheckRole"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
 		selectQuery = `
-		SELECT e.username
-		FROM employee e
-		JOIN organization_responsible resp ON resp.user_id = e.id
-		JOIN organization o ON resp.organization_id = o.id
-		JOIN tender t ON t.organization_id = o.id
-		WHERE t.id = $1::uuid AND e.username = $2;
+		SELECT CAST(role AS text)
+		FROM organization_responsible
+		WHERE organization_id = $1::uuid AND user_id = $2::uuid;
 `
 		selectValues = []any{
-			tenderId, username,
+			organizationId, userId,
 		}
-		id string
+		role string
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
-	err := row.Scan(&id)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
+	err := row.Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Info("user is not a member of organization")
+		return "", errs.Forbidden(fmt.Errorf("user is not a member of organization"))
+	}
 	if err != nil {
-		log.Info("user have no access to tender ", err)
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user have no access to tender "))
+		log.Error("failed to get role", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
-	return nil
+	return role, nil
 }
 
-func (s *Storage) CheckIdByName(username string) (string, error) {
+func (s *Storage) CheckIdByName(ctx context.Context, username string) (string, error) {
 	const op = "Support.CheckIdByName"
 	log := s.log.With(
 		slog.String("op", op),
@@ -65,17 +70,47 @@ func (s *Storage) CheckIdByName(username string) (string, error) {
 		id string
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&id)
 	if err != nil {
 		log.Error("user not found", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusUnauthorized, fmt.Errorf("user not found"))
+		return "", errs.Unauthorized(fmt.Errorf("user not found"))
 	}
 
 	return id, nil
 }
 
-func (s *Storage) CheckCorporateById(userId string) (string, error) {
+func (s *Storage) CheckKnownNames(ctx context.Context, names []string) ([]string, error) {
+	const op = "Support.CheckKnownNames"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT username
+		FROM employee
+		WHERE username = ANY($1)
+
+		UNION
+
+		SELECT name
+		FROM organization
+		WHERE name = ANY($1);
+`
+		known []string
+	)
+
+	err := s.db.SelectContext(ctx, &known, selectQuery, pq.Array(names))
+	if err != nil {
+		log.Error("failed to select names", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return known, nil
+}
+
+func (s *Storage) CheckCorporateById(ctx context.Context, userId string) (string, error) {
 	const op = "Support.CheckCorporateById"
 	log := s.log.With(
 		slog.String("op", op),
@@ -99,46 +134,47 @@ func (s *Storage) CheckCorporateById(userId string) (string, error) {
 		username string
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&username)
 	if err != nil {
 		log.Error("user or organization not found", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusUnauthorized, fmt.Errorf("user or organization not found"))
+		return "", errs.Unauthorized(fmt.Errorf("user or organization not found"))
 	}
 
 	return username, nil
 }
 
-func (s *Storage) CheckResponsibility(username string) (string, error) {
-	const op = "Support.CheckResponsibility"
+func (s *Storage) CheckMemberships(ctx context.Context, userId string) ([]model.MembershipDB, error) {
+	const op = "Support.CheckMemberships"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
 		selectQuery = `
-		SELECT resp.organization_id
-		FROM employee e
-		JOIN organization_responsible resp ON resp.user_id = e.id
-		WHERE e.username = $1;
+		SELECT o.id, o.name, COALESCE(o.description, '') AS description, COALESCE(CAST(o.type AS text), '') AS type,
+		       o.created_at, o.updated_at, CAST(resp.role AS text) AS role
+		FROM organization_responsible resp
+		JOIN organization o ON o.id = resp.organization_id
+		WHERE resp.user_id = $1::uuid
+		ORDER BY o.created_at, o.id;
 `
 		selectValues = []any{
-			username,
+			userId,
 		}
-		organisationId string
+		memberships []model.MembershipDB
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
-	err := row.Scan(&organisationId)
+	err := s.db.SelectContext(ctx, &memberships, selectQuery, selectValues...)
 	if err != nil {
-		log.Info("user does not responsible for any organization", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user does not responsible for any organization"))
+		log.Error("failed to select memberships", sl.Err(err))
+		return nil, errs.Internal(err)
 	}
 
-	return organisationId, nil
+	return memberships, nil
 }
 
-func (s *Storage) CheckResponsibleCount(organizationId string) (int, error) {
+func (s *Storage) CheckResponsibleCount(ctx context.Context, organizationId string, roles []string) (int, error) {
 	const op = "Support.CheckResponsibleCount"
 	log := s.log.With(
 		slog.String("op", op),
@@ -148,25 +184,25 @@ func (s *Storage) CheckResponsibleCount(organizationId string) (int, error) {
 		selectQuery = `
 		SELECT COUNT(*) AS responsible_count
 		FROM organization_responsible
-		WHERE organization_id = $1;
+		WHERE organization_id = $1 AND CAST(role AS text) = ANY($2);
 `
 		selectValues = []any{
-			organizationId,
+			organizationId, pq.Array(roles),
 		}
 		responsibleCount int
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&responsibleCount)
 	if err != nil {
 		log.Info("failed to get responsible count", sl.Err(err))
-		return -1, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("failed to get responsible count"))
+		return -1, errs.Forbidden(fmt.Errorf("failed to get responsible count"))
 	}
 
 	return responsibleCount, nil
 }
 
-func (s *Storage) CheckTender(tenderId string) (model.TenderDB, error) {
+func (s *Storage) CheckTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
 	const op = "Support.CheckTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -175,7 +211,8 @@ func (s *Storage) CheckTender(tenderId string) (model.TenderDB, error) {
 	var (
 		selectQuery = `
 		SELECT id, name, description, CAST(serviceType AS text) AS serviceType,
-		       CAST(status AS text), organization_id,creator_username, version, created_at
+		       CAST(status AS text), organization_id,creator_username, version, created_at,
+		       submission_deadline, decision_deadline
 		FROM tender
 		WHERE id = $1::uuid;
 `
@@ -185,7 +222,7 @@ func (s *Storage) CheckTender(tenderId string) (model.TenderDB, error) {
 		tender model.TenderDB
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&tender.Id,
 		&tender.Name,
 		&tender.Description,
@@ -194,16 +231,18 @@ func (s *Storage) CheckTender(tenderId string) (model.TenderDB, error) {
 		&tender.OrganizationId,
 		&tender.CreatorUsername,
 		&tender.Version,
-		&tender.CreatedAt)
+		&tender.CreatedAt,
+		&tender.SubmissionDeadline,
+		&tender.DecisionDeadline)
 	if err != nil {
 		log.Error("tender not found", sl.Err(err))
-		return model.TenderDB{}, echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("tender not found"))
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("tender not found"))
 	}
 
 	return tender, nil
 }
 
-func (s *Storage) CheckTenderVersion(tenderId string, version int32) error {
+func (s *Storage) CheckTenderVersion(ctx context.Context, tenderId string, version int32) error {
 	const op = "Support.CheckTenderVersion"
 	log := s.log.With(
 		slog.String("op", op),
@@ -222,17 +261,17 @@ func (s *Storage) CheckTenderVersion(tenderId string, version int32) error {
 		verstion int32 = -1
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&verstion)
 	if err != nil {
 		log.Error("version not found", sl.Err(err))
-		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("no such tender version"))
+		return errs.NotFound(fmt.Errorf("no such tender version"))
 	}
 
 	return nil
 }
 
-func (s *Storage) CheckBid(bidId string) (model.BidDB, error) {
+func (s *Storage) CheckBid(ctx context.Context, bidId string) (model.BidDB, error) {
 	const op = "Support.CheckBid"
 	log := s.log.With(
 		slog.String("op", op),
@@ -251,7 +290,7 @@ func (s *Storage) CheckBid(bidId string) (model.BidDB, error) {
 		bid model.BidDB
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&bid.Id,
 		&bid.Name,
 		&bid.Description,
@@ -264,12 +303,16 @@ func (s *Storage) CheckBid(bidId string) (model.BidDB, error) {
 		&bid.CreatedAt)
 	if err != nil {
 		log.Error("bid not found", sl.Err(err))
-		return model.BidDB{}, echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("bid not found"))
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("bid not found"))
+	}
+	err = s.withOffer(ctx, &bid)
+	if err != nil {
+		return model.BidDB{}, err
 	}
 	return bid, nil
 }
 
-func (s *Storage) CheckBidVersion(bidId string, version int32) error {
+func (s *Storage) CheckBidVersion(ctx context.Context, bidId string, version int32) error {
 	const op = "Support.CheckBidVersion"
 	log := s.log.With(
 		slog.String("op", op),
@@ -288,17 +331,17 @@ func (s *Storage) CheckBidVersion(bidId string, version int32) error {
 		verstion int32 = -1
 	)
 
-	row := s.db.QueryRow(selectQuery, selectValues...)
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
 	err := row.Scan(&verstion)
 	if err != nil {
 		log.Error("version not found", sl.Err(err))
-		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("no such bid version"))
+		return errs.NotFound(fmt.Errorf("no such bid version"))
 	}
 
 	return nil
 }
 
-func (s *Storage) CheckBidAuthorByUsername(bidId string, username string) error {
+func (s *Storage) CheckBidAuthorByUsername(ctx context.Context, bidId string, username string) error {
 	const op = "Support.CheckBidAuthorByUsername"
 	log := s.log.With(
 		slog.String("op", op),
@@ -316,110 +359,17 @@ func (s *Storage) CheckBidAuthorByUsername(bidId string, username string) error
 		}
 		name string
 	)
-	row := s.db.QueryRow(authorQuery, authorValues...)
+	row := s.db.QueryRowContext(ctx, authorQuery, authorValues...)
 	errAuthor := row.Scan(&name)
 
 	if errAuthor != nil {
 		log.Info("user have no access to bid ", sl.Err(errAuthor))
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user have no access to bid "))
-	}
-	return nil
-}
-
-func (s *Storage) CheckBidTenderOwner(bidId string, organizationId string) (string, error) {
-	const op = "Support.CheckBidTenderOwner"
-	log := s.log.With(
-		slog.String("op", op),
-	)
-
-	var (
-		tenderOwnerQuery = `
-		SELECT t.id
-		FROM bid b 
-		JOIN tender t ON t.id = b.tenderid
-		JOIN organization o ON o.id = t.organization_id
-		WHERE b.id = $1::uuid AND o.id = $2::uuid;
-`
-		tenderOwnerValues = []any{
-			bidId, organizationId,
-		}
-		tenderId string
-	)
-
-	row := s.db.QueryRow(tenderOwnerQuery, tenderOwnerValues...)
-	errTenderOwner := row.Scan(&tenderId)
-
-	if errTenderOwner != nil {
-		log.Info("organization have no access to bid ", sl.Err(errTenderOwner))
-		return "", echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("organization have no access to bid "))
-	}
-
-	return tenderId, nil
-}
-
-func (s *Storage) CheckAccessToBidByOrganizationId(bidId string, organizationId string) error {
-	const op = "Support.CheckAccessToBidByOrganizationId"
-	log := s.log.With(
-		slog.String("op", op),
-	)
-
-	var (
-		authorQuery = `
-		SELECT o.name
-		FROM bid b 
-		JOIN organization o ON b.authorId = o.id
-		WHERE b.id = $1::uuid AND o.id = $2::uuid;
-`
-		authorValues = []any{
-			bidId, organizationId,
-		}
-		name string
-	)
-
-	row := s.db.QueryRow(authorQuery, authorValues...)
-	errAuthor := row.Scan(&name)
-
-	_, errTenderOwner := s.CheckBidTenderOwner(bidId, organizationId)
-	if errAuthor != nil || errTenderOwner != nil {
-		log.Info("organization have no access to bid ", sl.Err(errAuthor))
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("organization have no access to bid "))
-	}
-
-	return nil
-}
-
-func (s *Storage) CheckStatusForbiddenForBid(bidId string, username string) error {
-	const op = "Support.CheckStatusForbiddenForBid"
-	log := s.log.With(
-		slog.String("op", op),
-	)
-
-	var (
-		errNotAuthor      error
-		errNotResponsible error
-	)
-	//check status 403 (not author)
-	errNotAuthor = s.CheckBidAuthorByUsername(bidId, username)
-	//check status 403 (not responsible)
-	organizationId, errNotResponsible := s.CheckResponsibility(username)
-	if organizationId != "" {
-		log.Debug("orgId", organizationId)
-		errNotResponsible = s.CheckAccessToBidByOrganizationId(bidId, organizationId)
-	}
-
-	if errNotAuthor != nil {
-		log.Debug("errNotAuthor", sl.Err(errNotAuthor))
-	}
-	if errNotResponsible != nil {
-		log.Debug("errNotResponsible", sl.Err(errNotResponsible))
-	}
-	if errNotAuthor != nil && errNotResponsible != nil {
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user have no access to bid "))
+		return errs.Forbidden(fmt.Errorf("user have no access to bid "))
 	}
 	return nil
 }
 
-func (s *Storage) CheckBidDecisionCount(bidId string) (int, error) {
+func (s *Storage) CheckBidDecisionCount(ctx context.Context, bidId string, decision string) (int, error) {
 	const op = "Support.CheckBidDecisionCount"
 	log := s.log.With(
 		slog.String("op", op),
@@ -433,25 +383,56 @@ func (s *Storage) CheckBidDecisionCount(bidId string) (int, error) {
 		JOIN bid b ON bd.bid_id = b.id
 		JOIN tender t ON b.tenderId = t.id
 		WHERE b.id = $1::uuid
-		AND resp.organization_id = t.organization_id 
-		GROUP BY b.id;
+		AND bd.decision = $2::bid_decision
+		AND resp.organization_id = t.organization_id;
 `
 		countValues = []any{
-			bidId,
+			bidId, decision,
 		}
 		count int
 	)
-	row := s.db.QueryRow(countQuery, countValues...)
+	row := s.db.QueryRowContext(ctx, countQuery, countValues...)
 	err := row.Scan(&count)
 
 	if err != nil {
 		log.Error("failed to get decision count..", sl.Err(err))
-		return -1, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get decision count.."))
+		return -1, errs.Internal(fmt.Errorf("failed to get decision count.."))
 	}
 	return count, nil
 }
 
-func (s *Storage) CheckSameSubmitter(bidId string, username string) error {
+func (s *Storage) CheckDecisionPolicy(ctx context.Context, tenderId string) (quorum.Policy, error) {
+	const op = "Support.CheckDecisionPolicy"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT CAST(decision_policy AS text), decision_required, CAST(decision_approvers AS text[])
+		FROM tender
+		WHERE id = $1::uuid;
+`
+		selectValues = []any{
+			tenderId,
+		}
+		decisionPolicy quorum.Policy
+	)
+
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
+	err := row.Scan(&decisionPolicy.Kind, &decisionPolicy.Required, pq.Array(&decisionPolicy.Approvers))
+	if errors.Is(err, sql.ErrNoRows) {
+		return quorum.Policy{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+	if err != nil {
+		log.Error("failed to get decision policy", sl.Err(err))
+		return quorum.Policy{}, errs.Internal(err)
+	}
+
+	return decisionPolicy, nil
+}
+
+func (s *Storage) CheckSameSubmitter(ctx context.Context, bidId string, username string) error {
 	const op = "Support.CheckSameSubmitter"
 	log := s.log.With(
 		slog.String("op", op),
@@ -470,20 +451,20 @@ func (s *Storage) CheckSameSubmitter(bidId string, username string) error {
 		}
 		count int
 	)
-	row := s.db.QueryRow(countQuery, countValues...)
+	row := s.db.QueryRowContext(ctx, countQuery, countValues...)
 	err := row.Scan(&count)
 	if err != nil {
 		log.Error("failed to get decision count..", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get decision count.."))
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
 	}
 	if count > 0 {
 		log.Info("user already sent decision")
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user already sent decision"))
+		return errs.Forbidden(fmt.Errorf("user already sent decision"))
 	}
 	return nil
 }
 
-func (s *Storage) CheckBidAvailability(bidId string) error {
+func (s *Storage) CheckBidAvailability(ctx context.Context, bidId string) error {
 	const op = "Support.CheckBidAvailability"
 	log := s.log.With(
 		slog.String("op", op),
@@ -508,21 +489,21 @@ func (s *Storage) CheckBidAvailability(bidId string) error {
 		errDecisionTaken error
 		errBidCanceled   error
 	)
-	row := s.db.QueryRow(decisionQuery, Values...)
+	row := s.db.QueryRowContext(ctx, decisionQuery, Values...)
 	err := row.Scan(&decision)
 	if err != nil {
 		log.Error("failed to get decision", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get decision count.."))
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
 	}
 	if decision != "" {
 		errDecisionTaken = fmt.Errorf("decision on bid already taken")
 	}
 
-	row = s.db.QueryRow(canceledQuery, Values...)
+	row = s.db.QueryRowContext(ctx, canceledQuery, Values...)
 	err = row.Scan(&status)
 	if err != nil {
 		log.Error("failed to get status", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get decision count.."))
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
 	}
 	if strings.EqualFold(status, "Canceled") {
 		errBidCanceled = fmt.Errorf("bid is canceled")
@@ -530,13 +511,13 @@ func (s *Storage) CheckBidAvailability(bidId string) error {
 
 	if errDecisionTaken != nil || errBidCanceled != nil {
 		log.Info("bid is locked")
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("bid is locked"))
+		return errs.Forbidden(fmt.Errorf("bid is locked"))
 	}
 
 	return nil
 }
 
-func (s *Storage) CheckBidCanceled(bidId string) error {
+func (s *Storage) CheckBidCanceled(ctx context.Context, bidId string) error {
 	const op = "Support.CheckBidCanceled"
 	log := s.log.With(
 		slog.String("op", op),
@@ -557,11 +538,11 @@ func (s *Storage) CheckBidCanceled(bidId string) error {
 		errBidCanceled error
 	)
 
-	row := s.db.QueryRow(canceledQuery, Values...)
+	row := s.db.QueryRowContext(ctx, canceledQuery, Values...)
 	err := row.Scan(&status)
 	if err != nil {
 		log.Error("failed to get status", sl.Err(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get decision count.."))
+		return errs.Internal(fmt.Errorf("failed to get decision count.."))
 	}
 	if strings.EqualFold(status, "Canceled") {
 		errBidCanceled = fmt.Errorf("bid is canceled")
@@ -569,7 +550,7 @@ func (s *Storage) CheckBidCanceled(bidId string) error {
 
 	if errBidCanceled != nil {
 		log.Info("bid is canceled")
-		return echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("bid is locked"))
+		return errs.Forbidden(fmt.Errorf("bid is locked"))
 	}
 
 	return nil
@@ -1,47 +1,83 @@
 package postgres
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/lib/pq"
 	"log/slog"
-	"net/http"
+	"time"
+	"zadanie-6105/internal/domain/errs"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
 	sl "zadanie-6105/internal/lib/logger/slog"
 )
 
-func (s *Storage) Tenders(limit int32, offset int32, serviceTypes []string) ([]model.TenderDB, error) {
+// insertTenderVersion saves current tender to history
+const insertTenderVersion = `
+        INSERT INTO tender_version (tender_id, version, name, description, serviceType, status, organization_id, creator_username, created_at,
+                                    submission_deadline, decision_deadline, attachments)
+		SELECT id, version, name, description, serviceType, status, organization_id, creator_username, created_at,
+		       submission_deadline, decision_deadline, attachments
+		FROM tender
+		WHERE id = $1::uuid;
+    `
+
+// tenderSortColumns are shared by tenders lists, name may be null
+var tenderSortColumns = map[string]string{
+	model.SortName:      "COALESCE(t.name, '')",
+	model.SortCreatedAt: "t.created_at",
+	model.SortVersion:   "t.version",
+}
+
+// Tenders lists published tenders, total counts them without page
+func (s *Storage) Tenders(ctx context.Context, page model.PageQuery, serviceTypes []string) ([]model.TenderDB, int, error) {
 	const op = "Repo.Tenders"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	log.Debug("types: ", pq.Array(serviceTypes))
+	log.Debug("types: ", slog.Any("serviceTypes", serviceTypes))
+	after, order, afterValues := keyset(page, tenderSortColumns, "t.id", 4)
 	var (
+		filter = `
+		FROM tender t
+		WHERE ($1::TEXT[] IS NULL OR t.serviceType::TEXT = ANY($1))
+		  AND t.status = 'Published'
+`
 		selectQuery = `
-		SELECT id, name, description, CAST(serviceType AS text),
-		       CAST(status AS text), organization_id, creator_username, version, created_at
-		FROM tender
-		WHERE ($3::TEXT[] IS NULL OR serviceType::TEXT = ANY($3))
-		ORDER BY name ASC
-		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
-		OFFSET COALESCE($2, 0);
+		SELECT t.id, t.name, t.description, CAST(t.serviceType AS text),
+		       CAST(t.status AS text), t.organization_id, t.creator_username, t.version, t.created_at,
+		       t.submission_deadline, t.decision_deadline` + filter + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $2 = 0 THEN NULL ELSE $2 END
+		OFFSET COALESCE($3, 0);
 `
-		selectValues = []any{
-			limit, offset, pq.Array(serviceTypes),
-		}
-		tenders []model.TenderDB
+		selectValues = append([]any{
+			pq.Array(serviceTypes), page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*)` + filter
+		tenders    []model.TenderDB
+		total      int
 	)
 
-	err := s.db.Select(&tenders, selectQuery, selectValues...)
+	err := s.db.SelectContext(ctx, &tenders, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select tenders", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, pq.Array(serviceTypes))
 	if err != nil {
-		log.Error("failed to select tenders", err)
-		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to count tenders", sl.Err(err))
+		return nil, 0, errs.Internal(err)
 	}
 
-	return tenders, nil
+	return tenders, total, nil
 }
 
-func (s *Storage) CreateTender(name string, description string, serviceType string, organizationId string, creatorUsername string) (string, error) {
+func (s *Storage) CreateTender(ctx context.Context, name string, description string, serviceType string, organizationId string, creatorUsername string, submissionDeadline *time.Time, decisionDeadline *time.Time, decisionPolicy quorum.Policy) (string, error) {
 	const op = "Repo.CreateTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -49,76 +85,94 @@ func (s *Storage) CreateTender(name string, description string, serviceType stri
 
 	var (
 		insertQuery = `
-        INSERT INTO tender (name, description, serviceType, status, organization_id, creator_username, version, created_at)
-        VALUES ($1, $2, $3, 'Created'::tender_status, $4,$5, 1, CURRENT_TIMESTAMP)
+        INSERT INTO tender (name, description, serviceType, status, organization_id, creator_username, version, created_at,
+                            submission_deadline, decision_deadline,
+                            decision_policy, decision_required, decision_approvers)
+        VALUES ($1, $2, $3, 'Created'::tender_status, $4,$5, 1, CURRENT_TIMESTAMP, $6, $7,
+                $8::decision_policy, $9, COALESCE($10::uuid[], '{}'))
         RETURNING id;
     `
 		insertValues = []any{
-			name, description, serviceType, organizationId, creatorUsername,
+			name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline,
+			decisionPolicy.Kind, decisionPolicy.Required, pq.Array(decisionPolicy.Approvers),
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	row := tx.QueryRow(insertQuery, insertValues...)
+	row := tx.QueryRowContext(ctx, insertQuery, insertValues...)
 	err = row.Scan(&id)
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	err = addTenderEvent(ctx, tx, model.EventTenderCreated, id)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) TendersByUser(limit int32, offset int32, username string) ([]model.TenderDB, error) {
-	const op = "Repo.TendersByUser"
+func (s *Storage) TendersByOrganizations(ctx context.Context, page model.PageQuery, organizationIds []string) ([]model.TenderDB, int, error) {
+	const op = "Repo.TendersByOrganizations"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
+	after, order, afterValues := keyset(page, tenderSortColumns, "t.id", 4)
 	var (
+		filter = `
+		FROM tender t
+		WHERE t.organization_id = ANY($1::uuid[])
+`
 		selectQuery = `
 		SELECT t.id, t.name, t.description, CAST(t.serviceType AS text),
-		       CAST(t.status AS text), t.organization_id, t.creator_username, t.version, t.created_at
-		FROM tender t
-		JOIN organization o ON t.organization_id = o.id
-		JOIN organization_responsible resp ON resp.organization_id = o.id
-		JOIN employee e ON e.id = resp.user_id
-		WHERE e.username = $3
-		ORDER BY t.name ASC
-		LIMIT CASE WHEN $1 = 0 THEN NULL ELSE $1 END
-		OFFSET COALESCE($2, 0);
+		       CAST(t.status AS text), t.organization_id, t.creator_username, t.version, t.created_at,
+		       t.submission_deadline, t.decision_deadline` + filter + `
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $2 = 0 THEN NULL ELSE $2 END
+		OFFSET COALESCE($3, 0);
 `
-		selectValues = []any{
-			limit, offset, username,
-		}
-		tenders []model.TenderDB
+		selectValues = append([]any{
+			pq.Array(organizationIds), page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*)` + filter
+		tenders    []model.TenderDB
+		total      int
 	)
 
-	err := s.db.Select(&tenders, selectQuery, selectValues...)
+	err := s.db.SelectContext(ctx, &tenders, selectQuery, selectValues...)
 	if err != nil {
-		log.Error("failed to select tenders for user", err)
-		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to select tenders for organizations", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, pq.Array(organizationIds))
+	if err != nil {
+		log.Error("failed to count tenders for organizations", sl.Err(err))
+		return nil, 0, errs.Internal(err)
 	}
 
-	return tenders, nil
+	return tenders, total, nil
 }
 
-func (s *Storage) Status(tenderID string) (string, error) {
+func (s *Storage) Status(ctx context.Context, tenderID string) (string, error) {
 	const op = "Repo.Status"
 	log := s.log.With(
 		slog.String("op", op),
@@ -136,150 +190,175 @@ func (s *Storage) Status(tenderID string) (string, error) {
 		status string
 	)
 
-	err := s.db.Get(&status, selectQuery, selectValues...)
+	err := s.db.GetContext(ctx, &status, selectQuery, selectValues...)
 	if err != nil {
-		log.Error("failed to get status for tender", err)
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to get status for tender", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
 	return status, nil
 }
 
-func (s *Storage) ChangeTenderStatus(tenderId string, status string) (string, error) {
-	const op = "Repo.ChangeTenderStatus"
+func (s *Storage) ExpiredTenders(ctx context.Context, now time.Time) ([]model.TenderDB, error) {
+	const op = "Repo.ExpiredTenders"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO tender_version (tender_id, version, name, description, serviceType, status, organization_id, creator_username, created_at)
-		SELECT id, version, name, description, serviceType, status, organization_id, creator_username, created_at
+		selectQuery = `
+		SELECT id, name, description, CAST(serviceType AS text),
+		       CAST(status AS text), organization_id, creator_username, version, created_at,
+		       submission_deadline, decision_deadline
 		FROM tender
-		WHERE id = $1::uuid;
-    `
+		WHERE status = 'Published'
+		  AND decision_deadline IS NOT NULL
+		  AND decision_deadline <= $1
+		ORDER BY decision_deadline ASC;
+`
+		selectValues = []any{
+			now,
+		}
+		tenders []model.TenderDB
+	)
+
+	err := s.db.SelectContext(ctx, &tenders, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select expired tenders", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return tenders, nil
+}
+
+func (s *Storage) ChangeTenderStatus(ctx context.Context, tenderId string, status string, expectedVersion int32) (string, error) {
+	const op = "Repo.ChangeTenderStatus"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
 		updateQuery = `
 		UPDATE tender
 		SET status = $2::tender_status, 
 			version = version + 1
 		WHERE id = $1::uuid
+		  AND ($3::int = 0 OR version = $3)
 		RETURNING id;
 `
-		versionValues = []any{
-			tenderId,
-		}
 		updateValues = []any{
-			tenderId, status,
+			tenderId, status, expectedVersion,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "tender", insertTenderVersion, tenderId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save tender to history", sl.Err(err))
+		return "", err
 	}
 
-	row := tx.QueryRow(updateQuery, updateValues...)
+	row := tx.QueryRowContext(ctx, updateQuery, updateValues...)
 	err = row.Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) && expectedVersion != 0 {
+		log.Warn("tender version mismatch", slog.Int("expectedVersion", int(expectedVersion)))
+		return "", errs.VersionMismatch(fmt.Errorf("tender version mismatch"))
+	}
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	err = addTenderEvent(ctx, tx, model.EventTenderStatusChanged, id)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return "", errs.Internal(err)
 	}
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) EditTender(tenderId string, name string, description string, serviceType string) (string, error) {
+func (s *Storage) EditTender(ctx context.Context, tenderId string, name string, description string, serviceType string, submissionDeadline *time.Time, decisionDeadline *time.Time, expectedVersion int32) (string, error) {
 	const op = "Repo.EditTender"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO tender_version (tender_id, version, name, description, serviceType, status, organization_id, creator_username,  created_at)
-		SELECT id, version, name, description, serviceType, status, organization_id, creator_username, created_at
-		FROM tender
-		WHERE id = $1::uuid;
-    `
 		updateQuery = `
 		UPDATE tender
 		SET name = COALESCE(NULLIF($2, ''), name),
 		    description = COALESCE(NULLIF($3, ''), description),
-		    serviceType = COALESCE(NULLIF($4, '')::service_type, serviceType), 
+		    serviceType = COALESCE(NULLIF($4, ''), serviceType),
+		    submission_deadline = COALESCE($6::timestamptz, submission_deadline),
+		    decision_deadline = COALESCE($7::timestamptz, decision_deadline),
 			version = version + 1
 		WHERE id = $1::uuid
+		  AND ($5::int = 0 OR version = $5)
 		RETURNING id;
 `
-		versionValues = []any{
-			tenderId,
-		}
 		updateValues = []any{
-			tenderId, name, description, serviceType,
+			tenderId, name, description, serviceType, expectedVersion, submissionDeadline, decisionDeadline,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "tender", insertTenderVersion, tenderId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save tender to history", sl.Err(err))
+		return "", err
 	}
 
-	row := tx.QueryRow(updateQuery, updateValues...)
+	row := tx.QueryRowContext(ctx, updateQuery, updateValues...)
 	err = row.Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) && expectedVersion != 0 {
+		log.Warn("tender version mismatch", slog.Int("expectedVersion", int(expectedVersion)))
+		return "", errs.VersionMismatch(fmt.Errorf("tender version mismatch"))
+	}
 	if err != nil {
 		log.Error("failed to scan id", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
 }
 
-func (s *Storage) RollbackTender(tenderId string, version int32) (string, error) {
+func (s *Storage) RollbackTender(ctx context.Context, tenderId string, version int32, expectedVersion int32) (string, error) {
 	const op = "Repo.RollbackTender"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
 	var (
-		insertVersion = `
-        INSERT INTO tender_version (tender_id, version, name, description, serviceType, status, organization_id, creator_username, created_at)
-		SELECT id, version, name, description, serviceType, status, organization_id, creator_username, created_at
-		FROM tender
-		WHERE id = $1::uuid;
-    `
 		updateQuery = `
 		UPDATE tender
 		SET name = v.name,
@@ -288,46 +367,58 @@ func (s *Storage) RollbackTender(tenderId string, version int32) (string, error)
 			status = v.status::tender_status,
 			organization_id = v.organization_id,
 			creator_username = v.creator_username,
+			submission_deadline = v.submission_deadline,
+			decision_deadline = v.decision_deadline,
+			attachments = v.attachments,
 			version = tender.version + 1
 		FROM tender_version v
 		WHERE tender.id = v.tender_id
 		  AND tender.id = $1
-		  AND v.version = $2;
+		  AND v.version = $2
+		  AND ($3::int = 0 OR tender.version = $3);
 `
-		versionValues = []any{
-			tenderId,
-		}
 		updateValues = []any{
-			tenderId, version,
+			tenderId, version, expectedVersion,
 		}
 		id string
 	)
 
 	log.Debug("beginning transaction")
-	tx, err := s.db.Begin()
+	tx, err := s.begin(ctx)
 	if err != nil {
 		log.Error("failed to begin transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec(insertVersion, versionValues...)
+	err = saveVersion(ctx, tx, "tender", insertTenderVersion, tenderId, expectedVersion)
 	if err != nil {
-		log.Error("failed to insert to history table", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		log.Error("failed to save tender to history", sl.Err(err))
+		return "", err
 	}
 
-	_, err = tx.Exec(updateQuery, updateValues...)
+	result, err := tx.ExecContext(ctx, updateQuery, updateValues...)
 	if err != nil {
 		log.Error("failed to update", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Error("failed to get affected rows", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+	// current version is checked under lock, so nothing is updated only without such version in history
+	if affected == 0 {
+		log.Warn("tender version not found", slog.Int("version", int(version)))
+		return "", errs.NotFound(fmt.Errorf("tender version not found"))
 	}
+	id = tenderId
 
 	log.Debug("trying to commit transaction")
 	err = tx.Commit()
 	if err != nil {
 		log.Error("failed to commit transaction", sl.Err(err))
-		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+		return "", errs.Internal(err)
 	}
 
 	return id, nil
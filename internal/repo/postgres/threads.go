@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+type threadMessageRow struct {
+	Id             string         `db:"id"`
+	BidId          string         `db:"bid_id"`
+	AuthorId       string         `db:"author_id"`
+	AuthorUsername string         `db:"author_username"`
+	Side           string         `db:"side"`
+	Text           string         `db:"description"`
+	Mentions       pq.StringArray `db:"mentions"`
+	CreatedAt      string         `db:"created_at"`
+	Unread         bool           `db:"unread"`
+}
+
+func (r threadMessageRow) toModel() model.ThreadMessageDB {
+	return model.ThreadMessageDB{
+		Id:             r.Id,
+		BidId:          r.BidId,
+		AuthorId:       r.AuthorId,
+		AuthorUsername: r.AuthorUsername,
+		Side:           r.Side,
+		Text:           r.Text,
+		Mentions:       r.Mentions,
+		CreatedAt:      r.CreatedAt,
+		Unread:         r.Unread,
+	}
+}
+
+// selectThreadMessage is shared by inserted and listed messages, fb is the feedback row
+const selectThreadMessage = `
+		SELECT fb.id, fb.bidId AS bid_id,
+		       COALESCE(CAST(fb.author_id AS text), '') AS author_id, COALESCE(e.username, '') AS author_username,
+		       CAST(fb.side AS text) AS side, fb.description, CAST(fb.mentions AS text[]) AS mentions,
+		       fb.createdAt AS created_at`
+
+func (s *Storage) PostMessage(ctx context.Context, bidId string, authorId string, side string, text string, mentions []string, ratings map[string]int) (model.ThreadMessageDB, error) {
+	const op = "Repo.PostMessage"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		WITH fb AS (
+		    INSERT INTO feedback (description, bidId, author_id, side, mentions)
+		    VALUES ($1, $2::uuid, $3::uuid, $4::feedback_side, COALESCE($5::varchar(50)[], '{}'))
+		    RETURNING *
+		)` + selectThreadMessage + `, FALSE AS unread
+		FROM fb
+		LEFT JOIN employee e ON e.id = fb.author_id;
+`
+		insertValues = []any{
+			text, bidId, authorId, side, pq.Array(mentions),
+		}
+		ratingQuery = `
+		INSERT INTO feedback_rating (feedback_id, criterion, score)
+		VALUES ($1::uuid, $2, $3);
+`
+		row threadMessageRow
+	)
+	tx, err := s.begin(ctx)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return model.ThreadMessageDB{}, errs.Internal(err)
+	}
+	defer tx.Rollback()
+
+	err = tx.GetContext(ctx, &row, insertQuery, insertValues...)
+	if err != nil {
+		log.Error("failed to post message", sl.Err(err))
+		return model.ThreadMessageDB{}, errs.Internal(err)
+	}
+	for criterion, score := range ratings {
+		_, err = tx.ExecContext(ctx, ratingQuery, row.Id, criterion, score)
+		if err != nil {
+			log.Error("failed to rate", sl.Err(err))
+			return model.ThreadMessageDB{}, errs.Internal(err)
+		}
+	}
+	eventType, extra := model.EventBidFeedbackLeft, map[string]any{"feedback": text, "mentions": row.Mentions}
+	if side == model.SideBidder {
+		eventType, extra = model.EventBidFeedbackReplied, map[string]any{"reply": text, "mentions": row.Mentions}
+	}
+	err = addBidEvent(ctx, tx, eventType, bidId, extra)
+	if err != nil {
+		log.Error("failed to add event", sl.Err(err))
+		return model.ThreadMessageDB{}, errs.Internal(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return model.ThreadMessageDB{}, errs.Internal(err)
+	}
+	message := row.toModel()
+	message.Ratings = ratings
+	return message, nil
+}
+
+func (s *Storage) Thread(ctx context.Context, bidId string, viewerId string, page model.PageQuery) ([]model.ThreadMessageDB, int, error) {
+	const op = "Repo.Thread"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	page.Sort = model.SortCreatedAt
+	after, order, afterValues := keyset(page, map[string]string{model.SortCreatedAt: "fb.createdAt"}, "fb.id", 5)
+	var (
+		filter = `
+		FROM feedback fb
+		WHERE fb.bidId = $1::uuid
+`
+		selectQuery = selectThreadMessage + `,
+		       (fb.author_id IS DISTINCT FROM $2::uuid AND (r.read_at IS NULL OR fb.createdAt > r.read_at)) AS unread
+		FROM feedback fb
+		LEFT JOIN employee e ON e.id = fb.author_id
+		LEFT JOIN feedback_read r ON r.bid_id = fb.bidId AND r.employee_id = $2::uuid
+		WHERE fb.bidId = $1::uuid
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $3 = 0 THEN NULL ELSE $3 END
+		OFFSET COALESCE($4, 0);
+`
+		selectValues = append([]any{
+			bidId, viewerId, page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*)` + filter
+		rows       []threadMessageRow
+		total      int
+	)
+
+	err := s.db.SelectContext(ctx, &rows, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select thread", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, bidId)
+	if err != nil {
+		log.Error("failed to count thread", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	messages := make([]model.ThreadMessageDB, len(rows))
+	for i, row := range rows {
+		messages[i] = row.toModel()
+	}
+	return messages, total, nil
+}
+
+func (s *Storage) MarkThreadRead(ctx context.Context, bidId string, employeeId string) error {
+	const op = "Repo.MarkThreadRead"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		upsertQuery = `
+		INSERT INTO feedback_read (bid_id, employee_id, read_at)
+		VALUES ($1::uuid, $2::uuid, CURRENT_TIMESTAMP)
+		ON CONFLICT (bid_id, employee_id) DO UPDATE SET read_at = EXCLUDED.read_at;
+`
+		upsertValues = []any{
+			bidId, employeeId,
+		}
+	)
+
+	_, err := s.db.ExecContext(ctx, upsertQuery, upsertValues...)
+	if err != nil {
+		log.Error("failed to mark thread read", sl.Err(err))
+		return errs.Internal(err)
+	}
+	return nil
+}
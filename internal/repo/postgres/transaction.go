@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/repo"
+)
+
+// dbtx is satisfied by both *sqlx.DB and *sqlx.Tx
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+type txer interface {
+	dbtx
+	Commit() error
+	Rollback() error
+}
+
+// nestedTx lets methods with their own transaction run inside unit of work,
+// commit and rollback are up to the outer transaction
+type nestedTx struct {
+	*sqlx.Tx
+}
+
+func (nestedTx) Commit() error   { return nil }
+func (nestedTx) Rollback() error { return nil }
+
+func (s *Storage) begin(ctx context.Context) (txer, error) {
+	if s.tx != nil {
+		return nestedTx{s.tx}, nil
+	}
+	return s.conn.BeginTxx(ctx, nil)
+}
+
+// InTransaction runs fn with storage bound to one transaction,
+// everything is rolled back if fn returns error
+func (s *Storage) InTransaction(ctx context.Context, fn func(tx repo.Tx) error) error {
+	const op = "Repo.InTransaction"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	log.Debug("beginning transaction")
+	tx, err := s.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", sl.Err(err))
+		return errs.Internal(err)
+	}
+	defer tx.Rollback()
+
+	err = fn(&Storage{
+		log:  s.log,
+		db:   tx,
+		conn: s.conn,
+		tx:   tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("trying to commit transaction")
+	err = tx.Commit()
+	if err != nil {
+		log.Error("failed to commit transaction", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) LockTender(ctx context.Context, tenderId string) (model.TenderDB, error) {
+	const op = "Repo.LockTender"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, name, description, CAST(serviceType AS text),
+		       CAST(status AS text), organization_id, creator_username, version, created_at,
+		       submission_deadline, decision_deadline
+		FROM tender
+		WHERE id = $1::uuid
+		FOR UPDATE;
+`
+		selectValues = []any{
+			tenderId,
+		}
+		tender model.TenderDB
+	)
+
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
+	err := row.Scan(&tender.Id,
+		&tender.Name,
+		&tender.Description,
+		&tender.ServiceType,
+		&tender.Status,
+		&tender.OrganizationId,
+		&tender.CreatorUsername,
+		&tender.Version,
+		&tender.CreatedAt,
+		&tender.SubmissionDeadline,
+		&tender.DecisionDeadline)
+	if err != nil {
+		log.Error("failed to lock tender", sl.Err(err))
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("tender not found"))
+	}
+
+	return tender, nil
+}
+
+func (s *Storage) LockBid(ctx context.Context, bidId string) (model.BidDB, error) {
+	const op = "Repo.LockBid"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, name, description, COALESCE(CAST(decision AS text),''),
+		       CAST(status AS text), tenderId, authorType, authorId, version, createdAt
+		FROM bid
+		WHERE id = $1::uuid
+		FOR UPDATE;
+`
+		selectValues = []any{
+			bidId,
+		}
+		bid model.BidDB
+	)
+
+	row := s.db.QueryRowContext(ctx, selectQuery, selectValues...)
+	err := row.Scan(&bid.Id,
+		&bid.Name,
+		&bid.Description,
+		&bid.Decision,
+		&bid.Status,
+		&bid.TenderId,
+		&bid.AuthorType,
+		&bid.AuthorId,
+		&bid.Version,
+		&bid.CreatedAt)
+	if err != nil {
+		log.Error("failed to lock bid", sl.Err(err))
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("bid not found"))
+	}
+
+	return bid, nil
+}
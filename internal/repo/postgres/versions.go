@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// selectTenderVersions returns history together with current state ordered by version,
+// $2 = 0 means all versions
+const selectTenderVersions = `
+		SELECT id, name, description, servicetype, status, organization_id, creator_username,
+		       version, created_at, submission_deadline, decision_deadline, attachments
+		FROM (
+			SELECT tender_id AS id, name, description, CAST(serviceType AS text) AS servicetype,
+			       CAST(status AS text) AS status, organization_id, creator_username, version, created_at,
+			       submission_deadline, decision_deadline, CAST(attachments AS text[]) AS attachments
+			FROM tender_version
+			WHERE tender_id = $1::uuid
+			UNION ALL
+			SELECT id, name, description, CAST(serviceType AS text),
+			       CAST(status AS text), organization_id, creator_username, version, created_at,
+			       submission_deadline, decision_deadline, CAST(attachments AS text[])
+			FROM tender
+			WHERE id = $1::uuid
+		) versions
+		WHERE ($2::int = 0 OR version = $2)
+		ORDER BY version ASC;
+`
+
+// selectBidVersions does the same for bids, offer of current version is built
+// the same way insertBidVersion saves it
+const selectBidVersions = `
+		SELECT *
+		FROM (
+			SELECT v.bid_id AS id, v.name, COALESCE(v.description, '') AS description,
+			       COALESCE(CAST(v.decision AS text), '') AS decision, CAST(v.status AS text) AS status,
+			       v.tenderId AS tenderid, CAST(v.authorType AS text) AS authortype, v.authorId AS authorid,
+			       v.version, v.createdAt AS createdat,
+			       v.amount, v.currency, v.delivery_deadline,
+			       COALESCE(v.warranty_months, 0) AS warranty_months,
+			       COALESCE(v.items, '[]'::jsonb)::text AS items,
+			       CAST(v.attachments AS text[]) AS attachments
+			FROM bid_version v
+			WHERE v.bid_id = $1::uuid
+			UNION ALL
+			SELECT b.id, b.name, COALESCE(b.description, ''),
+			       COALESCE(CAST(b.decision AS text), ''), CAST(b.status AS text),
+			       b.tenderId, CAST(b.authorType AS text), b.authorId,
+			       b.version, b.createdAt,
+			       o.amount, o.currency, o.delivery_deadline,
+			       COALESCE(o.warranty_months, 0),
+			       COALESCE((SELECT jsonb_agg(jsonb_build_object('name', i.name, 'quantity', i.quantity, 'unitPrice', i.unit_price)
+			                                  ORDER BY i.position)
+			                 FROM bid_offer_item i
+			                 WHERE i.bid_id = b.id), '[]'::jsonb)::text,
+			       CAST(b.attachments AS text[])
+			FROM bid b
+			LEFT JOIN bid_offer o ON o.bid_id = b.id
+			WHERE b.id = $1::uuid
+		) versions
+		WHERE ($2::int = 0 OR version = $2)
+		ORDER BY version ASC;
+`
+
+type bidVersionRow struct {
+	model.BidDB
+	Amount           model.Decimal  `db:"amount"`
+	Currency         sql.NullString `db:"currency"`
+	DeliveryDeadline sql.NullTime   `db:"delivery_deadline"`
+	WarrantyMonths   int32          `db:"warranty_months"`
+	Items            string         `db:"items"`
+	Attachments      pq.StringArray `db:"attachments"`
+}
+
+type tenderVersionRow struct {
+	model.TenderDB
+	Attachments pq.StringArray `db:"attachments"`
+}
+
+func (r tenderVersionRow) toModel() model.TenderDB {
+	tender := r.TenderDB
+	tender.Attachments = r.Attachments
+	return tender
+}
+
+// toModel returns bid with offer, offer is nil if version had none
+func (r bidVersionRow) toModel() (model.BidDB, error) {
+	bid := r.BidDB
+	bid.Attachments = r.Attachments
+	if !r.Currency.Valid {
+		return bid, nil
+	}
+
+	bid.Offer = &model.Offer{
+		Amount:           r.Amount,
+		Currency:         r.Currency.String,
+		DeliveryDeadline: formatDate(r.DeliveryDeadline),
+		WarrantyMonths:   r.WarrantyMonths,
+	}
+	err := json.Unmarshal([]byte(r.Items), &bid.Offer.Items)
+	if err != nil {
+		return model.BidDB{}, err
+	}
+	if len(bid.Offer.Items) == 0 {
+		bid.Offer.Items = nil
+	}
+	return bid, nil
+}
+
+func (s *Storage) TenderVersions(ctx context.Context, tenderId string) ([]model.TenderDB, error) {
+	const op = "Repo.TenderVersions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tenders, err := s.tenderVersions(ctx, tenderId, 0)
+	if err != nil {
+		log.Error("failed to select tender versions", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return tenders, nil
+}
+
+func (s *Storage) TenderVersion(ctx context.Context, tenderId string, version int32) (model.TenderDB, error) {
+	const op = "Repo.TenderVersion"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tenders, err := s.tenderVersions(ctx, tenderId, version)
+	if err != nil {
+		log.Error("failed to select tender version", sl.Err(err))
+		return model.TenderDB{}, errs.Internal(err)
+	}
+	if len(tenders) == 0 {
+		return model.TenderDB{}, errs.NotFound(fmt.Errorf("no such tender version"))
+	}
+
+	return tenders[0], nil
+}
+
+func (s *Storage) BidVersions(ctx context.Context, bidId string) ([]model.BidDB, error) {
+	const op = "Repo.BidVersions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	bids, err := s.bidVersions(ctx, bidId, 0)
+	if err != nil {
+		log.Error("failed to select bid versions", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return bids, nil
+}
+
+func (s *Storage) BidVersion(ctx context.Context, bidId string, version int32) (model.BidDB, error) {
+	const op = "Repo.BidVersion"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	bids, err := s.bidVersions(ctx, bidId, version)
+	if err != nil {
+		log.Error("failed to select bid version", sl.Err(err))
+		return model.BidDB{}, errs.Internal(err)
+	}
+	if len(bids) == 0 {
+		return model.BidDB{}, errs.NotFound(fmt.Errorf("no such bid version"))
+	}
+
+	return bids[0], nil
+}
+
+func (s *Storage) tenderVersions(ctx context.Context, tenderId string, version int32) ([]model.TenderDB, error) {
+	var rows []tenderVersionRow
+	err := s.db.SelectContext(ctx, &rows, selectTenderVersions, tenderId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	tenders := make([]model.TenderDB, len(rows))
+	for i, row := range rows {
+		tenders[i] = row.toModel()
+	}
+	return tenders, nil
+}
+
+func (s *Storage) bidVersions(ctx context.Context, bidId string, version int32) ([]model.BidDB, error) {
+	var rows []bidVersionRow
+	err := s.db.SelectContext(ctx, &rows, selectBidVersions, bidId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	bids := make([]model.BidDB, len(rows))
+	for i, row := range rows {
+		bids[i], err = row.toModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bids, nil
+}
+
+// saveVersion locks row of table and saves it to history with insertVersion. Concurrent edits
+// of the same version wait for the lock instead of colliding on history key, and expected version
+// is checked under it, 0 means any version
+func saveVersion(ctx context.Context, tx dbtx, table string, insertVersion string, id string, expectedVersion int32) error {
+	var (
+		lockQuery = fmt.Sprintf(`
+		SELECT version
+		FROM %s
+		WHERE id = $1::uuid
+		FOR UPDATE;
+`, table)
+		version int32
+	)
+
+	err := tx.GetContext(ctx, &version, lockQuery, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errs.NotFound(fmt.Errorf("%s not found", table))
+	}
+	if err != nil {
+		return errs.Internal(err)
+	}
+	if expectedVersion != 0 && version != expectedVersion {
+		return errs.VersionMismatch(fmt.Errorf("%s version mismatch", table))
+	}
+
+	_, err = tx.ExecContext(ctx, insertVersion, id)
+	if err != nil {
+		return errs.Internal(err)
+	}
+	return nil
+}
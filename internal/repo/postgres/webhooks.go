@@ -0,0 +1,312 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/lib/pq"
+	"log/slog"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+type webhookRow struct {
+	Id             string         `db:"id"`
+	OrganizationId string         `db:"organization_id"`
+	Url            string         `db:"url"`
+	Secret         string         `db:"secret"`
+	Events         pq.StringArray `db:"events"`
+	CreatedAt      string         `db:"created_at"`
+}
+
+func (r webhookRow) toModel() model.WebhookDB {
+	return model.WebhookDB{
+		Id:             r.Id,
+		OrganizationId: r.OrganizationId,
+		Url:            r.Url,
+		Secret:         r.Secret,
+		Events:         r.Events,
+		CreatedAt:      r.CreatedAt,
+	}
+}
+
+func (s *Storage) CreateWebhook(ctx context.Context, organizationId string, url string, secret string, events []string) (string, error) {
+	const op = "Repo.CreateWebhook"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		insertQuery = `
+		INSERT INTO webhook (organization_id, url, secret, events)
+		VALUES ($1::uuid, $2, $3, $4::text[])
+		RETURNING id;
+`
+		insertValues = []any{
+			organizationId, url, secret, pq.Array(events),
+		}
+		id string
+	)
+
+	row := s.db.QueryRowContext(ctx, insertQuery, insertValues...)
+	err := row.Scan(&id)
+	if err != nil {
+		log.Error("failed to scan id", sl.Err(err))
+		return "", errs.Internal(err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) Webhooks(ctx context.Context, organizationId string) ([]model.WebhookDB, error) {
+	const op = "Repo.Webhooks"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, organization_id, url, secret, events, created_at
+		FROM webhook
+		WHERE organization_id = $1::uuid
+		ORDER BY created_at ASC;
+`
+		selectValues = []any{
+			organizationId,
+		}
+		rows []webhookRow
+	)
+
+	err := s.db.SelectContext(ctx, &rows, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select webhooks", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	webhooks := make([]model.WebhookDB, len(rows))
+	for i, row := range rows {
+		webhooks[i] = row.toModel()
+	}
+	return webhooks, nil
+}
+
+func (s *Storage) Webhook(ctx context.Context, webhookId string) (model.WebhookDB, error) {
+	const op = "Repo.Webhook"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		selectQuery = `
+		SELECT id, organization_id, url, secret, events, created_at
+		FROM webhook
+		WHERE id = $1::uuid;
+`
+		selectValues = []any{
+			webhookId,
+		}
+		row webhookRow
+	)
+
+	err := s.db.GetContext(ctx, &row, selectQuery, selectValues...)
+	if err != nil {
+		log.Info("webhook not found", sl.Err(err))
+		return model.WebhookDB{}, errs.NotFound(fmt.Errorf("webhook not found"))
+	}
+
+	return row.toModel(), nil
+}
+
+func (s *Storage) DeleteWebhook(ctx context.Context, webhookId string) error {
+	const op = "Repo.DeleteWebhook"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		deleteQuery = `
+		DELETE FROM webhook
+		WHERE id = $1::uuid;
+`
+		deleteValues = []any{
+			webhookId,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, deleteValues...)
+	if err != nil {
+		log.Error("failed to delete webhook", sl.Err(err))
+		return errs.Internal(err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return errs.NotFound(fmt.Errorf("webhook not found"))
+	}
+
+	return nil
+}
+
+// WebhookDeliveries are sorted by creation time only, cursor value is in UTC
+// because created_at of deliveries has time zone and cursor is cast to timestamp
+func (s *Storage) WebhookDeliveries(ctx context.Context, webhookId string, page model.PageQuery) ([]model.WebhookDeliveryDB, int, error) {
+	const op = "Repo.WebhookDeliveries"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	page.Sort = model.SortCreatedAt
+	after, order, afterValues := keyset(page, map[string]string{model.SortCreatedAt: "(d.created_at AT TIME ZONE 'UTC')"}, "d.id", 4)
+	var (
+		selectQuery = `
+		SELECT d.id, d.webhook_id, d.event_id, e.type AS event_type, d.status, d.attempts,
+		       COALESCE(d.response_code, 0) AS response_code, COALESCE(d.error, '') AS error,
+		       d.next_attempt_at, d.created_at, d.updated_at
+		FROM webhook_delivery d
+		JOIN outbox_event e ON e.id = d.event_id
+		WHERE d.webhook_id = $1::uuid
+		  AND ` + after + `
+		ORDER BY ` + order + `
+		LIMIT CASE WHEN $2 = 0 THEN NULL ELSE $2 END
+		OFFSET COALESCE($3, 0);
+`
+		selectValues = append([]any{
+			webhookId, page.Limit, page.Offset,
+		}, afterValues...)
+		countQuery = `SELECT count(*) FROM webhook_delivery WHERE webhook_id = $1::uuid`
+		deliveries []model.WebhookDeliveryDB
+		total      int
+	)
+
+	err := s.db.SelectContext(ctx, &deliveries, selectQuery, selectValues...)
+	if err != nil {
+		log.Error("failed to select deliveries", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+	err = s.db.GetContext(ctx, &total, countQuery, webhookId)
+	if err != nil {
+		log.Error("failed to count deliveries", sl.Err(err))
+		return nil, 0, errs.Internal(err)
+	}
+
+	return deliveries, total, nil
+}
+
+// FanOutEvents creates deliveries for not dispatched events and marks them dispatched,
+// events of organizations without webhooks are just marked
+func (s *Storage) FanOutEvents(ctx context.Context, limit int32) (int, error) {
+	const op = "Repo.FanOutEvents"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		fanOutQuery = `
+		WITH events AS (
+			SELECT id, type, organization_id
+			FROM outbox_event
+			WHERE dispatched_at IS NULL
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), deliveries AS (
+			INSERT INTO webhook_delivery (webhook_id, event_id)
+			SELECT w.id, e.id
+			FROM events e
+			JOIN webhook w ON w.organization_id = e.organization_id
+			WHERE cardinality(w.events) = 0 OR e.type = ANY(w.events)
+			ON CONFLICT (webhook_id, event_id) DO NOTHING
+		)
+		UPDATE outbox_event
+		SET dispatched_at = CURRENT_TIMESTAMP
+		WHERE id IN (SELECT id FROM events);
+`
+		fanOutValues = []any{
+			limit,
+		}
+	)
+
+	res, err := s.db.ExecContext(ctx, fanOutQuery, fanOutValues...)
+	if err != nil {
+		log.Error("failed to fan out events", sl.Err(err))
+		return 0, errs.Internal(err)
+	}
+	affected, _ := res.RowsAffected()
+
+	return int(affected), nil
+}
+
+// ClaimDeliveries takes due pending deliveries and postpones them for lease,
+// so other dispatchers skip them while they are being sent
+func (s *Storage) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int32) ([]model.Delivery, error) {
+	const op = "Repo.ClaimDeliveries"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		claimQuery = `
+		WITH claimed AS (
+			UPDATE webhook_delivery
+			SET next_attempt_at = $1::timestamptz + make_interval(secs => $2),
+			    updated_at = CURRENT_TIMESTAMP
+			WHERE id IN (
+				SELECT id
+				FROM webhook_delivery
+				WHERE status = 'Pending' AND next_attempt_at <= $1::timestamptz
+				ORDER BY next_attempt_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, webhook_id, event_id, attempts
+		)
+		SELECT c.id, c.webhook_id, w.url, w.secret, c.event_id, e.type AS event_type,
+		       e.payload::text AS payload, e.created_at AS event_created_at, c.attempts
+		FROM claimed c
+		JOIN webhook w ON w.id = c.webhook_id
+		JOIN outbox_event e ON e.id = c.event_id;
+`
+		claimValues = []any{
+			now, lease.Seconds(), limit,
+		}
+		deliveries []model.Delivery
+	)
+
+	err := s.db.SelectContext(ctx, &deliveries, claimQuery, claimValues...)
+	if err != nil {
+		log.Error("failed to claim deliveries", sl.Err(err))
+		return nil, errs.Internal(err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *Storage) RecordDelivery(ctx context.Context, deliveryId string, status string, attempts int32, responseCode int, errText string, nextAttemptAt time.Time) error {
+	const op = "Repo.RecordDelivery"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	var (
+		updateQuery = `
+		UPDATE webhook_delivery
+		SET status = $2,
+		    attempts = $3,
+		    response_code = NULLIF($4, 0),
+		    error = NULLIF($5, ''),
+		    next_attempt_at = $6,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1::uuid;
+`
+		updateValues = []any{
+			deliveryId, status, attempts, responseCode, errText, nextAttemptAt,
+		}
+	)
+
+	_, err := s.db.ExecContext(ctx, updateQuery, updateValues...)
+	if err != nil {
+		log.Error("failed to record delivery", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	return nil
+}
@@ -1,67 +1,158 @@
 package repo
 
 import (
+	"context"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
 )
 
 type Checkers interface {
-	CheckResponsibleToTender(
-		tenderId string,
-		username string,
-	) error
+	// CheckRole returns role of user in organization, permissions of roles are decided by policy
+	CheckRole(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+	) (string, error)
 	CheckIdByName(
+		ctx context.Context,
 		username string,
 	) (string, error)
+	// CheckKnownNames returns those of names which belong to employees or organizations, unknown names are not an error
+	CheckKnownNames(
+		ctx context.Context,
+		names []string,
+	) ([]string, error)
 	CheckCorporateById(
+		ctx context.Context,
 		userId string,
 	) (string, error)
-	CheckResponsibility(
-		username string,
-	) (string, error)
+	// CheckMemberships lists every organization user is responsible for, empty list is not an error
+	CheckMemberships(
+		ctx context.Context,
+		userId string,
+	) ([]model.MembershipDB, error)
 	CheckTender(
+		ctx context.Context,
 		tenderId string,
 	) (model.TenderDB, error)
 	CheckTenderVersion(
+		ctx context.Context,
 		tenderId string,
 		version int32,
 	) error
 	CheckBid(
+		ctx context.Context,
 		bidId string,
 	) (model.BidDB, error)
 	CheckBidVersion(
+		ctx context.Context,
 		bidId string,
 		version int32,
 	) error
-	CheckBidTenderOwner(
-		bidId string,
-		organizationId string,
-	) (string, error)
-	CheckAccessToBidByOrganizationId(
-		bidId string,
-		organizationId string,
-	) error
 	CheckBidAuthorByUsername(
+		ctx context.Context,
 		bidId string,
 		username string,
 	) error
-	CheckStatusForbiddenForBid(
-		bidId string,
-		username string,
-	) error
+	// CheckBidDecisionCount counts votes of members with given decision
 	CheckBidDecisionCount(
+		ctx context.Context,
 		bidId string,
+		decision string,
 	) (int, error)
+	CheckDecisionPolicy(
+		ctx context.Context,
+		tenderId string,
+	) (quorum.Policy, error)
+	// CheckResponsibleCount counts members having one of roles
 	CheckResponsibleCount(
+		ctx context.Context,
 		organizationId string,
+		roles []string,
 	) (int, error)
 	CheckSameSubmitter(
+		ctx context.Context,
 		bidId string,
 		username string,
 	) error
 	CheckBidAvailability(
+		ctx context.Context,
 		bidId string,
 	) error
 	CheckBidCanceled(
+		ctx context.Context,
 		bidId string,
 	) error
 }
+
+// Transactor runs fn in a single transaction, fn must use only the given Tx
+type Transactor interface {
+	InTransaction(ctx context.Context, fn func(tx Tx) error) error
+}
+
+// Tx is a storage bound to a transaction
+type Tx interface {
+	Checkers
+	// LockTender and LockBid take row lock until the transaction ends
+	LockTender(
+		ctx context.Context,
+		tenderId string,
+	) (model.TenderDB, error)
+	LockBid(
+		ctx context.Context,
+		bidId string,
+	) (model.BidDB, error)
+	SubmitDecision(
+		ctx context.Context,
+		bidId string,
+		responsibleId string,
+		decision string,
+		comment string,
+	) error
+	ApplyDecision(
+		ctx context.Context,
+		bidId string,
+		decision string,
+	) error
+	UpdateBidStatus(
+		ctx context.Context,
+		bidId string,
+		status string,
+		expectedVersion int32,
+	) (string, error)
+	ChangeTenderStatus(
+		ctx context.Context,
+		tenderId string,
+		status string,
+		expectedVersion int32,
+	) (string, error)
+	BidsForTender(
+		ctx context.Context,
+		tenderId string,
+		viewer string,
+		page model.PageQuery,
+	) ([]model.BidDB, int, error)
+	// CreateEmployee, SetCredentials and AddResponsible are one step of adding employee to organization
+	CreateEmployee(
+		ctx context.Context,
+		username string,
+		firstName string,
+		lastName string,
+	) (string, error)
+	AddResponsible(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+		role string,
+	) error
+	// UseInvite and SetCredentials are one step of registration
+	UseInvite(
+		ctx context.Context,
+		tokenHash string,
+	) (string, error)
+	SetCredentials(
+		ctx context.Context,
+		employeeId string,
+		passwordHash string,
+	) error
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	sl "zadanie-6105/internal/lib/logger/slog"
+)
+
+// content type is detected from this many first bytes, client header is not trusted
+const sniffLen = 512
+
+type AttachmentConfig struct {
+	// MaxSize is the largest file in bytes
+	MaxSize int64
+	// Types are allowed media types as detected from content
+	Types []string
+}
+
+type RepoAttachments interface {
+	// AddAttachment saves metadata and attaches file to owner as a new version of owner, the version is returned too
+	AddAttachment(
+		ctx context.Context,
+		attachment model.AttachmentDB,
+		expectedVersion int32,
+	) (model.AttachmentDB, int, error)
+	// Attachments lists files attached to version of owner, 0 means current version
+	Attachments(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		version int32,
+	) ([]model.AttachmentDB, error)
+	// Attachment finds file ever attached to owner, it may be gone from current version
+	Attachment(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		attachmentId string,
+	) (model.AttachmentDB, error)
+	// RemoveAttachment detaches file from current version of owner as a new version of owner and returns it
+	RemoveAttachment(
+		ctx context.Context,
+		ownerType string,
+		ownerId string,
+		attachmentId string,
+		expectedVersion int32,
+	) (int, error)
+}
+
+// BlobStore keeps content of attachments, implementations are in lib/blob
+type BlobStore interface {
+	Put(
+		ctx context.Context,
+		key string,
+		body io.Reader,
+		size int64,
+		contentType string,
+	) error
+	Get(
+		ctx context.Context,
+		key string,
+	) (io.ReadCloser, error)
+	Delete(
+		ctx context.Context,
+		key string,
+	) error
+}
+
+func (s *Service) Attachments(ctx context.Context, ownerType string, ownerId string, version int32) ([]model.AttachmentResponse, error) {
+	const op = "Service.Attachments"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	err := s.checkAttachmentsAccess(ctx, ownerType, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	if version != 0 {
+		switch ownerType {
+		case model.OwnerTender:
+			_, err = s.repoTenderProvider.TenderVersion(ctx, ownerId, version)
+		case model.OwnerBid:
+			_, err = s.repoBidProvider.BidVersion(ctx, ownerId, version)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attachmentsDB, err := s.repoAttachments.Attachments(ctx, ownerType, ownerId, version)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Attachments from DB", slog.Int("count", len(attachmentsDB)))
+
+	return model.ConvertAttachments(attachmentsDB), nil
+}
+
+// UploadAttachment streams file to blob store counting its checksum on the way,
+// blob is removed again if metadata is not saved. New version of owner is returned for ETag
+func (s *Service) UploadAttachment(ctx context.Context, ownerType string, ownerId string, name string, size int64, body io.Reader, expectedVersion int32) (model.AttachmentResponse, int, error) {
+	const op = "Service.UploadAttachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	caller, err := s.checkAttachmentsEdit(ctx, ownerType, ownerId)
+	if err != nil {
+		return model.AttachmentResponse{}, 0, err
+	}
+	// check status 400
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "" || name == "." || name == "/" || len(name) > 255 {
+		return model.AttachmentResponse{}, 0, errs.Validation(fmt.Errorf("invalid file name"))
+	}
+	if size <= 0 {
+		return model.AttachmentResponse{}, 0, errs.Validation(fmt.Errorf("file is empty"))
+	}
+	if size > s.attachments.MaxSize {
+		return model.AttachmentResponse{}, 0, errs.Validation(fmt.Errorf("file is larger than %d bytes", s.attachments.MaxSize))
+	}
+	content := bufio.NewReaderSize(io.LimitReader(body, size), sniffLen)
+	head, err := content.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return model.AttachmentResponse{}, 0, errs.Internal(err)
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return model.AttachmentResponse{}, 0, errs.Internal(err)
+	}
+	if !slices.Contains(s.attachments.Types, contentType) {
+		return model.AttachmentResponse{}, 0, errs.Validation(fmt.Errorf("files of type %s are not allowed", contentType))
+	}
+
+	key, err := newBlobKey(ownerType, ownerId)
+	if err != nil {
+		return model.AttachmentResponse{}, 0, errs.Internal(err)
+	}
+	hash := sha256.New()
+	err = s.blobStore.Put(ctx, key, io.TeeReader(content, hash), size, contentType)
+	if err != nil {
+		log.Error("failed to put blob", sl.Err(err))
+		return model.AttachmentResponse{}, 0, errs.Internal(err)
+	}
+
+	// check status 412
+	attachmentDB, version, err := s.repoAttachments.AddAttachment(ctx, model.AttachmentDB{
+		OwnerType:   ownerType,
+		OwnerId:     ownerId,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		BlobKey:     key,
+		UploadedBy:  caller.Id,
+	}, expectedVersion)
+	if err != nil {
+		if deleteErr := s.blobStore.Delete(context.WithoutCancel(ctx), key); deleteErr != nil {
+			log.Error("failed to delete orphaned blob", slog.String("key", key), sl.Err(deleteErr))
+		}
+		return model.AttachmentResponse{}, 0, err
+	}
+	log.Info("Attachment added", slog.String("attachmentId", attachmentDB.Id))
+
+	return model.ConvertAttachmentToResponse(attachmentDB), version, nil
+}
+
+// DownloadAttachment gives any file ever attached to owner, so attachments of old versions stay readable.
+// Caller closes content
+func (s *Service) DownloadAttachment(ctx context.Context, ownerType string, ownerId string, attachmentId string) (model.AttachmentResponse, io.ReadCloser, error) {
+	const op = "Service.DownloadAttachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	err := s.checkAttachmentsAccess(ctx, ownerType, ownerId)
+	if err != nil {
+		return model.AttachmentResponse{}, nil, err
+	}
+	// check status 404
+	attachmentDB, err := s.repoAttachments.Attachment(ctx, ownerType, ownerId, attachmentId)
+	if err != nil {
+		return model.AttachmentResponse{}, nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, attachmentDB.BlobKey)
+	if err != nil {
+		log.Error("failed to get blob", slog.String("key", attachmentDB.BlobKey), sl.Err(err))
+		return model.AttachmentResponse{}, nil, errs.Internal(err)
+	}
+
+	return model.ConvertAttachmentToResponse(attachmentDB), content, nil
+}
+
+// DeleteAttachment detaches file from owner, blob is kept because older versions still refer to it.
+// New version of owner is returned for ETag
+func (s *Service) DeleteAttachment(ctx context.Context, ownerType string, ownerId string, attachmentId string, expectedVersion int32) (int, error) {
+	const op = "Service.DeleteAttachment"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	_, err := s.checkAttachmentsEdit(ctx, ownerType, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	// check status 404, 412
+	version, err := s.repoAttachments.RemoveAttachment(ctx, ownerType, ownerId, attachmentId, expectedVersion)
+	if err != nil {
+		return 0, err
+	}
+	log.Info("Attachment removed", slog.String("attachmentId", attachmentId))
+
+	return version, nil
+}
+
+// checkAttachmentsAccess lets read attachments whoever can read history of owner
+func (s *Service) checkAttachmentsAccess(ctx context.Context, ownerType string, ownerId string) error {
+	switch ownerType {
+	case model.OwnerTender:
+		return s.checkTenderHistoryAccess(ctx, ownerId)
+	case model.OwnerBid:
+		return s.checkBidHistoryAccess(ctx, ownerId)
+	}
+	return errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+}
+
+// checkAttachmentsEdit follows EditTender and EditBid
+func (s *Service) checkAttachmentsEdit(ctx context.Context, ownerType string, ownerId string) (model.Caller, error) {
+	switch ownerType {
+	case model.OwnerTender:
+		// check status 401
+		caller, err := s.caller(ctx)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 404
+		tenderDB, err := s.checkers.CheckTender(ctx, ownerId)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 403
+		err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ManageTenders)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 403
+		if strings.EqualFold(tenderDB.Status, "closed") {
+			return model.Caller{}, errs.Forbidden(fmt.Errorf("tender is closed"))
+		}
+		return caller, nil
+	case model.OwnerBid:
+		// check status 404
+		bidDB, err := s.checkers.CheckBid(ctx, ownerId)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 401
+		caller, err := s.caller(ctx)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 403
+		err = s.checkers.CheckBidAvailability(ctx, ownerId)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		// check status 403
+		err = s.authorizeBidAuthor(ctx, bidDB, caller, policy.SubmitBids)
+		if err != nil {
+			return model.Caller{}, err
+		}
+		return caller, nil
+	}
+	return model.Caller{}, errs.Validation(fmt.Errorf("unknown owner type %s", ownerType))
+}
+
+// newBlobKey groups blobs by owner, random part keeps every upload separate
+func newBlobKey(ownerType string, ownerId string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return strings.ToLower(ownerType) + "s/" + ownerId + "/" + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"strings"
+	"testing"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+const attachmentId = "88888888-8888-4888-8888-888888888888"
+
+func TestUploadAttachment(t *testing.T) {
+	const content = "specification of works"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name    string
+		content string
+		size    int64
+		prepare func(d *deps)
+		want    errs.Kind
+	}{
+		{
+			name:    "stored with checksum",
+			content: content,
+			size:    int64(len(content)),
+			prepare: func(d *deps) {
+				d.blobs.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "tenders/"+tenderId+"/")
+				}), mock.Anything, int64(len(content)), "text/plain").
+					RunAndReturn(func(_ context.Context, _ string, body io.Reader, _ int64, _ string) error {
+						_, err := io.Copy(io.Discard, body)
+						return err
+					})
+				d.attachments.EXPECT().AddAttachment(mock.Anything, mock.MatchedBy(func(a model.AttachmentDB) bool {
+					return a.OwnerType == model.OwnerTender && a.OwnerId == tenderId && a.Name == "spec.txt" &&
+						a.ContentType == "text/plain" && a.Checksum == checksum && a.UploadedBy == userId
+				}), int32(1)).
+					RunAndReturn(func(_ context.Context, a model.AttachmentDB, _ int32) (model.AttachmentDB, int, error) {
+						a.Id = attachmentId
+						return a, 2, nil
+					})
+			},
+		},
+		{
+			name:    "too large",
+			content: strings.Repeat("a", 1025),
+			size:    1025,
+			want:    errs.KindValidation,
+		},
+		{
+			name:    "empty",
+			content: "",
+			size:    0,
+			want:    errs.KindValidation,
+		},
+		{
+			name:    "type not allowed",
+			content: "\x89PNG\r\n\x1a\n",
+			size:    8,
+			want:    errs.KindValidation,
+		},
+		{
+			name:    "blob removed when metadata is not saved",
+			content: content,
+			size:    int64(len(content)),
+			prepare: func(d *deps) {
+				var key string
+				d.blobs.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(len(content)), "text/plain").
+					RunAndReturn(func(_ context.Context, k string, body io.Reader, _ int64, _ string) error {
+						key = k
+						_, err := io.Copy(io.Discard, body)
+						return err
+					})
+				d.attachments.EXPECT().AddAttachment(mock.Anything, mock.Anything, int32(1)).
+					Return(model.AttachmentDB{}, 0, domainError(errs.KindInternal))
+				d.blobs.EXPECT().Delete(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, k string) error {
+						assert.Equal(t, key, k)
+						return nil
+					})
+			},
+			want: errs.KindInternal,
+		},
+		{
+			name:    "tender changed since If-Match",
+			content: content,
+			size:    int64(len(content)),
+			prepare: func(d *deps) {
+				d.blobs.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(len(content)), "text/plain").Return(nil)
+				d.attachments.EXPECT().AddAttachment(mock.Anything, mock.Anything, int32(1)).
+					Return(model.AttachmentDB{}, 0, domainError(errs.KindVersionMismatch))
+				d.blobs.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil)
+			},
+			want: errs.KindVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+			d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			if tt.prepare != nil {
+				tt.prepare(d)
+			}
+
+			got, version, err := svc.UploadAttachment(callerCtx(), model.OwnerTender, tenderId, "C:\\docs\\spec.txt", tt.size, strings.NewReader(tt.content), 1)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, 2, version)
+				assert.Equal(t, attachmentId, got.Id)
+				assert.Equal(t, checksum, got.Checksum)
+			}
+		})
+	}
+}
+
+func TestUploadAttachment_ClosedTender(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+
+	_, _, err := svc.UploadAttachment(callerCtx(), model.OwnerTender, tenderId, "spec.txt", 4, strings.NewReader("spec"), 0)
+	requireKind(t, err, errs.KindForbidden)
+}
+
+func TestDeleteAttachment_ForeignBid(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Created"), nil)
+	d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+
+	_, err := svc.DeleteAttachment(callerCtx(), model.OwnerBid, bidId, attachmentId, 0)
+	requireKind(t, err, errs.KindForbidden)
+}
+
+func TestAttachments_NoSuchVersion(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+	d.bidProvider.EXPECT().BidVersion(mock.Anything, bidId, int32(5)).Return(model.BidDB{}, domainError(errs.KindNotFound))
+
+	_, err := svc.Attachments(callerCtx(), model.OwnerBid, bidId, 5)
+	requireKind(t, err, errs.KindNotFound)
+}
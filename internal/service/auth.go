@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"log/slog"
+	"slices"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	"zadanie-6105/internal/lib/auth"
+	"zadanie-6105/internal/lib/jwt"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/repo"
+)
+
+type RepoAuth interface {
+	EmployeeIdByName(
+		ctx context.Context,
+		username string,
+	) (string, error)
+	Credentials(
+		ctx context.Context,
+		username string,
+	) (model.CredentialsDB, error)
+	SetCredentials(
+		ctx context.Context,
+		employeeId string,
+		passwordHash string,
+	) error
+	CreateInvite(
+		ctx context.Context,
+		employeeId string,
+		createdBy string,
+		tokenHash string,
+		ttl time.Duration,
+	) error
+	// UseInvite deletes invite and returns its employee, expired invites are 401
+	UseInvite(
+		ctx context.Context,
+		tokenHash string,
+	) (string, error)
+	CreateSession(
+		ctx context.Context,
+		employeeId string,
+		refreshTokenHash string,
+		ttl time.Duration,
+	) (string, error)
+	ActiveSession(
+		ctx context.Context,
+		sessionId string,
+	) (model.SessionDB, error)
+	RotateSession(
+		ctx context.Context,
+		refreshTokenHash string,
+		newRefreshTokenHash string,
+		ttl time.Duration,
+	) (model.SessionDB, error)
+	RevokeSession(
+		ctx context.Context,
+		sessionId string,
+	) error
+	RevokeEmployeeSessions(
+		ctx context.Context,
+		employeeId string,
+	) error
+}
+
+type TokenConfig struct {
+	Secret     []byte
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	InviteTTL  time.Duration
+}
+
+// Register sets the first password of employee, invite proves that employee is the one it was issued to
+func (s *Service) Register(ctx context.Context, inviteToken string, password string) error {
+	const op = "Service.Register"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error("failed to hash password", sl.Err(err))
+		return errs.Internal(err)
+	}
+
+	// invite is spent only if password is set
+	err = s.transactor.InTransaction(ctx, func(tx repo.Tx) error {
+		// check status 401
+		employeeId, err := tx.UseInvite(ctx, hashToken(inviteToken))
+		if err != nil {
+			return err
+		}
+		// check status 409
+		err = tx.SetCredentials(ctx, employeeId, string(hash))
+		if err != nil {
+			return err
+		}
+		log.Info("Registered employee", slog.String("id", employeeId))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InviteEmployee lets managers of organization give its members without password a way to set it
+func (s *Service) InviteEmployee(ctx context.Context, employeeId string) (model.InviteResponse, error) {
+	const op = "Service.InviteEmployee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	// check status 404
+	employeeDB, err := s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	// check status 403
+	managed, err := s.organizationsWith(ctx, caller.Id, policy.ManageMembers)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	memberships, err := s.checkers.CheckMemberships(ctx, employeeId)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	if !slices.ContainsFunc(memberships, func(m model.MembershipDB) bool {
+		return slices.Contains(managed, m.Id)
+	}) {
+		return model.InviteResponse{}, errs.Forbidden(fmt.Errorf("user does not manage members of employee organizations"))
+	}
+	// check status 409
+	err = s.checkNotRegistered(ctx, employeeDB.Username)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+
+	invite, err := s.issueInvite(ctx, employeeId, caller.Id)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	log.Info("Invited employee", slog.String("id", employeeId))
+
+	return invite, nil
+}
+
+// BootstrapInvite is run at startup for the first admin, nobody can invite by HTTP before the admin registers.
+// Employee is created if there is none
+func (s *Service) BootstrapInvite(ctx context.Context, username string) (model.InviteResponse, error) {
+	const op = "Service.BootstrapInvite"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	employeeId, err := s.repoAuth.EmployeeIdByName(ctx, username)
+	if errs.KindOf(err) == errs.KindUnauthorized {
+		employeeId, err = s.repoEmployeeEditor.CreateEmployee(ctx, username, "", "")
+	}
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	err = s.checkNotRegistered(ctx, username)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+
+	invite, err := s.issueInvite(ctx, employeeId, "")
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+	log.Info("Invited bootstrap admin", slog.String("id", employeeId))
+
+	return invite, nil
+}
+
+func (s *Service) IssueToken(ctx context.Context, username string, password string) (model.TokenResponse, error) {
+	const op = "Service.IssueToken"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	credentials, err := s.repoAuth.Credentials(ctx, username)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	err = bcrypt.CompareHashAndPassword([]byte(credentials.PasswordHash), []byte(password))
+	if err != nil {
+		log.Info("wrong password", slog.String("username", username))
+		return model.TokenResponse{}, errs.Unauthorized(fmt.Errorf("invalid username or password"))
+	}
+
+	refreshToken, refreshHash, err := newRefreshToken()
+	if err != nil {
+		log.Error("failed to generate refresh token", sl.Err(err))
+		return model.TokenResponse{}, errs.Internal(err)
+	}
+
+	sessionId, err := s.repoAuth.CreateSession(ctx, credentials.EmployeeId, refreshHash, s.tokens.RefreshTTL)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	return s.tokenResponse(model.SessionDB{
+		Id:         sessionId,
+		EmployeeId: credentials.EmployeeId,
+		Username:   credentials.Username,
+	}, refreshToken)
+}
+
+func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (model.TokenResponse, error) {
+	const op = "Service.RefreshToken"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	newToken, newHash, err := newRefreshToken()
+	if err != nil {
+		log.Error("failed to generate refresh token", sl.Err(err))
+		return model.TokenResponse{}, errs.Internal(err)
+	}
+
+	// check status 401
+	// old refresh token becomes useless right after rotation
+	session, err := s.repoAuth.RotateSession(ctx, hashToken(refreshToken), newHash, s.tokens.RefreshTTL)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	return s.tokenResponse(session, newToken)
+}
+
+func (s *Service) RevokeToken(ctx context.Context, allSessions bool) error {
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	if allSessions {
+		return s.repoAuth.RevokeEmployeeSessions(ctx, caller.Id)
+	}
+	return s.repoAuth.RevokeSession(ctx, caller.SessionId)
+}
+
+func (s *Service) Authenticate(ctx context.Context, accessToken string) (model.Caller, error) {
+	const op = "Service.Authenticate"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	claims, err := jwt.Parse(accessToken, s.tokens.Secret, time.Now())
+	if err != nil {
+		log.Info("invalid access token", sl.Err(err))
+		if errors.Is(err, jwt.ErrExpired) {
+			return model.Caller{}, errs.Unauthorized(fmt.Errorf("access token is expired"))
+		}
+		return model.Caller{}, errs.Unauthorized(fmt.Errorf("access token is invalid"))
+	}
+
+	// check status 401
+	// revoked session makes its access tokens useless before they expire
+	session, err := s.repoAuth.ActiveSession(ctx, claims.SessionId)
+	if err != nil {
+		return model.Caller{}, err
+	}
+
+	return model.Caller{
+		Id:        session.EmployeeId,
+		Username:  session.Username,
+		SessionId: session.Id,
+	}, nil
+}
+
+// caller returns authenticated employee or 401
+func (s *Service) caller(ctx context.Context) (model.Caller, error) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return model.Caller{}, errs.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+	return caller, nil
+}
+
+func (s *Service) tokenResponse(session model.SessionDB, refreshToken string) (model.TokenResponse, error) {
+	now := time.Now()
+
+	accessToken, err := jwt.Sign(jwt.Claims{
+		Subject:   session.EmployeeId,
+		Username:  session.Username,
+		SessionId: session.Id,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.tokens.AccessTTL).Unix(),
+	}, s.tokens.Secret)
+	if err != nil {
+		return model.TokenResponse{}, errs.Internal(err)
+	}
+
+	return model.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.tokens.AccessTTL.Seconds()),
+	}, nil
+}
+
+// checkNotRegistered returns 409 if employee already has password
+func (s *Service) checkNotRegistered(ctx context.Context, username string) error {
+	_, err := s.repoAuth.Credentials(ctx, username)
+	if err == nil {
+		return errs.Conflict(fmt.Errorf("user is already registered"))
+	}
+	// no credentials is 401 for login
+	if errs.KindOf(err) != errs.KindUnauthorized {
+		return err
+	}
+	return nil
+}
+
+// issueInvite keeps only hash of token, like refresh tokens
+func (s *Service) issueInvite(ctx context.Context, employeeId string, createdBy string) (model.InviteResponse, error) {
+	token, hash, err := newRefreshToken()
+	if err != nil {
+		return model.InviteResponse{}, errs.Internal(err)
+	}
+	err = s.repoAuth.CreateInvite(ctx, employeeId, createdBy, hash, s.tokens.InviteTTL)
+	if err != nil {
+		return model.InviteResponse{}, err
+	}
+
+	return model.InviteResponse{
+		EmployeeId:  employeeId,
+		InviteToken: token,
+		ExpiresIn:   int64(s.tokens.InviteTTL.Seconds()),
+	}, nil
+}
+
+// newRefreshToken returns random token for client and its hash for DB
+func newRefreshToken() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+const inviteToken = "invite-token"
+
+func hashOf(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "invite is used",
+			setup: func(d *deps) {
+				d.tx.EXPECT().UseInvite(mock.Anything, hashOf(inviteToken)).Return(otherUserId, nil)
+				d.tx.EXPECT().SetCredentials(mock.Anything, otherUserId, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "invalid or expired invite",
+			setup: func(d *deps) {
+				d.tx.EXPECT().UseInvite(mock.Anything, hashOf(inviteToken)).Return("", domainError(errs.KindUnauthorized))
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "already registered",
+			setup: func(d *deps) {
+				d.tx.EXPECT().UseInvite(mock.Anything, hashOf(inviteToken)).Return(otherUserId, nil)
+				d.tx.EXPECT().SetCredentials(mock.Anything, otherUserId, mock.Anything).Return(domainError(errs.KindConflict))
+			},
+			want: errs.KindConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			err := svc.Register(context.Background(), inviteToken, "password")
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestInviteEmployee(t *testing.T) {
+	employee := model.EmployeeDB{Id: otherUserId, Username: "newbie"}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "employee not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(model.EmployeeDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "employee of organization caller does not manage",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(employee, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{
+					membership(organizationId, "owner"),
+					membership(otherOrganizationId, "procurement_manager"),
+				}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, otherUserId).Return([]model.MembershipDB{
+					membership(otherOrganizationId, "viewer"),
+				}, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "already registered",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(employee, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "owner")}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, otherUserId).Return([]model.MembershipDB{membership(organizationId, "viewer")}, nil)
+				d.auth.EXPECT().Credentials(mock.Anything, "newbie").Return(model.CredentialsDB{EmployeeId: otherUserId}, nil)
+			},
+			want: errs.KindConflict,
+		},
+		{
+			name: "invited",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(employee, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "owner")}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, otherUserId).Return([]model.MembershipDB{membership(organizationId, "viewer")}, nil)
+				d.auth.EXPECT().Credentials(mock.Anything, "newbie").Return(model.CredentialsDB{}, domainError(errs.KindUnauthorized))
+				d.auth.EXPECT().CreateInvite(mock.Anything, otherUserId, userId, mock.Anything, time.Hour).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.InviteEmployee(tt.ctx, otherUserId)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.NotEmpty(t, got.InviteToken)
+				d.auth.AssertCalled(t, "CreateInvite", mock.Anything, otherUserId, userId, hashOf(got.InviteToken), time.Hour)
+			}
+		})
+	}
+}
+
+func TestBootstrapInvite_CreatesEmployee(t *testing.T) {
+	svc, d := newService(t)
+
+	d.auth.EXPECT().EmployeeIdByName(mock.Anything, "admin").Return("", domainError(errs.KindUnauthorized))
+	d.employeeEditor.EXPECT().CreateEmployee(mock.Anything, "admin", "", "").Return(otherUserId, nil)
+	d.auth.EXPECT().Credentials(mock.Anything, "admin").Return(model.CredentialsDB{}, domainError(errs.KindUnauthorized))
+	d.auth.EXPECT().CreateInvite(mock.Anything, otherUserId, "", mock.Anything, time.Hour).Return(nil)
+
+	got, err := svc.BootstrapInvite(context.Background(), "admin")
+	requireKind(t, err, "")
+	assert.Equal(t, otherUserId, got.EmployeeId)
+}
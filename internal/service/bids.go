@@ -1,77 +1,148 @@
 package service
 
 import (
+	"context"
 	"fmt"
-	"github.com/labstack/echo/v4"
 	"log/slog"
-	"net/http"
+	"slices"
 	"strings"
+	"time"
+	"zadanie-6105/internal/domain/errs"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	"zadanie-6105/internal/domain/quorum"
 	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/repo"
 )
 
 type RepoBidProvider interface {
+	// GetBidsById lists bids of any of authors as one list
 	GetBidsById(
-		limit int32,
-		offset int32,
-		authorId string,
-	) ([]model.BidDB, error)
+		ctx context.Context,
+		page model.PageQuery,
+		authorIds []string,
+	) ([]model.BidDB, int, error)
+	// BidsForTender shows not published bids only to their authors, empty viewer sees all
 	BidsForTender(
+		ctx context.Context,
 		tenderId string,
-		limit int32,
-		offset int32,
-	) ([]model.BidDB, error)
+		viewer string,
+		page model.PageQuery,
+	) ([]model.BidDB, int, error)
 	BidStatus(
+		ctx context.Context,
 		bidId string,
 	) (string, error)
+	BidVersions(
+		ctx context.Context,
+		bidId string,
+	) ([]model.BidDB, error)
+	BidVersion(
+		ctx context.Context,
+		bidId string,
+		version int32,
+	) (model.BidDB, error)
+	// SearchBids finds bids of authorIds and not created bids on tenders of organizationIds
+	SearchBids(
+		ctx context.Context,
+		filter model.SearchFilter,
+		authorIds []string,
+		organizationIds []string,
+		page model.PageQuery,
+	) ([]model.BidSearchDB, int, error)
 }
 type RepoBidCreator interface {
 	CreateBid(
+		ctx context.Context,
 		name string,
 		description string,
 		tenderId string,
 		authorType string,
 		authorId string,
+		offer *model.Offer,
 	) (string, error)
 }
 type RepoBidEditor interface {
 	UpdateBidStatus(
+		ctx context.Context,
 		bidId string,
 		status string,
+		expectedVersion int32,
 	) (string, error)
 	EditBid(
+		ctx context.Context,
 		bidId string,
 		name string,
 		description string,
+		offer *model.Offer,
+		expectedVersion int32,
 	) (string, error)
 	RollbackBid(
+		ctx context.Context,
 		bidId string,
 		version int32,
+		expectedVersion int32,
 	) (string, error)
 }
 type RepoBidDecisionMaker interface {
 	SubmitDecision(
+		ctx context.Context,
 		bidId string,
 		responsibleId string,
+		decision string,
+		comment string,
 	) error
 	ApplyDecision(
+		ctx context.Context,
 		bidId string,
 		decision string,
 	) error
+	// Decisions lists votes on bid in order they were submitted
+	Decisions(
+		ctx context.Context,
+		bidId string,
+	) ([]model.DecisionDB, error)
 }
 type RepoBidFeedbacker interface {
-	Feedback(
+	// PostMessage adds message to thread of bid, messages of organization are reviews as well
+	PostMessage(
+		ctx context.Context,
+		bidId string,
+		authorId string,
+		side string,
+		text string,
+		mentions []string,
+		ratings map[string]int,
+	) (model.ThreadMessageDB, error)
+	// Thread lists messages of bid thread, unread is computed for viewer
+	Thread(
+		ctx context.Context,
 		bidId string,
-		feedback string,
+		viewerId string,
+		page model.PageQuery,
+	) ([]model.ThreadMessageDB, int, error)
+	MarkThreadRead(
+		ctx context.Context,
+		bidId string,
+		employeeId string,
 	) error
+	// Reviews lists messages of organization on bids of author within scope,
+	// only BidId and creation time are taken from filter
 	Reviews(
-		authorUsername string,
-		limit int32,
-		offset int32,
-	) ([]model.Feedback, error)
+		ctx context.Context,
+		scope model.ReviewScope,
+		filter model.ReviewFilter,
+		page model.PageQuery,
+	) ([]model.Feedback, int, error)
+	// Reputation counts decided bids and reviews of author with sums of scores by criteria
+	Reputation(
+		ctx context.Context,
+		authorType string,
+		authorId string,
+	) (model.ReputationDB, error)
 }
 
-func (s *Service) CreateBid(name string, description string, tenderId string, authorType string, authorId string) (model.BidResponse, error) {
+func (s *Service) CreateBid(ctx context.Context, name string, description string, tenderId string, authorType string, authorId string, offer *model.Offer) (model.BidResponse, error) {
 	const op = "Service.CreateBid"
 	log := s.log.With(
 		slog.String("op", op),
@@ -85,95 +156,127 @@ func (s *Service) CreateBid(name string, description string, tenderId string, au
 		orgranizationId string
 	)
 	// check status 401
-	username, err := s.checkers.CheckCorporateById(authorId)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 404
-	_, err = s.checkers.CheckTender(tenderId)
+	tender, err := s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 403
-	status, err = s.repoTenderProvider.Status(tenderId)
+	status, err = s.repoTenderProvider.Status(ctx, tenderId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	if strings.EqualFold(status, "Closed") || strings.EqualFold(status, "Created") {
-		return model.BidResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("tender is not available"))
+		return model.BidResponse{}, errs.Forbidden(fmt.Errorf("tender is not available"))
+	}
+	if tender.SubmissionDeadline != nil && time.Now().After(*tender.SubmissionDeadline) {
+		return model.BidResponse{}, errs.Forbidden(fmt.Errorf("bid submission deadline has passed"))
 	}
 	// если тип автора указан как организация,
 	// то автором будет не ОТВТЕТСВЕННЫЙ за организацию,
 	// а сама ОРГАНИЗАЦИЯ
 	if strings.EqualFold(authorType, "Organization") {
-		orgId, _ := s.checkers.CheckIdByName(username)
-		relatedOrganizationId, _ := s.checkers.CheckResponsibility(username)
-		if relatedOrganizationId != "" {
-			orgranizationId = relatedOrganizationId
+		// check status 403
+		// authorId may be omitted only when caller can submit bids for a single organization
+		orgranizationId = authorId
+		if orgranizationId == "" || orgranizationId == caller.Id {
+			orgranizationId, err = s.soleOrganizationWith(ctx, caller.Id, policy.SubmitBids)
 		} else {
-			orgranizationId = orgId
+			err = s.authorize(ctx, orgranizationId, caller.Id, policy.SubmitBids)
+		}
+		if err != nil {
+			return model.BidResponse{}, err
 		}
-		bidId, err = s.repoBidCreator.CreateBid(name, description, tenderId, authorType, orgranizationId)
+		bidId, err = s.repoBidCreator.CreateBid(ctx, name, description, tenderId, authorType, orgranizationId, offer)
 		if err != nil {
 			return model.BidResponse{}, err
 		}
 	} else {
-		bidId, err = s.repoBidCreator.CreateBid(name, description, tenderId, authorType, authorId)
+		// check status 403
+		if authorId != "" && authorId != caller.Id {
+			return model.BidResponse{}, errs.Forbidden(fmt.Errorf("user cannot create bid on behalf of another user"))
+		}
+		bidId, err = s.repoBidCreator.CreateBid(ctx, name, description, tenderId, authorType, caller.Id, offer)
 		if err != nil {
 			return model.BidResponse{}, err
 		}
 	}
 
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	log.Info("Created Bid from DB:", BidDB)
+	log.Info("Created Bid from DB", slog.Any("bidDB", BidDB))
 
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
-func (s *Service) GetBidsByUser(limit int32, offset int32, username string) ([]model.BidResponse, error) {
+
+// GetBidsByUser lists personal bids of caller, bids of organization caller is responsible for or both
+func (s *Service) GetBidsByUser(ctx context.Context, page model.PageQuery, authors string) (model.Page[model.BidResponse], error) {
 	const op = "Service.GetBidsById"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 	var (
-		BidsDB         []model.BidDB
-		BidsResponse   []model.BidResponse
-		err            error
-		organizationId string
+		BidsDB       []model.BidDB
+		BidsResponse []model.BidResponse
+		total        int
+		err          error
+		authorIds    []string
 	)
 	//check status 401
-	userId, err := s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
-		return nil, err
+		return model.Page[model.BidResponse]{}, err
 	}
-	BidsDB, err = s.repoBidProvider.GetBidsById(limit, offset, userId)
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
 	if err != nil {
-		return nil, err
+		return model.Page[model.BidResponse]{}, err
+	}
+
+	if authors != model.BidsOrganization {
+		authorIds = append(authorIds, caller.Id)
 	}
-	// если пользователь -- ответственный за оргу, то добавятся ещё биды от организации
-	organizationId, err = s.checkers.CheckResponsibility(username)
-	log.Debug("org id", organizationId)
-	if organizationId != "" {
-		bidsByOrganization, err := s.repoBidProvider.GetBidsById(limit, offset, organizationId)
-		BidsDB = append(BidsDB, bidsByOrganization...)
+	if authors != model.BidsPersonal {
+		// если пользователь -- ответственный за организации, то добавятся ещё биды от каждой из них
+		organizationIds, err := s.organizationsWith(ctx, caller.Id, policy.ViewBids)
 		if err != nil {
-			return nil, err
+			return model.Page[model.BidResponse]{}, err
 		}
+		log.Debug("org ids", slog.Any("organizationIds", organizationIds))
+		// check status 403
+		if authors == model.BidsOrganization && len(organizationIds) == 0 {
+			return model.Page[model.BidResponse]{}, errs.Forbidden(fmt.Errorf("user does not responsible for any organization"))
+		}
+		authorIds = append(authorIds, organizationIds...)
 	}
-	log.Info("Bids from DB:", BidsDB)
+
+	BidsDB, total, err = s.repoBidProvider.GetBidsById(ctx, page, authorIds)
+	if err != nil {
+		return model.Page[model.BidResponse]{}, err
+	}
+	log.Info("Bids from DB", slog.Any("bidsDB", BidsDB))
+	BidsDB, nextCursor := nextPage(BidsDB, page)
 
 	BidsResponse = model.ConvertBids(BidsDB)
-	log.Info("Converted Bids to response:", BidsResponse)
+	log.Info("Converted Bids to response", slog.Any("bidsResponse", BidsResponse))
 
-	return BidsResponse, nil
+	return model.Page[model.BidResponse]{
+		Items:      BidsResponse,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
 }
 
-func (s *Service) BidsForTender(tenderId string, limit int32, offset int32, username string) ([]model.BidResponse, error) {
+func (s *Service) BidsForTender(ctx context.Context, tenderId string, page model.PageQuery) (model.Page[model.BidResponse], error) {
 	const op = "Service.BidsForTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -182,51 +285,53 @@ func (s *Service) BidsForTender(tenderId string, limit int32, offset int32, user
 		BidsDB       []model.BidDB
 		BidsResponse []model.BidResponse
 		Tender       model.TenderDB
+		total        int
 		err          error
 	)
 	//check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
-		return nil, err
+		return model.Page[model.BidResponse]{}, err
 	}
+	username := caller.Username
 	// check status 404
-	Tender, err = s.checkers.CheckTender(tenderId)
+	Tender, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
-		return nil, err
+		return model.Page[model.BidResponse]{}, err
 	}
 
 	//check status 403
 	if !strings.EqualFold(Tender.Status, "Published") {
-		err = s.checkers.CheckResponsibleToTender(tenderId, username)
+		err = s.authorize(ctx, Tender.OrganizationId, caller.Id, policy.ViewTenders)
 		if err != nil {
-			return nil, echo.NewHTTPError(http.StatusForbidden, "you have no access to Tender -- it is not published")
+			return model.Page[model.BidResponse]{}, errs.Forbidden(fmt.Errorf("you have no access to Tender -- it is not published"))
 		}
 	}
-
-	BidsDB, err = s.repoBidProvider.BidsForTender(tenderId, limit, offset)
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
 	if err != nil {
-		return nil, err
+		return model.Page[model.BidResponse]{}, err
 	}
-	log.Info("Bids from DB:", BidsDB)
 
-	//extract unavailable bid from response
-	var exportBidsDB = make([]model.BidDB, 0, len(BidsDB))
-	for _, bid := range BidsDB {
-		status := bid.Status
-		err = s.checkers.CheckBidAuthorByUsername(bid.Id, username)
-		// автор может увидеть только своё, а ответственный за оргу только опубликованные и отмененные
-		if !strings.EqualFold(status, "Created") || err == nil {
-			exportBidsDB = append(exportBidsDB, bid)
-		}
+	// автор может увидеть только своё, а ответственный за оргу только опубликованные и отмененные
+	BidsDB, total, err = s.repoBidProvider.BidsForTender(ctx, tenderId, username, page)
+	if err != nil {
+		return model.Page[model.BidResponse]{}, err
 	}
+	log.Info("Bids from DB", slog.Any("bidsDB", BidsDB))
+	BidsDB, nextCursor := nextPage(BidsDB, page)
 
-	BidsResponse = model.ConvertBids(exportBidsDB)
-	log.Info("Converted Bids to response:", BidsResponse)
+	BidsResponse = model.ConvertBids(BidsDB)
+	log.Info("Converted Bids to response", slog.Any("bidsResponse", BidsResponse))
 
-	return BidsResponse, nil
+	return model.Page[model.BidResponse]{
+		Items:      BidsResponse,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
 }
 
-func (s *Service) BidStatus(bidId string, username string) (string, error) {
+func (s *Service) BidStatus(ctx context.Context, bidId string) (string, error) {
 	const op = "Service.BidStatus"
 	log := s.log.With(
 		slog.String("op", op),
@@ -236,25 +341,25 @@ func (s *Service) BidStatus(bidId string, username string) (string, error) {
 		err    error
 	)
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	bid, err := s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return "", err
 	}
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	//check status 403
-	status, err = s.repoBidProvider.BidStatus(bidId)
+	status, err = s.repoBidProvider.BidStatus(ctx, bidId)
 	if err != nil {
 		return "nil", err
 	}
-	log.Info("Status for bid from DB:", status)
+	log.Info("Status for bid from DB", slog.Any("status", status))
 
 	if !strings.EqualFold(status, "Published") {
-		err = s.checkers.CheckBidAuthorByUsername(bidId, username)
+		err = s.authorizeBidAuthor(ctx, bid, caller, policy.ViewBids)
 		if err != nil {
 			return "", err
 		}
@@ -263,7 +368,7 @@ func (s *Service) BidStatus(bidId string, username string) (string, error) {
 	return status, nil
 }
 
-func (s *Service) UpdateBidStatus(bidId string, status string, username string) (model.BidResponse, error) {
+func (s *Service) UpdateBidStatus(ctx context.Context, bidId string, status string, expectedVersion int32) (model.BidResponse, error) {
 	const op = "Service.UpdateBidStatus"
 	log := s.log.With(
 		slog.String("op", op),
@@ -274,46 +379,46 @@ func (s *Service) UpdateBidStatus(bidId string, status string, username string)
 		err         error
 	)
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 403
 	// если бид отменен или по нему принято решение, статус нельзя изменить
-	err = s.checkers.CheckBidAvailability(bidId)
+	err = s.checkers.CheckBidAvailability(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	//check status 403
 	//author and responsilbe
-	err = s.checkers.CheckStatusForbiddenForBid(bidId, username)
+	err = s.authorizeBidAuthor(ctx, BidDB, caller, policy.SubmitBids)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	_, err = s.repoBidEditor.UpdateBidStatus(bidId, status)
+	_, err = s.repoBidEditor.UpdateBidStatus(ctx, bidId, status, expectedVersion)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	log.Info("Created Bid from DB:", BidDB)
+	log.Info("Created Bid from DB", slog.Any("bidDB", BidDB))
 
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
 
-func (s *Service) EditBid(bidId string, username string, name string, description string) (model.BidResponse, error) {
+func (s *Service) EditBid(ctx context.Context, bidId string, name string, description string, offer *model.Offer, expectedVersion int32) (model.BidResponse, error) {
 	const op = "Service.EditBid"
 	log := s.log.With(
 		slog.String("op", op),
@@ -325,46 +430,46 @@ func (s *Service) EditBid(bidId string, username string, name string, descriptio
 	)
 
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 403
 	// decision taken or bid canceled
-	err = s.checkers.CheckBidAvailability(bidId)
+	err = s.checkers.CheckBidAvailability(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	//check status 403
 	//only author or responsible can edit
-	err = s.checkers.CheckStatusForbiddenForBid(bidId, username)
+	err = s.authorizeBidAuthor(ctx, BidDB, caller, policy.SubmitBids)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	_, err = s.repoBidEditor.EditBid(bidId, name, description)
+	_, err = s.repoBidEditor.EditBid(ctx, bidId, name, description, offer, expectedVersion)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	log.Info("Created Bid from DB:", BidDB)
+	log.Info("Created Bid from DB", slog.Any("bidDB", BidDB))
 
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
 
-func (s *Service) SubmitDecision(bidId string, decision string, username string) (model.BidResponse, error) {
+func (s *Service) SubmitDecision(ctx context.Context, bidId string, decision string, comment string, actingOrganizationId string, expectedVersion int32) (model.BidResponse, error) {
 	const op = "Service.SubmitDecision"
 	log := s.log.With(
 		slog.String("op", op),
@@ -373,193 +478,207 @@ func (s *Service) SubmitDecision(bidId string, decision string, username string)
 		BidDB           model.BidDB
 		BidResponse     model.BidResponse
 		err             error
-		decisionCount   int
-		kworum          int
 		organizationId  string
 		relatedTenderId string
-		responsibleId   string
-		otherBids       []model.BidDB
 	)
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		log.Error("failed to check bid", sl.Err(err))
 		return model.BidResponse{}, err
 	}
 
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	// check status 403
-	//if bid just created, organization cannot submit decision
-	status, err := s.repoBidProvider.BidStatus(bidId)
+	username := caller.Username
+	//check status 403
+	//only members of tender organization whose role allows it can vote
+	relatedTender, err := s.checkers.CheckTender(ctx, BidDB.TenderId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	if strings.EqualFold(status, "Created") {
-		return model.BidResponse{}, echo.NewHTTPError(http.StatusForbidden, "organization have no access to just created bids")
-	}
-	// decision taken or bid canceled
-	err = s.checkers.CheckBidAvailability(bidId)
+	err = checkActingOrganization(relatedTender, actingOrganizationId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	//check status 403
-	//check organization ownership to tender
-	organizationId, err = s.checkers.CheckResponsibility(username)
+	organizationId, relatedTenderId = relatedTender.OrganizationId, relatedTender.Id
+	err = s.authorize(ctx, organizationId, caller.Id, policy.DecideBids)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	relatedTenderId, err = s.checkers.CheckBidTenderOwner(bidId, organizationId)
+	decisionPolicy, err := s.checkers.CheckDecisionPolicy(ctx, relatedTenderId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	err = s.checkers.CheckSameSubmitter(bidId, username)
-	if err != nil {
-		return model.BidResponse{}, err
+	if !decisionPolicy.CanVote(caller.Id) {
+		return model.BidResponse{}, errs.Forbidden(fmt.Errorf("user is not an approver of tender"))
 	}
 
-	// if decision = reject, apply decision without submitting
-	if strings.EqualFold(decision, "Rejected") {
-		err = s.repoBidDecisionMaker.ApplyDecision(bidId, decision)
+	// voting, quorum and closing of the tender are one unit of work,
+	// tender is locked first so concurrent decisions on its bids queue up
+	err = s.transactor.InTransaction(ctx, func(tx repo.Tx) error {
+		_, err := tx.LockTender(ctx, relatedTenderId)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
-		_, err = s.repoBidEditor.UpdateBidStatus(bidId, "Canceled")
+		lockedBid, err := tx.LockBid(ctx, bidId)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
-	} else {
-		responsibleId, err = s.checkers.CheckIdByName(username)
-		if err != nil {
-			return model.BidResponse{}, err
+		// check status 412
+		if expectedVersion != 0 && int32(lockedBid.Version) != expectedVersion {
+			return errs.VersionMismatch(fmt.Errorf("bid version mismatch"))
+		}
+		// check status 403
+		//if bid just created, organization cannot submit decision
+		if strings.EqualFold(lockedBid.Status, "Created") {
+			return errs.Forbidden(fmt.Errorf("organization have no access to just created bids"))
 		}
-		err = s.repoBidDecisionMaker.SubmitDecision(bidId, responsibleId)
+		// decision taken or bid canceled
+		err = tx.CheckBidAvailability(ctx, bidId)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
-		log.Info("Bid from DB:", BidDB)
-
-		decisionCount, err = s.checkers.CheckBidDecisionCount(bidId)
+		err = tx.CheckSameSubmitter(ctx, bidId, username)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
 
-		organizationId, err = s.checkers.CheckResponsibility(username)
+		// rejections count toward policy as well as approvals
+		err = tx.SubmitDecision(ctx, bidId, caller.Id, decision, comment)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
-		kworum, err = s.checkers.CheckResponsibleCount(organizationId)
+		bidTally, err := tally(ctx, tx, bidId, organizationId, decisionPolicy)
 		if err != nil {
-			return model.BidResponse{}, err
+			return err
 		}
-		kworum = min(3, kworum)
-		if decisionCount >= kworum {
-			err = s.repoBidDecisionMaker.ApplyDecision(bidId, decision)
-			if err != nil {
-				return model.BidResponse{}, err
-			}
-			_, err = s.repoTenderEditor.ChangeTenderStatus(relatedTenderId, "Closed")
+		switch bidTally.Outcome {
+		case "":
+			return nil
+		case quorum.Rejected:
+			err = tx.ApplyDecision(ctx, bidId, quorum.Rejected)
 			if err != nil {
-				return model.BidResponse{}, err
+				return err
 			}
+			_, err = tx.UpdateBidStatus(ctx, bidId, "Canceled", 0)
+			return err
+		}
 
-			otherBids, err = s.repoBidProvider.BidsForTender(relatedTenderId, 0, 0)
-			if err != nil {
-				return model.BidResponse{}, err
-			}
-			for _, bid := range otherBids {
-				if bid.Id != bidId {
-					err = s.repoBidDecisionMaker.ApplyDecision(bid.Id, "Rejected")
-					if err != nil {
-						return model.BidResponse{}, err
-					}
-					_, err = s.repoBidEditor.UpdateBidStatus(bid.Id, "Canceled")
-					if err != nil {
-						return model.BidResponse{}, err
-					}
-				}
-			}
+		err = tx.ApplyDecision(ctx, bidId, quorum.Approved)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ChangeTenderStatus(ctx, relatedTenderId, "Closed", 0)
+		if err != nil {
+			return err
 		}
+		otherBids, _, err := tx.BidsForTender(ctx, relatedTenderId, "", model.PageQuery{})
+		if err != nil {
+			return err
+		}
+		otherBids = slices.DeleteFunc(otherBids, func(bid model.BidDB) bool {
+			return bid.Id == bidId
+		})
+		return cancelBids(ctx, tx, otherBids)
+	})
+	if err != nil {
+		return model.BidResponse{}, err
 	}
 
 	//Get bid
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
 
-func (s *Service) Feedback(bidId string, feedback string, username string) (model.BidResponse, error) {
+func (s *Service) Feedback(ctx context.Context, bidId string, feedback string, ratings map[string]int, actingOrganizationId string) (model.BidResponse, error) {
 	const op = "Service.Feedback"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 	var (
-		BidDB          model.BidDB
-		BidResponse    model.BidResponse
-		err            error
-		organizationId string
+		BidDB       model.BidDB
+		BidResponse model.BidResponse
+		err         error
 	)
 
+	// check status 400
+	err = s.checkRatings(ratings)
+	if err != nil {
+		return model.BidResponse{}, err
+	}
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
+	username := caller.Username
 	//check status 403
 	//if bid just created, organization cannot submit decision
-	status, err := s.repoBidProvider.BidStatus(bidId)
+	status, err := s.repoBidProvider.BidStatus(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	if strings.EqualFold(status, "Created") {
-		return model.BidResponse{}, echo.NewHTTPError(http.StatusForbidden, "organization have no access to just created bids")
+		return model.BidResponse{}, errs.Forbidden(fmt.Errorf("organization have no access to just created bids"))
 	}
 	//author cannot leave feedback on his own bid
-	errNotAuthor := s.checkers.CheckBidAuthorByUsername(bidId, username)
+	errNotAuthor := s.checkers.CheckBidAuthorByUsername(ctx, bidId, username)
 	if errNotAuthor == nil {
-		return model.BidResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("author cannot leave feedback on his own bid"))
+		return model.BidResponse{}, errs.Forbidden(fmt.Errorf("author cannot leave feedback on his own bid"))
+	}
+	//only members of tender organization whose role allows it can leave feedback
+	relatedTender, err := s.checkers.CheckTender(ctx, BidDB.TenderId)
+	if err != nil {
+		return model.BidResponse{}, err
+	}
+	err = checkActingOrganization(relatedTender, actingOrganizationId)
+	if err != nil {
+		return model.BidResponse{}, err
 	}
-	//check organization ownership to tender
-	organizationId, err = s.checkers.CheckResponsibility(username)
+	err = s.authorize(ctx, relatedTender.OrganizationId, caller.Id, policy.FeedbackBids)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	_, err = s.checkers.CheckBidTenderOwner(bidId, organizationId)
+
+	mentions, err := s.mentions(ctx, feedback)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	err = s.repoBidFeedbacker.Feedback(bidId, feedback)
+	// feedback is the message of organization in thread of the bid
+	_, err = s.repoBidFeedbacker.PostMessage(ctx, bidId, caller.Id, model.SideOrganization, feedback, mentions, ratings)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	log.Info("Bid from DB:", BidDB)
+	log.Info("Bid from DB", slog.Any("bidDB", BidDB))
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
 
-func (s *Service) RollbackBid(bidId string, version int32, username string) (model.BidResponse, error) {
+func (s *Service) RollbackBid(ctx context.Context, bidId string, version int32, expectedVersion int32) (model.BidResponse, error) {
 	const op = "Service.RollbackBid"
 	log := s.log.With(
 		slog.String("op", op),
@@ -571,99 +690,147 @@ func (s *Service) RollbackBid(bidId string, version int32, username string) (mod
 	)
 
 	//check status 404
-	_, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		log.Debug("bid not found", sl.Err(err))
 		return model.BidResponse{}, err
 	}
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		log.Debug("user not found", sl.Err(err))
 		return model.BidResponse{}, err
 	}
 	// check status 403
 	// decision taken or bid canceled
-	err = s.checkers.CheckBidAvailability(bidId)
+	err = s.checkers.CheckBidAvailability(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	// check status 404
-	err = s.checkers.CheckBidVersion(bidId, version)
+	err = s.checkers.CheckBidVersion(ctx, bidId, version)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 	//check status 403
-	err = s.checkers.CheckStatusForbiddenForBid(bidId, username)
+	err = s.authorizeBidAuthor(ctx, BidDB, caller, policy.SubmitBids)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
-	_, err = s.repoBidEditor.RollbackBid(bidId, version)
+	_, err = s.repoBidEditor.RollbackBid(ctx, bidId, version, expectedVersion)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
-	log.Info("Bid from DB:", BidDB)
+	log.Info("Bid from DB", slog.Any("bidDB", BidDB))
 
-	BidDB, err = s.checkers.CheckBid(bidId)
+	BidDB, err = s.checkers.CheckBid(ctx, bidId)
 	if err != nil {
 		return model.BidResponse{}, err
 	}
 
 	BidResponse = model.ConvertBidToResponse(BidDB)
-	log.Info("Converted Bid to response:", BidResponse)
+	log.Info("Converted Bid to response", slog.Any("bidResponse", BidResponse))
 
 	return BidResponse, nil
 }
 
-func (s *Service) Reviews(tenderId string, authorUsername string, requesterUsername string, limit int32, offset int32) ([]model.Feedback, error) {
+func (s *Service) Reviews(ctx context.Context, tenderId string, filter model.ReviewFilter, page model.PageQuery) (model.Page[model.Feedback], error) {
 	const op = "Service.Reviews"
 	log := s.log.With(
 		slog.String("op", op),
 	)
 	var (
-		Feedbacks  []model.Feedback
-		err        error
-		BidsByUser []model.BidDB
-		authorId   string
+		Feedbacks []model.Feedback
+		total     int
+		err       error
+	)
+
+	// check status 400
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return model.Page[model.Feedback]{}, errs.Validation(fmt.Errorf("createdFrom must be before createdTo"))
+	}
+	scope, err := s.reviewScope(ctx, tenderId, filter)
+	if err != nil {
+		return model.Page[model.Feedback]{}, err
+	}
+
+	// check status 400
+	page, err = pageQuery(page, model.SortCreatedAt)
+	if err != nil {
+		return model.Page[model.Feedback]{}, err
+	}
+
+	Feedbacks, total, err = s.repoBidFeedbacker.Reviews(ctx, scope, filter, page)
+	if err != nil {
+		return model.Page[model.Feedback]{}, err
+	}
+	if total == 0 {
+		return model.Page[model.Feedback]{}, errs.NotFound(fmt.Errorf("no feedbacks for bids by this author"))
+	}
+	log.Info("Feedbacks from DB", slog.Any("feedbacks", Feedbacks))
+	Feedbacks, nextCursor := nextPage(Feedbacks, page)
+
+	return model.Page[model.Feedback]{
+		Items:      Feedbacks,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+// reviewScope lets responsible of tender organization see reviews of author who bid on the tender,
+// or on any tender of organization when filter asks for all tenders
+func (s *Service) reviewScope(ctx context.Context, tenderId string, filter model.ReviewFilter) (model.ReviewScope, error) {
+	const op = "Service.reviewScope"
+	log := s.log.With(
+		slog.String("op", op),
 	)
 
 	//check status 404
-	_, err = s.checkers.CheckTender(tenderId)
+	tenderDB, err := s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		log.Debug("bid not found", sl.Err(err))
-		return nil, err
+		return model.ReviewScope{}, err
 	}
-	// check status 401
-	authorId, err = s.checkers.CheckIdByName(authorUsername)
+	authorId, err := s.reviewedAuthor(ctx, filter)
 	if err != nil {
 		log.Debug("author not found", sl.Err(err))
-		return nil, err
+		return model.ReviewScope{}, err
 	}
-	log.Debug("authorId", authorId)
+	log.Debug("authorId", slog.Any("authorId", authorId))
 	// check status 401
-	_, err = s.checkers.CheckIdByName(requesterUsername)
+	caller, err := s.caller(ctx)
 	if err != nil {
-		log.Debug("requester not found", sl.Err(err))
-		return nil, err
+		log.Debug("requester not authenticated", sl.Err(err))
+		return model.ReviewScope{}, err
 	}
 	// check status 403
 	// автор не может посмотреть отзывы на свои предложения.
 	// ну да, странно, но в задании написано, что ток ответственный может...
 	// а ещё теоретически автор может не знать айди тендера.
-	err = s.checkers.CheckResponsibleToTender(tenderId, requesterUsername)
+	err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ViewReviews)
 	if err != nil {
-		return nil, err
+		return model.ReviewScope{}, err
 	}
 	// check 404
-	BidsByUser, err = s.repoBidProvider.GetBidsById(0, 0, authorId)
+	BidsByUser, _, err := s.repoBidProvider.GetBidsById(ctx, model.PageQuery{}, []string{authorId})
 	if err != nil {
-		return nil, err
+		return model.ReviewScope{}, err
 	}
 	if len(BidsByUser) == 0 {
-		return nil, echo.NewHTTPError(http.StatusNotFound, "no bids by this user")
+		return model.ReviewScope{}, errs.NotFound(fmt.Errorf("no bids by this author"))
+	}
+	log.Debug("user bids", slog.Any("bidsByUser", BidsByUser))
+
+	scope := model.ReviewScope{
+		AuthorType: filter.AuthorType,
+		AuthorId:   authorId,
+	}
+	if filter.AllTenders {
+		// reviews are left only by organization of tender, so they are its own anyway
+		scope.OrganizationId = tenderDB.OrganizationId
+		return scope, nil
 	}
-	log.Debug("user bids", BidsByUser)
 	//это уже скорее костыль, но у меня нет времени...
 	atLeastOneBid := false
 	for _, bid := range BidsByUser {
@@ -673,25 +840,26 @@ func (s *Service) Reviews(tenderId string, authorUsername string, requesterUsern
 		}
 	}
 	if !atLeastOneBid {
-		return nil, echo.NewHTTPError(http.StatusNotFound, "no bids for this tender by the specified author")
-	}
-	//extract just created Bids, because organization cannot leave feedback for it
-	var bidsToCheckFeedback = make([]model.BidDB, 0, len(BidsByUser))
-	for _, bid := range BidsByUser {
-		status := bid.Status
-		if !strings.EqualFold(status, "Created") {
-			bidsToCheckFeedback = append(bidsToCheckFeedback, bid)
-		}
+		return model.ReviewScope{}, errs.NotFound(fmt.Errorf("no bids for this tender by the specified author"))
 	}
+	scope.TenderId = tenderId
+	return scope, nil
+}
 
-	Feedbacks, err = s.repoBidFeedbacker.Reviews(authorUsername, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	if len(Feedbacks) == 0 {
-		return nil, echo.NewHTTPError(http.StatusNotFound, "no feedbacks for bids by this author")
+// reviewedAuthor resolves author of bids to id, user is given by username and organization by id
+func (s *Service) reviewedAuthor(ctx context.Context, filter model.ReviewFilter) (string, error) {
+	switch filter.AuthorType {
+	case "User":
+		// check status 401
+		return s.checkers.CheckIdByName(ctx, filter.Author)
+	case "Organization":
+		// check status 404
+		organizationDB, err := s.repoOrganizationProvider.Organization(ctx, filter.Author)
+		if err != nil {
+			return "", err
+		}
+		return organizationDB.Id, nil
+	default:
+		return "", errs.Validation(fmt.Errorf("either username or organization of author must be set"))
 	}
-	log.Info("Feedbacks from DB:", Feedbacks)
-
-	return Feedbacks, nil
 }
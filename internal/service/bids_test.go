@@ -0,0 +1,1173 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+)
+
+func TestCreateBid(t *testing.T) {
+	offer := &model.Offer{Amount: "1000", Currency: "RUB"}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		authorType string
+		authorId   string
+		setup      func(d *deps)
+		want       errs.Kind
+	}{
+		{
+			name:       "no caller",
+			ctx:        context.Background(),
+			authorType: "User",
+			want:       errs.KindUnauthorized,
+		},
+		{
+			name:       "tender not found",
+			ctx:        callerCtx(),
+			authorType: "User",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name:       "tender not published",
+			ctx:        callerCtx(),
+			authorType: "User",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Created", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "tender closed",
+			ctx:        callerCtx(),
+			authorType: "User",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Closed", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "submission deadline passed",
+			ctx:        callerCtx(),
+			authorType: "User",
+			setup: func(d *deps) {
+				deadline := time.Now().Add(-time.Minute)
+				expired := tender("Published")
+				expired.SubmissionDeadline = &deadline
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(expired, nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "on behalf of another user",
+			ctx:        callerCtx(),
+			authorType: "User",
+			authorId:   otherBidId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "by user",
+			ctx:        callerCtx(),
+			authorType: "User",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.bidCreator.EXPECT().CreateBid(mock.Anything, "bid", "description", tenderId, "User", userId, offer).Return(bidId, nil)
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+			},
+		},
+		{
+			name:       "organization without responsibility",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return(nil, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "on behalf of foreign organization",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			authorId:   otherBidId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, otherBidId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "organization role cannot submit bids",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			authorId:   userId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "reviewer")}, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "several organizations need explicit author",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "bidder"), membership(otherOrganizationId, "owner")}, nil)
+			},
+			want: errs.KindValidation,
+		},
+		{
+			name:       "by the only organization allowed to submit",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			authorId:   userId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "bidder"), membership(otherOrganizationId, "viewer")}, nil)
+				d.bidCreator.EXPECT().CreateBid(mock.Anything, "bid", "description", tenderId, "Organization", organizationId, offer).
+					Return(bidId, nil)
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+			},
+		},
+		{
+			name:       "by explicit organization",
+			ctx:        callerCtx(),
+			authorType: "Organization",
+			authorId:   otherOrganizationId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, otherOrganizationId, userId).Return("owner", nil)
+				d.bidCreator.EXPECT().CreateBid(mock.Anything, "bid", "description", tenderId, "Organization", otherOrganizationId, offer).
+					Return(bidId, nil)
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.CreateBid(tt.ctx, "bid", "description", tenderId, tt.authorType, tt.authorId, offer)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, bidId, got.Id)
+			}
+		})
+	}
+}
+
+func TestGetBidsByUser(t *testing.T) {
+	page := model.PageQuery{Limit: 6, Sort: model.SortName}
+
+	tests := []struct {
+		name    string
+		authors string
+		setup   func(d *deps)
+		want    errs.Kind
+	}{
+		{
+			name: "not responsible gets personal bids",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return(nil, nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, page, []string{userId}).
+					Return([]model.BidDB{bid(bidId, "Created")}, 1, nil)
+			},
+		},
+		{
+			name: "responsible gets bids of every organization in one query",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "owner"), membership(otherOrganizationId, "bidder")}, nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, page, []string{userId, organizationId, otherOrganizationId}).
+					Return([]model.BidDB{bid(bidId, "Created")}, 1, nil)
+			},
+		},
+		{
+			name:    "personal only",
+			authors: model.BidsPersonal,
+			setup: func(d *deps) {
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, page, []string{userId}).
+					Return([]model.BidDB{bid(bidId, "Created")}, 1, nil)
+			},
+		},
+		{
+			name:    "organization only",
+			authors: model.BidsOrganization,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "viewer")}, nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, page, []string{organizationId}).
+					Return([]model.BidDB{bid(bidId, "Published")}, 1, nil)
+			},
+		},
+		{
+			name:    "organization of not responsible",
+			authors: model.BidsOrganization,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return(nil, nil)
+			},
+			want: errs.KindForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			got, err := svc.GetBidsByUser(callerCtx(), model.PageQuery{Limit: 5}, tt.authors)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Len(t, got.Items, 1)
+				assert.Equal(t, 1, got.Total)
+				assert.Empty(t, got.NextCursor)
+			}
+		})
+	}
+}
+
+func TestBidsForTender(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+		ids   []string
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "unpublished tender of another organization",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "created bids are filtered by viewer",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.bidProvider.EXPECT().BidsForTender(mock.Anything, tenderId, username, model.PageQuery{Sort: model.SortName}).
+					Return([]model.BidDB{bid(bidId, "Created")}, 1, nil)
+			},
+			ids: []string{bidId},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.BidsForTender(tt.ctx, tenderId, model.PageQuery{})
+			requireKind(t, err, tt.want)
+			ids := make([]string, 0, len(got.Items))
+			for _, b := range got.Items {
+				ids = append(ids, b.Id)
+			}
+			if tt.want == "" {
+				assert.Equal(t, tt.ids, ids)
+			}
+		})
+	}
+}
+
+func TestBidStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		setup  func(d *deps)
+		want   errs.Kind
+		status string
+	}{
+		{
+			name: "bid not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "created is hidden from others",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Created"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Created", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "created is visible to organization members",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(orgBid(bidId, "Created"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Created", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			},
+			status: "Created",
+		},
+		{
+			name: "published",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+			},
+			status: "Published",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.BidStatus(tt.ctx, bidId)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, tt.status, got)
+			}
+		})
+	}
+}
+
+// bidEditCases are shared by UpdateBidStatus and EditBid, edit sets expectation on the editor
+func bidEditCases(edit func(d *deps)) []struct {
+	name  string
+	ctx   context.Context
+	setup func(d *deps)
+	want  errs.Kind
+} {
+	return []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "bid not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "bid locked",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Canceled"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "not an author",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "organization role cannot submit bids",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(orgBid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "updated",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				edit(d)
+			},
+		},
+	}
+}
+
+func TestUpdateBidStatus(t *testing.T) {
+	tests := bidEditCases(func(d *deps) {
+		d.bidEditor.EXPECT().UpdateBidStatus(mock.Anything, bidId, "Published", int32(1)).Return(bidId, nil)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			_, err := svc.UpdateBidStatus(tt.ctx, bidId, "Published", 1)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestEditBid(t *testing.T) {
+	offer := &model.Offer{Amount: "900", Currency: "RUB", WarrantyMonths: 12}
+	tests := bidEditCases(func(d *deps) {
+		d.bidEditor.EXPECT().EditBid(mock.Anything, bidId, "new", "", offer, int32(1)).Return(bidId, nil)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			_, err := svc.EditBid(tt.ctx, bidId, "new", "", offer, 1)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+// deciders are roles counted for quorum
+var deciders = []string{"owner", "procurement_manager", "reviewer"}
+
+const comment = "fits the budget"
+
+// decisionAllowed sets expectations for checks preceding the vote itself
+func decisionAllowed(d *deps, decisionPolicy quorum.Policy) {
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+	d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+	d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).Return(decisionPolicy, nil)
+	d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+	d.tx.EXPECT().LockBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+	d.tx.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+	d.tx.EXPECT().CheckSameSubmitter(mock.Anything, bidId, username).Return(nil)
+}
+
+// voted stores the vote and returns tally of the bid after it
+func voted(d *deps, decision string, approvals int, rejections int, members int) {
+	d.tx.EXPECT().SubmitDecision(mock.Anything, bidId, userId, decision, comment).Return(nil)
+	d.tx.EXPECT().CheckBidDecisionCount(mock.Anything, bidId, "Approved").Return(approvals, nil)
+	d.tx.EXPECT().CheckBidDecisionCount(mock.Anything, bidId, "Rejected").Return(rejections, nil)
+	d.tx.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, deciders).Return(members, nil)
+}
+
+// bidRejected sets expectations for canceling the bid once policy rejects it
+func bidRejected(d *deps) {
+	d.tx.EXPECT().ApplyDecision(mock.Anything, bidId, "Rejected").Return(nil)
+	d.tx.EXPECT().UpdateBidStatus(mock.Anything, bidId, "Canceled", int32(0)).Return(bidId, nil)
+}
+
+// quorumReached sets expectations for closing the tender after approval
+func quorumReached(d *deps) {
+	d.tx.EXPECT().ApplyDecision(mock.Anything, bidId, "Approved").Return(nil)
+	d.tx.EXPECT().ChangeTenderStatus(mock.Anything, tenderId, "Closed", int32(0)).Return(tenderId, nil)
+	d.tx.EXPECT().BidsForTender(mock.Anything, tenderId, "", model.PageQuery{}).
+		Return([]model.BidDB{bid(bidId, "Published"), bid(otherBidId, "Published")}, 2, nil)
+	d.tx.EXPECT().ApplyDecision(mock.Anything, otherBidId, "Rejected").Return(nil)
+	d.tx.EXPECT().UpdateBidStatus(mock.Anything, otherBidId, "Canceled", int32(0)).Return(otherBidId, nil)
+}
+
+func TestSubmitDecision(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		decision        string
+		organizationId  string
+		expectedVersion int32
+		setup           func(d *deps)
+		want            errs.Kind
+	}{
+		{
+			name:     "bid not found",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name:     "no caller",
+			ctx:      context.Background(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name:     "not a member of tender organization",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:           "acting for organization not owning tender",
+			ctx:            callerCtx(),
+			decision:       "Approved",
+			organizationId: otherOrganizationId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "role cannot decide",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:            "stale version",
+			ctx:             callerCtx(),
+			decision:        "Approved",
+			expectedVersion: 2,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).Return(quorum.Default(), nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().LockBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindVersionMismatch,
+		},
+		{
+			name:     "just created bid",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).Return(quorum.Default(), nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().LockBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "decision already taken",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).Return(quorum.Default(), nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().LockBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.tx.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "second vote of the same responsible",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).Return(quorum.Default(), nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().LockBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.tx.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				d.tx.EXPECT().CheckSameSubmitter(mock.Anything, bidId, username).Return(domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "not an approver of tender",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckDecisionPolicy(mock.Anything, tenderId).
+					Return(quorum.Policy{Kind: quorum.Approvers, Approvers: []string{otherUserId}}, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:           "rejection is counted",
+			ctx:            callerCtx(),
+			decision:       "Rejected",
+			organizationId: organizationId,
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Default())
+				voted(d, "Rejected", 0, 1, 5)
+			},
+		},
+		{
+			name:     "rejections leave too few voters",
+			ctx:      callerCtx(),
+			decision: "Rejected",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Default())
+				voted(d, "Rejected", 0, 1, 3)
+				bidRejected(d)
+			},
+		},
+		{
+			name:     "single responsible closes tender",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Default())
+				voted(d, "Approved", 1, 0, 1)
+				quorumReached(d)
+			},
+		},
+		{
+			name:     "quorum not reached",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Default())
+				voted(d, "Approved", 2, 0, 5)
+			},
+		},
+		{
+			name:            "quorum is capped at three",
+			ctx:             callerCtx(),
+			decision:        "Approved",
+			expectedVersion: 1,
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Default())
+				voted(d, "Approved", 3, 0, 5)
+				quorumReached(d)
+			},
+		},
+		{
+			name:     "majority approves despite rejection",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Policy{Kind: quorum.Majority})
+				voted(d, "Approved", 3, 1, 5)
+				quorumReached(d)
+			},
+		},
+		{
+			name:     "unanimous is broken by one rejection",
+			ctx:      callerCtx(),
+			decision: "Rejected",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Policy{Kind: quorum.Unanimous})
+				voted(d, "Rejected", 3, 1, 5)
+				bidRejected(d)
+			},
+		},
+		{
+			name:     "approver closes tender",
+			ctx:      callerCtx(),
+			decision: "Approved",
+			setup: func(d *deps) {
+				decisionAllowed(d, quorum.Policy{Kind: quorum.Approvers, Required: 1, Approvers: []string{userId, otherUserId}})
+				voted(d, "Approved", 1, 0, 5)
+				quorumReached(d)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			_, err := svc.SubmitDecision(tt.ctx, bidId, tt.decision, comment, tt.organizationId, tt.expectedVersion)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestDecisions(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "bid not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "bidder of tender organization",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("bidder", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "viewer sees votes",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+				d.bidDecisionMaker.EXPECT().Decisions(mock.Anything, bidId).Return([]model.DecisionDB{{
+					BidId:         bidId,
+					ResponsibleId: otherUserId,
+					Username:      "other",
+					Decision:      "Rejected",
+					Comment:       comment,
+					CreatedAt:     createdAt,
+				}}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.Decisions(tt.ctx, bidId)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				require.Len(t, got, 1)
+				assert.Equal(t, "other", got[0].ApproverUsername)
+				assert.Equal(t, comment, got[0].Comment)
+				assert.Equal(t, createdAt, got[0].CreatedAt)
+			}
+		})
+	}
+}
+
+func TestFeedback(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		organizationId string
+		ratings        map[string]int
+		setup          func(d *deps)
+		want           errs.Kind
+	}{
+		{
+			name:    "unknown criterion",
+			ctx:     callerCtx(),
+			ratings: map[string]int{"beauty": 5},
+			setup:   func(d *deps) {},
+			want:    errs.KindValidation,
+		},
+		{
+			name:    "score out of range",
+			ctx:     callerCtx(),
+			ratings: map[string]int{"quality": 6},
+			setup:   func(d *deps) {},
+			want:    errs.KindValidation,
+		},
+		{
+			name: "bid not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "just created bid",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Created", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "author of the bid",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+				d.checkers.EXPECT().CheckBidAuthorByUsername(mock.Anything, bidId, username).Return(nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:           "acting for organization not owning tender",
+			ctx:            callerCtx(),
+			organizationId: otherOrganizationId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+				d.checkers.EXPECT().CheckBidAuthorByUsername(mock.Anything, bidId, username).Return(domainError(errs.KindForbidden))
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "role cannot feedback",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+				d.checkers.EXPECT().CheckBidAuthorByUsername(mock.Anything, bidId, username).Return(domainError(errs.KindForbidden))
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("bidder", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "left",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+				d.checkers.EXPECT().CheckBidAuthorByUsername(mock.Anything, bidId, username).Return(domainError(errs.KindForbidden))
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.bidFeedbacker.EXPECT().PostMessage(mock.Anything, bidId, userId, "Organization", "good", []string(nil), map[string]int(nil)).
+					Return(model.ThreadMessageDB{}, nil)
+			},
+		},
+		{
+			name:    "left with ratings",
+			ctx:     callerCtx(),
+			ratings: map[string]int{"quality": 5, "price": 3},
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.bidProvider.EXPECT().BidStatus(mock.Anything, bidId).Return("Published", nil)
+				d.checkers.EXPECT().CheckBidAuthorByUsername(mock.Anything, bidId, username).Return(domainError(errs.KindForbidden))
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.bidFeedbacker.EXPECT().PostMessage(mock.Anything, bidId, userId, "Organization", "good", []string(nil), map[string]int{"quality": 5, "price": 3}).
+					Return(model.ThreadMessageDB{}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			_, err := svc.Feedback(tt.ctx, bidId, "good", tt.ratings, tt.organizationId)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestRollbackBid(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "bid not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(model.BidDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "bid locked",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Canceled"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "version not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				d.checkers.EXPECT().CheckBidVersion(mock.Anything, bidId, int32(1)).Return(domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "not an author",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				d.checkers.EXPECT().CheckBidVersion(mock.Anything, bidId, int32(1)).Return(nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "rolled back",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckBidAvailability(mock.Anything, bidId).Return(nil)
+				d.checkers.EXPECT().CheckBidVersion(mock.Anything, bidId, int32(1)).Return(nil)
+				d.bidEditor.EXPECT().RollbackBid(mock.Anything, bidId, int32(1), int32(0)).Return(bidId, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			_, err := svc.RollbackBid(tt.ctx, bidId, 1, 0)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestReviews(t *testing.T) {
+	const authorUsername = "author"
+
+	var (
+		author       = model.ReviewFilter{AuthorType: "User", Author: authorUsername}
+		tenderScope  = model.ReviewScope{AuthorType: "User", AuthorId: userId, TenderId: tenderId}
+		from         = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		to           = from.Add(-time.Hour)
+		organization = model.ReviewFilter{AuthorType: "Organization", Author: otherOrganizationId, AllTenders: true}
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		filter model.ReviewFilter
+		setup  func(d *deps)
+		want   errs.Kind
+	}{
+		{
+			name:   "created range reversed",
+			ctx:    callerCtx(),
+			filter: model.ReviewFilter{AuthorType: "User", Author: authorUsername, CreatedFrom: &from, CreatedTo: &to},
+			setup:  func(d *deps) {},
+			want:   errs.KindValidation,
+		},
+		{
+			name:   "unknown organization author",
+			ctx:    callerCtx(),
+			filter: organization,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.organizations.EXPECT().Organization(mock.Anything, otherOrganizationId).Return(model.OrganizationDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name:   "organization author across tenders of organization",
+			ctx:    callerCtx(),
+			filter: organization,
+			setup: func(d *deps) {
+				other := orgBid(bidId, "Published")
+				other.AuthorId = otherOrganizationId
+				other.TenderId = "another"
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.organizations.EXPECT().Organization(mock.Anything, otherOrganizationId).Return(model.OrganizationDB{Id: otherOrganizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{otherOrganizationId}).Return([]model.BidDB{other}, 1, nil)
+				d.bidFeedbacker.EXPECT().Reviews(mock.Anything,
+					model.ReviewScope{AuthorType: "Organization", AuthorId: otherOrganizationId, OrganizationId: organizationId},
+					organization, model.PageQuery{Sort: model.SortCreatedAt}).
+					Return([]model.Feedback{{Id: bidId}}, 1, nil)
+			},
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "unknown author",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return("", domainError(errs.KindUnauthorized))
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "not responsible for tender",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "author has no bids",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{userId}).Return(nil, 0, nil)
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "author has no bids for tender",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				other := bid(bidId, "Published")
+				other.TenderId = "another"
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{userId}).Return([]model.BidDB{other}, 1, nil)
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "no feedback yet",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{userId}).
+					Return([]model.BidDB{bid(bidId, "Published")}, 1, nil)
+				d.bidFeedbacker.EXPECT().Reviews(mock.Anything, tenderScope, author, model.PageQuery{Sort: model.SortCreatedAt}).Return(nil, 0, nil)
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "reviews",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(userId, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{userId}).
+					Return([]model.BidDB{bid(bidId, "Published")}, 1, nil)
+				d.bidFeedbacker.EXPECT().Reviews(mock.Anything, tenderScope, author, model.PageQuery{Sort: model.SortCreatedAt}).
+					Return([]model.Feedback{{Id: bidId}}, 1, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			filter := tt.filter
+			if filter.AuthorType == "" {
+				filter = author
+			}
+			_, err := svc.Reviews(tt.ctx, tenderId, filter, model.PageQuery{})
+			requireKind(t, err, tt.want)
+		})
+	}
+}
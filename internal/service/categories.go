@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+type RepoCategories interface {
+	// Categories returns the whole catalog, deprecated categories included
+	Categories(
+		ctx context.Context,
+	) ([]model.CategoryDB, error)
+	CreateCategory(
+		ctx context.Context,
+		code string,
+		name string,
+		parentCode string,
+	) (model.CategoryDB, error)
+	// EditCategory keeps name if it is empty and deprecation if it is nil
+	EditCategory(
+		ctx context.Context,
+		code string,
+		name string,
+		deprecated *bool,
+	) (model.CategoryDB, error)
+}
+
+// catalog is categories by code
+type catalog map[string]model.CategoryDB
+
+// deprecated tells if category or any of its ancestors is deprecated
+func (c catalog) deprecated(code string) bool {
+	for code != "" {
+		category := c[code]
+		if category.Deprecated {
+			return true
+		}
+		code = category.ParentCode
+	}
+	return false
+}
+
+// subtree returns codes with all their descendants, sorted
+func (c catalog) subtree(codes []string) []string {
+	children := map[string][]string{}
+	for _, category := range c {
+		children[category.ParentCode] = append(children[category.ParentCode], category.Code)
+	}
+	seen := map[string]bool{}
+	queue := slices.Clone(codes)
+	for len(queue) > 0 {
+		code := queue[0]
+		queue = queue[1:]
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		queue = append(queue, children[code]...)
+	}
+
+	subtree := make([]string, 0, len(seen))
+	for code := range seen {
+		subtree = append(subtree, code)
+	}
+	slices.Sort(subtree)
+	return subtree
+}
+
+// Categories shows deprecation inherited from ancestors, deprecated categories are listed only on demand
+func (s *Service) Categories(ctx context.Context, withDeprecated bool) ([]model.CategoryResponse, error) {
+	const op = "Service.Categories"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	categoriesDB, err := s.repoCategories.Categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Categories from DB", slog.Int("count", len(categoriesDB)))
+
+	c := newCatalog(categoriesDB)
+	var categories []model.CategoryDB
+	for _, category := range categoriesDB {
+		category.Deprecated = c.deprecated(category.Code)
+		if withDeprecated || !category.Deprecated {
+			categories = append(categories, category)
+		}
+	}
+
+	return model.ConvertCategories(categories), nil
+}
+
+func (s *Service) CreateCategory(ctx context.Context, code string, name string, parentCode string) (model.CategoryResponse, error) {
+	const op = "Service.CreateCategory"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 403
+	err := s.checkCatalogAdmin(ctx)
+	if err != nil {
+		return model.CategoryResponse{}, err
+	}
+	// check status 400
+	if parentCode != "" {
+		c, err := s.catalog(ctx)
+		if err != nil {
+			return model.CategoryResponse{}, err
+		}
+		if _, ok := c[parentCode]; !ok {
+			return model.CategoryResponse{}, errs.Validation(fmt.Errorf("unknown parent category %s", parentCode))
+		}
+		if c.deprecated(parentCode) {
+			return model.CategoryResponse{}, errs.Validation(fmt.Errorf("parent category %s is deprecated", parentCode))
+		}
+	}
+	// check status 409
+	categoryDB, err := s.repoCategories.CreateCategory(ctx, code, name, parentCode)
+	if err != nil {
+		return model.CategoryResponse{}, err
+	}
+	log.Info("Created category", slog.String("code", categoryDB.Code))
+
+	return model.ConvertCategoryToResponse(categoryDB), nil
+}
+
+// EditCategory renames category or changes its deprecation, tenders keep referring to it by code
+func (s *Service) EditCategory(ctx context.Context, code string, name string, deprecated *bool) (model.CategoryResponse, error) {
+	const op = "Service.EditCategory"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 403
+	err := s.checkCatalogAdmin(ctx)
+	if err != nil {
+		return model.CategoryResponse{}, err
+	}
+	// check status 404
+	categoryDB, err := s.repoCategories.EditCategory(ctx, code, name, deprecated)
+	if err != nil {
+		return model.CategoryResponse{}, err
+	}
+	log.Info("Edited category", slog.Any("category", categoryDB))
+
+	return model.ConvertCategoryToResponse(categoryDB), nil
+}
+
+// checkCatalogAdmin lets only configured users manage catalog, it is shared by all organizations
+func (s *Service) checkCatalogAdmin(ctx context.Context) error {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	if !slices.Contains(s.catalogAdmins, caller.Username) {
+		return errs.Forbidden(fmt.Errorf("user can not manage categories"))
+	}
+	return nil
+}
+
+// checkServiceType allows new and edited tenders to use only active categories
+func (s *Service) checkServiceType(ctx context.Context, serviceType string) error {
+	c, err := s.catalog(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := c[serviceType]; !ok {
+		return errs.Validation(fmt.Errorf("unknown service type %s", serviceType))
+	}
+	if c.deprecated(serviceType) {
+		return errs.Validation(fmt.Errorf("service type %s is deprecated", serviceType))
+	}
+	return nil
+}
+
+// serviceTypesFilter replaces categories of filter with their subtrees,
+// deprecated ones are kept because old tenders still have them
+func (s *Service) serviceTypesFilter(ctx context.Context, serviceTypes []string) ([]string, error) {
+	if len(serviceTypes) == 0 {
+		return serviceTypes, nil
+	}
+	c, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, serviceType := range serviceTypes {
+		if _, ok := c[serviceType]; !ok {
+			return nil, errs.Validation(fmt.Errorf("unknown service type %s", serviceType))
+		}
+	}
+	return c.subtree(serviceTypes), nil
+}
+
+func (s *Service) catalog(ctx context.Context) (catalog, error) {
+	categoriesDB, err := s.repoCategories.Categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newCatalog(categoriesDB), nil
+}
+
+func newCatalog(categories []model.CategoryDB) catalog {
+	c := make(catalog, len(categories))
+	for _, category := range categories {
+		c[category.Code] = category
+	}
+	return c
+}
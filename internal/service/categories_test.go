@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+	"zadanie-6105/internal/lib/auth"
+)
+
+// deliveryCatalog has Delivery > Food > Frozen and deprecated Delivery > Post
+func deliveryCatalog() []model.CategoryDB {
+	return []model.CategoryDB{
+		{Code: "Construction", Name: "Construction"},
+		{Code: "Delivery", Name: "Delivery"},
+		{Code: "Food", Name: "Food", ParentCode: "Delivery"},
+		{Code: "Frozen", Name: "Frozen food", ParentCode: "Food"},
+		{Code: "Letters", Name: "Letters", ParentCode: "Post"},
+		{Code: "Post", Name: "Post", ParentCode: "Delivery", Deprecated: true},
+	}
+}
+
+func TestTenders_CategorySubtree(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter []string
+		want   []string
+		kind   errs.Kind
+	}{
+		{
+			name:   "subtree of category",
+			filter: []string{"Food"},
+			want:   []string{"Food", "Frozen"},
+		},
+		{
+			name:   "deprecated categories are still found",
+			filter: []string{"Delivery"},
+			want:   []string{"Delivery", "Food", "Frozen", "Letters", "Post"},
+		},
+		{
+			name:   "overlapping subtrees",
+			filter: []string{"Frozen", "Food", "Construction"},
+			want:   []string{"Construction", "Food", "Frozen"},
+		},
+		{
+			name:   "unknown category",
+			filter: []string{"Food", "Space"},
+			kind:   errs.KindValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			d.catalog = deliveryCatalog()
+			if tt.kind == "" {
+				d.tenderProvider.EXPECT().Tenders(mock.Anything, mock.Anything, tt.want).Return(nil, 0, nil)
+			}
+
+			_, err := svc.Tenders(context.Background(), model.PageQuery{}, tt.filter)
+			requireKind(t, err, tt.kind)
+		})
+	}
+}
+
+func TestCreateTender_ServiceType(t *testing.T) {
+	for serviceType, want := range map[string]errs.Kind{
+		"Frozen":  "",
+		"Letters": errs.KindValidation,
+		"Space":   errs.KindValidation,
+	} {
+		t.Run(serviceType, func(t *testing.T) {
+			svc, d := newService(t)
+			d.catalog = deliveryCatalog()
+			d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+			d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+			if want == "" {
+				d.tenderCreator.EXPECT().CreateTender(mock.Anything, "tender", "description", serviceType, organizationId, username, (*time.Time)(nil), (*time.Time)(nil), quorum.Default()).
+					Return(tenderId, nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+			}
+
+			_, err := svc.CreateTender(callerCtx(), "tender", "description", serviceType, organizationId, nil, nil, quorum.Policy{})
+			requireKind(t, err, want)
+		})
+	}
+}
+
+func TestCategories(t *testing.T) {
+	svc, d := newService(t)
+	d.catalog = deliveryCatalog()
+
+	active, err := svc.Categories(context.Background(), false)
+	require.NoError(t, err)
+	var codes []string
+	for _, category := range active {
+		codes = append(codes, category.Code)
+	}
+	assert.Equal(t, []string{"Construction", "Delivery", "Food", "Frozen"}, codes)
+
+	all, err := svc.Categories(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, all, 6)
+	assert.Equal(t, model.CategoryResponse{Code: "Letters", Name: "Letters", ParentCode: "Post", Deprecated: true}, all[4],
+		"deprecation is inherited")
+}
+
+func TestCreateCategory(t *testing.T) {
+	notAdminCtx := auth.WithCaller(context.Background(), model.Caller{Id: otherUserId, Username: "other"})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		parent string
+		setup  func(d *deps)
+		want   errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "not catalog admin",
+			ctx:  notAdminCtx,
+			want: errs.KindForbidden,
+		},
+		{
+			name:   "unknown parent",
+			ctx:    callerCtx(),
+			parent: "Space",
+			want:   errs.KindValidation,
+		},
+		{
+			name:   "deprecated parent",
+			ctx:    callerCtx(),
+			parent: "Letters",
+			want:   errs.KindValidation,
+		},
+		{
+			name:   "created",
+			ctx:    callerCtx(),
+			parent: "Food",
+			setup: func(d *deps) {
+				d.categories.EXPECT().CreateCategory(mock.Anything, "Dairy", "Dairy products", "Food").
+					Return(model.CategoryDB{Code: "Dairy", Name: "Dairy products", ParentCode: "Food"}, nil)
+			},
+		},
+		{
+			name: "code taken",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.categories.EXPECT().CreateCategory(mock.Anything, "Dairy", "Dairy products", "").
+					Return(model.CategoryDB{}, domainError(errs.KindConflict))
+			},
+			want: errs.KindConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			d.catalog = deliveryCatalog()
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.CreateCategory(tt.ctx, "Dairy", "Dairy products", tt.parent)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, "Food", got.ParentCode)
+			}
+		})
+	}
+}
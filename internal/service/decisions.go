@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	"zadanie-6105/internal/domain/quorum"
+	"zadanie-6105/internal/repo"
+)
+
+// checkDecisionPolicy makes sure that every listed approver can decide bids of organization
+func (s *Service) checkDecisionPolicy(ctx context.Context, organizationId string, decisionPolicy quorum.Policy) error {
+	err := decisionPolicy.Validate()
+	if err != nil {
+		return errs.Validation(err)
+	}
+	for _, approverId := range decisionPolicy.Approvers {
+		role, err := s.checkers.CheckRole(ctx, organizationId, approverId)
+		if err != nil || !policy.Can(policy.Role(role), policy.DecideBids) {
+			return errs.Validation(fmt.Errorf("approver %s cannot decide bids of organization", approverId))
+		}
+	}
+	return nil
+}
+
+// tally counts votes on bid, checkers are either storage or transaction
+func tally(ctx context.Context, checkers repo.Checkers, bidId string, organizationId string, decisionPolicy quorum.Policy) (quorum.Tally, error) {
+	approvals, err := checkers.CheckBidDecisionCount(ctx, bidId, quorum.Approved)
+	if err != nil {
+		return quorum.Tally{}, err
+	}
+	rejections, err := checkers.CheckBidDecisionCount(ctx, bidId, quorum.Rejected)
+	if err != nil {
+		return quorum.Tally{}, err
+	}
+	members, err := checkers.CheckResponsibleCount(ctx, organizationId, policy.RolesWith(policy.DecideBids))
+	if err != nil {
+		return quorum.Tally{}, err
+	}
+	return decisionPolicy.Count(approvals, rejections, members), nil
+}
+
+func (s *Service) TenderTally(ctx context.Context, tenderId string) (model.TenderTally, error) {
+	const op = "Service.TenderTally"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.TenderTally{}, err
+	}
+	// check status 404
+	tenderDB, err := s.checkers.CheckTender(ctx, tenderId)
+	if err != nil {
+		return model.TenderTally{}, err
+	}
+	// check status 403
+	err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ViewTenders)
+	if err != nil {
+		return model.TenderTally{}, err
+	}
+
+	decisionPolicy, err := s.checkers.CheckDecisionPolicy(ctx, tenderId)
+	if err != nil {
+		return model.TenderTally{}, err
+	}
+	bidsDB, _, err := s.repoBidProvider.BidsForTender(ctx, tenderId, "", model.PageQuery{})
+	if err != nil {
+		return model.TenderTally{}, err
+	}
+
+	result := model.TenderTally{
+		TenderId: tenderId,
+		Policy:   decisionPolicy,
+		Bids:     []model.BidTally{},
+	}
+	for _, bid := range bidsDB {
+		// nobody votes on just created bids
+		if strings.EqualFold(bid.Status, "Created") {
+			continue
+		}
+		bidTally, err := tally(ctx, s.checkers, bid.Id, tenderDB.OrganizationId, decisionPolicy)
+		if err != nil {
+			return model.TenderTally{}, err
+		}
+		result.Bids = append(result.Bids, model.BidTally{BidId: bid.Id, Tally: bidTally})
+	}
+	log.Info("Counted votes", slog.Int("bids", len(result.Bids)))
+
+	return result, nil
+}
+
+// Decisions is the audit trail of voting on bid, it is visible to organization of the tender
+func (s *Service) Decisions(ctx context.Context, bidId string) ([]model.DecisionResponse, error) {
+	const op = "Service.Decisions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	bidDB, err := s.checkers.CheckBid(ctx, bidId)
+	if err != nil {
+		return nil, err
+	}
+	tenderDB, err := s.checkers.CheckTender(ctx, bidDB.TenderId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 403
+	err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ViewTenders)
+	if err != nil {
+		return nil, err
+	}
+
+	decisionsDB, err := s.repoBidDecisionMaker.Decisions(ctx, bidId)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Decisions from DB", slog.Int("count", len(decisionsDB)))
+
+	return model.ConvertDecisions(decisionsDB), nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/repo"
+)
+
+type RepoEmployeeProvider interface {
+	Employees(
+		ctx context.Context,
+		page model.PageQuery,
+	) ([]model.EmployeeDB, int, error)
+	Employee(
+		ctx context.Context,
+		employeeId string,
+	) (model.EmployeeDB, error)
+}
+type RepoEmployeeEditor interface {
+	CreateEmployee(
+		ctx context.Context,
+		username string,
+		firstName string,
+		lastName string,
+	) (string, error)
+	EditEmployee(
+		ctx context.Context,
+		employeeId string,
+		firstName string,
+		lastName string,
+	) error
+	DeleteEmployee(
+		ctx context.Context,
+		employeeId string,
+	) error
+}
+
+func (s *Service) Employees(ctx context.Context, page model.PageQuery) (model.Page[model.EmployeeResponse], error) {
+	// check status 401
+	_, err := s.caller(ctx)
+	if err != nil {
+		return model.Page[model.EmployeeResponse]{}, err
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
+	if err != nil {
+		return model.Page[model.EmployeeResponse]{}, err
+	}
+
+	employeesDB, total, err := s.repoEmployeeProvider.Employees(ctx, page)
+	if err != nil {
+		return model.Page[model.EmployeeResponse]{}, err
+	}
+	employeesDB, nextCursor := nextPage(employeesDB, page)
+
+	return model.Page[model.EmployeeResponse]{
+		Items:      model.ConvertEmployees(employeesDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+func (s *Service) Employee(ctx context.Context, employeeId string) (model.EmployeeResponse, error) {
+	// check status 401
+	_, err := s.caller(ctx)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	// check status 404
+	employeeDB, err := s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	return model.ConvertEmployeeToResponse(employeeDB), nil
+}
+
+func (s *Service) Me(ctx context.Context) (model.EmployeeResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	employeeDB, err := s.repoEmployeeProvider.Employee(ctx, caller.Id)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	return model.ConvertEmployeeToResponse(employeeDB), nil
+}
+
+// CreateEmployee adds employee with initial password to organization managed by caller,
+// viewer is the default role. Organization may be omitted if caller manages members of only one
+func (s *Service) CreateEmployee(ctx context.Context, username string, firstName string, lastName string, password string, organizationId string, role string) (model.EmployeeResponse, error) {
+	const op = "Service.CreateEmployee"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	// check status 400
+	if role == "" {
+		role = string(policy.RoleViewer)
+	}
+	role, err = checkRole(role)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	// check status 400, 403
+	if organizationId == "" {
+		organizationId, err = s.soleOrganizationWith(ctx, caller.Id, policy.ManageMembers)
+	} else {
+		err = s.authorize(ctx, organizationId, caller.Id, policy.ManageMembers)
+	}
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error("failed to hash password", sl.Err(err))
+		return model.EmployeeResponse{}, errs.Internal(err)
+	}
+
+	// employee never exists without password or organization
+	var employeeId string
+	err = s.transactor.InTransaction(ctx, func(tx repo.Tx) error {
+		// check status 409
+		employeeId, err = tx.CreateEmployee(ctx, username, firstName, lastName)
+		if err != nil {
+			return err
+		}
+		err = tx.SetCredentials(ctx, employeeId, string(hash))
+		if err != nil {
+			return err
+		}
+		return tx.AddResponsible(ctx, organizationId, employeeId, role)
+	})
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	employeeDB, err := s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	log.Info("Created employee", slog.String("id", employeeId), slog.String("organizationId", organizationId))
+
+	return model.ConvertEmployeeToResponse(employeeDB), nil
+}
+
+func (s *Service) EditEmployee(ctx context.Context, employeeId string, firstName string, lastName string) (model.EmployeeResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	// check status 404
+	_, err = s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+	// check status 403
+	if caller.Id != employeeId {
+		return model.EmployeeResponse{}, errs.Forbidden(fmt.Errorf("user can edit only himself"))
+	}
+
+	err = s.repoEmployeeEditor.EditEmployee(ctx, employeeId, firstName, lastName)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	employeeDB, err := s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return model.EmployeeResponse{}, err
+	}
+
+	return model.ConvertEmployeeToResponse(employeeDB), nil
+}
+
+func (s *Service) DeleteEmployee(ctx context.Context, employeeId string) error {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 404
+	_, err = s.repoEmployeeProvider.Employee(ctx, employeeId)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	if caller.Id != employeeId {
+		return errs.Forbidden(fmt.Errorf("user can delete only himself"))
+	}
+	// check status 403
+	membershipsDB, err := s.checkers.CheckMemberships(ctx, employeeId)
+	if err != nil {
+		return err
+	}
+	for _, membershipDB := range membershipsDB {
+		err = s.checkNotLastOwner(ctx, membershipDB.Id, employeeId)
+		if err != nil {
+			return err
+		}
+	}
+
+	// check status 409
+	return s.repoEmployeeEditor.DeleteEmployee(ctx, employeeId)
+}
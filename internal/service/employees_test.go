@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestCreateEmployee(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		organizationId string
+		setup          func(d *deps)
+		want           errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name:           "caller does not manage members",
+			ctx:            callerCtx(),
+			organizationId: organizationId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "organization is ambiguous",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{
+					membership(organizationId, "owner"),
+					membership(otherOrganizationId, "owner"),
+				}, nil)
+			},
+			want: errs.KindValidation,
+		},
+		{
+			name: "username taken",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "owner")}, nil)
+				d.tx.EXPECT().CreateEmployee(mock.Anything, "newbie", "Petr", "Petrov").Return("", domainError(errs.KindConflict))
+			},
+			want: errs.KindConflict,
+		},
+		{
+			name:           "created as viewer with password",
+			ctx:            callerCtx(),
+			organizationId: organizationId,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tx.EXPECT().CreateEmployee(mock.Anything, "newbie", "Petr", "Petrov").Return(otherUserId, nil)
+				d.tx.EXPECT().SetCredentials(mock.Anything, otherUserId, mock.Anything).Return(nil)
+				d.tx.EXPECT().AddResponsible(mock.Anything, organizationId, otherUserId, "viewer").Return(nil)
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(model.EmployeeDB{Id: otherUserId, Username: "newbie", CreatedAt: createdAt}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.CreateEmployee(tt.ctx, "newbie", "Petr", "Petrov", "password", tt.organizationId, "")
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, otherUserId, got.Id)
+			}
+		})
+	}
+}
+
+func TestDeleteEmployee(t *testing.T) {
+	tests := []struct {
+		name       string
+		employeeId string
+		setup      func(d *deps)
+		want       errs.Kind
+	}{
+		{
+			name:       "other employee",
+			employeeId: otherUserId,
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, otherUserId).Return(model.EmployeeDB{Id: otherUserId}, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "last owner",
+			employeeId: userId,
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, userId).Return(model.EmployeeDB{Id: userId}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "owner")}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.checkers.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, []string{"owner"}).Return(1, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:       "creator of tenders",
+			employeeId: userId,
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, userId).Return(model.EmployeeDB{Id: userId}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "viewer")}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+				d.employeeEditor.EXPECT().DeleteEmployee(mock.Anything, userId).Return(domainError(errs.KindConflict))
+			},
+			want: errs.KindConflict,
+		},
+		{
+			name:       "one of owners",
+			employeeId: userId,
+			setup: func(d *deps) {
+				d.employees.EXPECT().Employee(mock.Anything, userId).Return(model.EmployeeDB{Id: userId}, nil)
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{membership(organizationId, "owner")}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.checkers.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, []string{"owner"}).Return(2, nil)
+				d.employeeEditor.EXPECT().DeleteEmployee(mock.Anything, userId).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			err := svc.DeleteEmployee(callerCtx(), tt.employeeId)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoAttachments is an autogenerated mock type for the RepoAttachments type
+type RepoAttachments struct {
+	mock.Mock
+}
+
+type RepoAttachments_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoAttachments) EXPECT() *RepoAttachments_Expecter {
+	return &RepoAttachments_Expecter{mock: &_m.Mock}
+}
+
+// AddAttachment provides a mock function with given fields: ctx, attachment, expectedVersion
+func (_m *RepoAttachments) AddAttachment(ctx context.Context, attachment model.AttachmentDB, expectedVersion int32) (model.AttachmentDB, int, error) {
+	ret := _m.Called(ctx, attachment, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 model.AttachmentDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AttachmentDB, int32) (model.AttachmentDB, int, error)); ok {
+		return rf(ctx, attachment, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AttachmentDB, int32) model.AttachmentDB); ok {
+		r0 = rf(ctx, attachment, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(model.AttachmentDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AttachmentDB, int32) int); ok {
+		r1 = rf(ctx, attachment, expectedVersion)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.AttachmentDB, int32) error); ok {
+		r2 = rf(ctx, attachment, expectedVersion)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoAttachments_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type RepoAttachments_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment model.AttachmentDB
+//   - expectedVersion int32
+func (_e *RepoAttachments_Expecter) AddAttachment(ctx interface{}, attachment interface{}, expectedVersion interface{}) *RepoAttachments_AddAttachment_Call {
+	return &RepoAttachments_AddAttachment_Call{Call: _e.mock.On("AddAttachment", ctx, attachment, expectedVersion)}
+}
+
+func (_c *RepoAttachments_AddAttachment_Call) Run(run func(ctx context.Context, attachment model.AttachmentDB, expectedVersion int32)) *RepoAttachments_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AttachmentDB), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoAttachments_AddAttachment_Call) Return(_a0 model.AttachmentDB, _a1 int, _a2 error) *RepoAttachments_AddAttachment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoAttachments_AddAttachment_Call) RunAndReturn(run func(context.Context, model.AttachmentDB, int32) (model.AttachmentDB, int, error)) *RepoAttachments_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attachment provides a mock function with given fields: ctx, ownerType, ownerId, attachmentId
+func (_m *RepoAttachments) Attachment(ctx context.Context, ownerType string, ownerId string, attachmentId string) (model.AttachmentDB, error) {
+	ret := _m.Called(ctx, ownerType, ownerId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachment")
+	}
+
+	var r0 model.AttachmentDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (model.AttachmentDB, error)); ok {
+		return rf(ctx, ownerType, ownerId, attachmentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) model.AttachmentDB); ok {
+		r0 = rf(ctx, ownerType, ownerId, attachmentId)
+	} else {
+		r0 = ret.Get(0).(model.AttachmentDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, ownerType, ownerId, attachmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAttachments_Attachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachment'
+type RepoAttachments_Attachment_Call struct {
+	*mock.Call
+}
+
+// Attachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType string
+//   - ownerId string
+//   - attachmentId string
+func (_e *RepoAttachments_Expecter) Attachment(ctx interface{}, ownerType interface{}, ownerId interface{}, attachmentId interface{}) *RepoAttachments_Attachment_Call {
+	return &RepoAttachments_Attachment_Call{Call: _e.mock.On("Attachment", ctx, ownerType, ownerId, attachmentId)}
+}
+
+func (_c *RepoAttachments_Attachment_Call) Run(run func(ctx context.Context, ownerType string, ownerId string, attachmentId string)) *RepoAttachments_Attachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAttachments_Attachment_Call) Return(_a0 model.AttachmentDB, _a1 error) *RepoAttachments_Attachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAttachments_Attachment_Call) RunAndReturn(run func(context.Context, string, string, string) (model.AttachmentDB, error)) *RepoAttachments_Attachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attachments provides a mock function with given fields: ctx, ownerType, ownerId, version
+func (_m *RepoAttachments) Attachments(ctx context.Context, ownerType string, ownerId string, version int32) ([]model.AttachmentDB, error) {
+	ret := _m.Called(ctx, ownerType, ownerId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachments")
+	}
+
+	var r0 []model.AttachmentDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.AttachmentDB, error)); ok {
+		return rf(ctx, ownerType, ownerId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.AttachmentDB); ok {
+		r0 = rf(ctx, ownerType, ownerId, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AttachmentDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, ownerType, ownerId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAttachments_Attachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachments'
+type RepoAttachments_Attachments_Call struct {
+	*mock.Call
+}
+
+// Attachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType string
+//   - ownerId string
+//   - version int32
+func (_e *RepoAttachments_Expecter) Attachments(ctx interface{}, ownerType interface{}, ownerId interface{}, version interface{}) *RepoAttachments_Attachments_Call {
+	return &RepoAttachments_Attachments_Call{Call: _e.mock.On("Attachments", ctx, ownerType, ownerId, version)}
+}
+
+func (_c *RepoAttachments_Attachments_Call) Run(run func(ctx context.Context, ownerType string, ownerId string, version int32)) *RepoAttachments_Attachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoAttachments_Attachments_Call) Return(_a0 []model.AttachmentDB, _a1 error) *RepoAttachments_Attachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAttachments_Attachments_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.AttachmentDB, error)) *RepoAttachments_Attachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAttachment provides a mock function with given fields: ctx, ownerType, ownerId, attachmentId, expectedVersion
+func (_m *RepoAttachments) RemoveAttachment(ctx context.Context, ownerType string, ownerId string, attachmentId string, expectedVersion int32) (int, error) {
+	ret := _m.Called(ctx, ownerType, ownerId, attachmentId, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAttachment")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32) (int, error)); ok {
+		return rf(ctx, ownerType, ownerId, attachmentId, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32) int); ok {
+		r0 = rf(ctx, ownerType, ownerId, attachmentId, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int32) error); ok {
+		r1 = rf(ctx, ownerType, ownerId, attachmentId, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAttachments_RemoveAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAttachment'
+type RepoAttachments_RemoveAttachment_Call struct {
+	*mock.Call
+}
+
+// RemoveAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerType string
+//   - ownerId string
+//   - attachmentId string
+//   - expectedVersion int32
+func (_e *RepoAttachments_Expecter) RemoveAttachment(ctx interface{}, ownerType interface{}, ownerId interface{}, attachmentId interface{}, expectedVersion interface{}) *RepoAttachments_RemoveAttachment_Call {
+	return &RepoAttachments_RemoveAttachment_Call{Call: _e.mock.On("RemoveAttachment", ctx, ownerType, ownerId, attachmentId, expectedVersion)}
+}
+
+func (_c *RepoAttachments_RemoveAttachment_Call) Run(run func(ctx context.Context, ownerType string, ownerId string, attachmentId string, expectedVersion int32)) *RepoAttachments_RemoveAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoAttachments_RemoveAttachment_Call) Return(_a0 int, _a1 error) *RepoAttachments_RemoveAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAttachments_RemoveAttachment_Call) RunAndReturn(run func(context.Context, string, string, string, int32) (int, error)) *RepoAttachments_RemoveAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoAttachments creates a new instance of RepoAttachments. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoAttachments(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoAttachments {
+	mock := &RepoAttachments{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
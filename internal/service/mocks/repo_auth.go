@@ -0,0 +1,577 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepoAuth is an autogenerated mock type for the RepoAuth type
+type RepoAuth struct {
+	mock.Mock
+}
+
+type RepoAuth_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoAuth) EXPECT() *RepoAuth_Expecter {
+	return &RepoAuth_Expecter{mock: &_m.Mock}
+}
+
+// ActiveSession provides a mock function with given fields: ctx, sessionId
+func (_m *RepoAuth) ActiveSession(ctx context.Context, sessionId string) (model.SessionDB, error) {
+	ret := _m.Called(ctx, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveSession")
+	}
+
+	var r0 model.SessionDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.SessionDB, error)); ok {
+		return rf(ctx, sessionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.SessionDB); ok {
+		r0 = rf(ctx, sessionId)
+	} else {
+		r0 = ret.Get(0).(model.SessionDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_ActiveSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveSession'
+type RepoAuth_ActiveSession_Call struct {
+	*mock.Call
+}
+
+// ActiveSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+func (_e *RepoAuth_Expecter) ActiveSession(ctx interface{}, sessionId interface{}) *RepoAuth_ActiveSession_Call {
+	return &RepoAuth_ActiveSession_Call{Call: _e.mock.On("ActiveSession", ctx, sessionId)}
+}
+
+func (_c *RepoAuth_ActiveSession_Call) Run(run func(ctx context.Context, sessionId string)) *RepoAuth_ActiveSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_ActiveSession_Call) Return(_a0 model.SessionDB, _a1 error) *RepoAuth_ActiveSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_ActiveSession_Call) RunAndReturn(run func(context.Context, string) (model.SessionDB, error)) *RepoAuth_ActiveSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInvite provides a mock function with given fields: ctx, employeeId, createdBy, tokenHash, ttl
+func (_m *RepoAuth) CreateInvite(ctx context.Context, employeeId string, createdBy string, tokenHash string, ttl time.Duration) error {
+	ret := _m.Called(ctx, employeeId, createdBy, tokenHash, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, employeeId, createdBy, tokenHash, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoAuth_CreateInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvite'
+type RepoAuth_CreateInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+//   - createdBy string
+//   - tokenHash string
+//   - ttl time.Duration
+func (_e *RepoAuth_Expecter) CreateInvite(ctx interface{}, employeeId interface{}, createdBy interface{}, tokenHash interface{}, ttl interface{}) *RepoAuth_CreateInvite_Call {
+	return &RepoAuth_CreateInvite_Call{Call: _e.mock.On("CreateInvite", ctx, employeeId, createdBy, tokenHash, ttl)}
+}
+
+func (_c *RepoAuth_CreateInvite_Call) Run(run func(ctx context.Context, employeeId string, createdBy string, tokenHash string, ttl time.Duration)) *RepoAuth_CreateInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_CreateInvite_Call) Return(_a0 error) *RepoAuth_CreateInvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoAuth_CreateInvite_Call) RunAndReturn(run func(context.Context, string, string, string, time.Duration) error) *RepoAuth_CreateInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, employeeId, refreshTokenHash, ttl
+func (_m *RepoAuth) CreateSession(ctx context.Context, employeeId string, refreshTokenHash string, ttl time.Duration) (string, error) {
+	ret := _m.Called(ctx, employeeId, refreshTokenHash, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (string, error)); ok {
+		return rf(ctx, employeeId, refreshTokenHash, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) string); ok {
+		r0 = rf(ctx, employeeId, refreshTokenHash, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, employeeId, refreshTokenHash, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type RepoAuth_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+//   - refreshTokenHash string
+//   - ttl time.Duration
+func (_e *RepoAuth_Expecter) CreateSession(ctx interface{}, employeeId interface{}, refreshTokenHash interface{}, ttl interface{}) *RepoAuth_CreateSession_Call {
+	return &RepoAuth_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, employeeId, refreshTokenHash, ttl)}
+}
+
+func (_c *RepoAuth_CreateSession_Call) Run(run func(ctx context.Context, employeeId string, refreshTokenHash string, ttl time.Duration)) *RepoAuth_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_CreateSession_Call) Return(_a0 string, _a1 error) *RepoAuth_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_CreateSession_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (string, error)) *RepoAuth_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Credentials provides a mock function with given fields: ctx, username
+func (_m *RepoAuth) Credentials(ctx context.Context, username string) (model.CredentialsDB, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Credentials")
+	}
+
+	var r0 model.CredentialsDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.CredentialsDB, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.CredentialsDB); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(model.CredentialsDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_Credentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Credentials'
+type RepoAuth_Credentials_Call struct {
+	*mock.Call
+}
+
+// Credentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepoAuth_Expecter) Credentials(ctx interface{}, username interface{}) *RepoAuth_Credentials_Call {
+	return &RepoAuth_Credentials_Call{Call: _e.mock.On("Credentials", ctx, username)}
+}
+
+func (_c *RepoAuth_Credentials_Call) Run(run func(ctx context.Context, username string)) *RepoAuth_Credentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_Credentials_Call) Return(_a0 model.CredentialsDB, _a1 error) *RepoAuth_Credentials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_Credentials_Call) RunAndReturn(run func(context.Context, string) (model.CredentialsDB, error)) *RepoAuth_Credentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmployeeIdByName provides a mock function with given fields: ctx, username
+func (_m *RepoAuth) EmployeeIdByName(ctx context.Context, username string) (string, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmployeeIdByName")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_EmployeeIdByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmployeeIdByName'
+type RepoAuth_EmployeeIdByName_Call struct {
+	*mock.Call
+}
+
+// EmployeeIdByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepoAuth_Expecter) EmployeeIdByName(ctx interface{}, username interface{}) *RepoAuth_EmployeeIdByName_Call {
+	return &RepoAuth_EmployeeIdByName_Call{Call: _e.mock.On("EmployeeIdByName", ctx, username)}
+}
+
+func (_c *RepoAuth_EmployeeIdByName_Call) Run(run func(ctx context.Context, username string)) *RepoAuth_EmployeeIdByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_EmployeeIdByName_Call) Return(_a0 string, _a1 error) *RepoAuth_EmployeeIdByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_EmployeeIdByName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *RepoAuth_EmployeeIdByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeEmployeeSessions provides a mock function with given fields: ctx, employeeId
+func (_m *RepoAuth) RevokeEmployeeSessions(ctx context.Context, employeeId string) error {
+	ret := _m.Called(ctx, employeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeEmployeeSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, employeeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoAuth_RevokeEmployeeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeEmployeeSessions'
+type RepoAuth_RevokeEmployeeSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeEmployeeSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+func (_e *RepoAuth_Expecter) RevokeEmployeeSessions(ctx interface{}, employeeId interface{}) *RepoAuth_RevokeEmployeeSessions_Call {
+	return &RepoAuth_RevokeEmployeeSessions_Call{Call: _e.mock.On("RevokeEmployeeSessions", ctx, employeeId)}
+}
+
+func (_c *RepoAuth_RevokeEmployeeSessions_Call) Run(run func(ctx context.Context, employeeId string)) *RepoAuth_RevokeEmployeeSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_RevokeEmployeeSessions_Call) Return(_a0 error) *RepoAuth_RevokeEmployeeSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoAuth_RevokeEmployeeSessions_Call) RunAndReturn(run func(context.Context, string) error) *RepoAuth_RevokeEmployeeSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, sessionId
+func (_m *RepoAuth) RevokeSession(ctx context.Context, sessionId string) error {
+	ret := _m.Called(ctx, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoAuth_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type RepoAuth_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+func (_e *RepoAuth_Expecter) RevokeSession(ctx interface{}, sessionId interface{}) *RepoAuth_RevokeSession_Call {
+	return &RepoAuth_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, sessionId)}
+}
+
+func (_c *RepoAuth_RevokeSession_Call) Run(run func(ctx context.Context, sessionId string)) *RepoAuth_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_RevokeSession_Call) Return(_a0 error) *RepoAuth_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoAuth_RevokeSession_Call) RunAndReturn(run func(context.Context, string) error) *RepoAuth_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSession provides a mock function with given fields: ctx, refreshTokenHash, newRefreshTokenHash, ttl
+func (_m *RepoAuth) RotateSession(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, ttl time.Duration) (model.SessionDB, error) {
+	ret := _m.Called(ctx, refreshTokenHash, newRefreshTokenHash, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSession")
+	}
+
+	var r0 model.SessionDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (model.SessionDB, error)); ok {
+		return rf(ctx, refreshTokenHash, newRefreshTokenHash, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) model.SessionDB); ok {
+		r0 = rf(ctx, refreshTokenHash, newRefreshTokenHash, ttl)
+	} else {
+		r0 = ret.Get(0).(model.SessionDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, refreshTokenHash, newRefreshTokenHash, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_RotateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSession'
+type RepoAuth_RotateSession_Call struct {
+	*mock.Call
+}
+
+// RotateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshTokenHash string
+//   - newRefreshTokenHash string
+//   - ttl time.Duration
+func (_e *RepoAuth_Expecter) RotateSession(ctx interface{}, refreshTokenHash interface{}, newRefreshTokenHash interface{}, ttl interface{}) *RepoAuth_RotateSession_Call {
+	return &RepoAuth_RotateSession_Call{Call: _e.mock.On("RotateSession", ctx, refreshTokenHash, newRefreshTokenHash, ttl)}
+}
+
+func (_c *RepoAuth_RotateSession_Call) Run(run func(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, ttl time.Duration)) *RepoAuth_RotateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_RotateSession_Call) Return(_a0 model.SessionDB, _a1 error) *RepoAuth_RotateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_RotateSession_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (model.SessionDB, error)) *RepoAuth_RotateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCredentials provides a mock function with given fields: ctx, employeeId, passwordHash
+func (_m *RepoAuth) SetCredentials(ctx context.Context, employeeId string, passwordHash string) error {
+	ret := _m.Called(ctx, employeeId, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCredentials")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, employeeId, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoAuth_SetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCredentials'
+type RepoAuth_SetCredentials_Call struct {
+	*mock.Call
+}
+
+// SetCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+//   - passwordHash string
+func (_e *RepoAuth_Expecter) SetCredentials(ctx interface{}, employeeId interface{}, passwordHash interface{}) *RepoAuth_SetCredentials_Call {
+	return &RepoAuth_SetCredentials_Call{Call: _e.mock.On("SetCredentials", ctx, employeeId, passwordHash)}
+}
+
+func (_c *RepoAuth_SetCredentials_Call) Run(run func(ctx context.Context, employeeId string, passwordHash string)) *RepoAuth_SetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_SetCredentials_Call) Return(_a0 error) *RepoAuth_SetCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoAuth_SetCredentials_Call) RunAndReturn(run func(context.Context, string, string) error) *RepoAuth_SetCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseInvite provides a mock function with given fields: ctx, tokenHash
+func (_m *RepoAuth) UseInvite(ctx context.Context, tokenHash string) (string, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseInvite")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoAuth_UseInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseInvite'
+type RepoAuth_UseInvite_Call struct {
+	*mock.Call
+}
+
+// UseInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *RepoAuth_Expecter) UseInvite(ctx interface{}, tokenHash interface{}) *RepoAuth_UseInvite_Call {
+	return &RepoAuth_UseInvite_Call{Call: _e.mock.On("UseInvite", ctx, tokenHash)}
+}
+
+func (_c *RepoAuth_UseInvite_Call) Run(run func(ctx context.Context, tokenHash string)) *RepoAuth_UseInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoAuth_UseInvite_Call) Return(_a0 string, _a1 error) *RepoAuth_UseInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoAuth_UseInvite_Call) RunAndReturn(run func(context.Context, string) (string, error)) *RepoAuth_UseInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoAuth creates a new instance of RepoAuth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoAuth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoAuth {
+	mock := &RepoAuth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoBidCreator is an autogenerated mock type for the RepoBidCreator type
+type RepoBidCreator struct {
+	mock.Mock
+}
+
+type RepoBidCreator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoBidCreator) EXPECT() *RepoBidCreator_Expecter {
+	return &RepoBidCreator_Expecter{mock: &_m.Mock}
+}
+
+// CreateBid provides a mock function with given fields: ctx, name, description, tenderId, authorType, authorId, offer
+func (_m *RepoBidCreator) CreateBid(ctx context.Context, name string, description string, tenderId string, authorType string, authorId string, offer *model.Offer) (string, error) {
+	ret := _m.Called(ctx, name, description, tenderId, authorType, authorId, offer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBid")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, *model.Offer) (string, error)); ok {
+		return rf(ctx, name, description, tenderId, authorType, authorId, offer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, *model.Offer) string); ok {
+		r0 = rf(ctx, name, description, tenderId, authorType, authorId, offer)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, *model.Offer) error); ok {
+		r1 = rf(ctx, name, description, tenderId, authorType, authorId, offer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidCreator_CreateBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBid'
+type RepoBidCreator_CreateBid_Call struct {
+	*mock.Call
+}
+
+// CreateBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description string
+//   - tenderId string
+//   - authorType string
+//   - authorId string
+//   - offer *model.Offer
+func (_e *RepoBidCreator_Expecter) CreateBid(ctx interface{}, name interface{}, description interface{}, tenderId interface{}, authorType interface{}, authorId interface{}, offer interface{}) *RepoBidCreator_CreateBid_Call {
+	return &RepoBidCreator_CreateBid_Call{Call: _e.mock.On("CreateBid", ctx, name, description, tenderId, authorType, authorId, offer)}
+}
+
+func (_c *RepoBidCreator_CreateBid_Call) Run(run func(ctx context.Context, name string, description string, tenderId string, authorType string, authorId string, offer *model.Offer)) *RepoBidCreator_CreateBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(*model.Offer))
+	})
+	return _c
+}
+
+func (_c *RepoBidCreator_CreateBid_Call) Return(_a0 string, _a1 error) *RepoBidCreator_CreateBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidCreator_CreateBid_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, *model.Offer) (string, error)) *RepoBidCreator_CreateBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoBidCreator creates a new instance of RepoBidCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoBidCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoBidCreator {
+	mock := &RepoBidCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,194 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoBidDecisionMaker is an autogenerated mock type for the RepoBidDecisionMaker type
+type RepoBidDecisionMaker struct {
+	mock.Mock
+}
+
+type RepoBidDecisionMaker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoBidDecisionMaker) EXPECT() *RepoBidDecisionMaker_Expecter {
+	return &RepoBidDecisionMaker_Expecter{mock: &_m.Mock}
+}
+
+// ApplyDecision provides a mock function with given fields: ctx, bidId, decision
+func (_m *RepoBidDecisionMaker) ApplyDecision(ctx context.Context, bidId string, decision string) error {
+	ret := _m.Called(ctx, bidId, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoBidDecisionMaker_ApplyDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyDecision'
+type RepoBidDecisionMaker_ApplyDecision_Call struct {
+	*mock.Call
+}
+
+// ApplyDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - decision string
+func (_e *RepoBidDecisionMaker_Expecter) ApplyDecision(ctx interface{}, bidId interface{}, decision interface{}) *RepoBidDecisionMaker_ApplyDecision_Call {
+	return &RepoBidDecisionMaker_ApplyDecision_Call{Call: _e.mock.On("ApplyDecision", ctx, bidId, decision)}
+}
+
+func (_c *RepoBidDecisionMaker_ApplyDecision_Call) Run(run func(ctx context.Context, bidId string, decision string)) *RepoBidDecisionMaker_ApplyDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_ApplyDecision_Call) Return(_a0 error) *RepoBidDecisionMaker_ApplyDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_ApplyDecision_Call) RunAndReturn(run func(context.Context, string, string) error) *RepoBidDecisionMaker_ApplyDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decisions provides a mock function with given fields: ctx, bidId
+func (_m *RepoBidDecisionMaker) Decisions(ctx context.Context, bidId string) ([]model.DecisionDB, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decisions")
+	}
+
+	var r0 []model.DecisionDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.DecisionDB, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.DecisionDB); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DecisionDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidDecisionMaker_Decisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decisions'
+type RepoBidDecisionMaker_Decisions_Call struct {
+	*mock.Call
+}
+
+// Decisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *RepoBidDecisionMaker_Expecter) Decisions(ctx interface{}, bidId interface{}) *RepoBidDecisionMaker_Decisions_Call {
+	return &RepoBidDecisionMaker_Decisions_Call{Call: _e.mock.On("Decisions", ctx, bidId)}
+}
+
+func (_c *RepoBidDecisionMaker_Decisions_Call) Run(run func(ctx context.Context, bidId string)) *RepoBidDecisionMaker_Decisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_Decisions_Call) Return(_a0 []model.DecisionDB, _a1 error) *RepoBidDecisionMaker_Decisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_Decisions_Call) RunAndReturn(run func(context.Context, string) ([]model.DecisionDB, error)) *RepoBidDecisionMaker_Decisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitDecision provides a mock function with given fields: ctx, bidId, responsibleId, decision, comment
+func (_m *RepoBidDecisionMaker) SubmitDecision(ctx context.Context, bidId string, responsibleId string, decision string, comment string) error {
+	ret := _m.Called(ctx, bidId, responsibleId, decision, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, bidId, responsibleId, decision, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoBidDecisionMaker_SubmitDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitDecision'
+type RepoBidDecisionMaker_SubmitDecision_Call struct {
+	*mock.Call
+}
+
+// SubmitDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - responsibleId string
+//   - decision string
+//   - comment string
+func (_e *RepoBidDecisionMaker_Expecter) SubmitDecision(ctx interface{}, bidId interface{}, responsibleId interface{}, decision interface{}, comment interface{}) *RepoBidDecisionMaker_SubmitDecision_Call {
+	return &RepoBidDecisionMaker_SubmitDecision_Call{Call: _e.mock.On("SubmitDecision", ctx, bidId, responsibleId, decision, comment)}
+}
+
+func (_c *RepoBidDecisionMaker_SubmitDecision_Call) Run(run func(ctx context.Context, bidId string, responsibleId string, decision string, comment string)) *RepoBidDecisionMaker_SubmitDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_SubmitDecision_Call) Return(_a0 error) *RepoBidDecisionMaker_SubmitDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoBidDecisionMaker_SubmitDecision_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *RepoBidDecisionMaker_SubmitDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoBidDecisionMaker creates a new instance of RepoBidDecisionMaker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoBidDecisionMaker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoBidDecisionMaker {
+	mock := &RepoBidDecisionMaker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
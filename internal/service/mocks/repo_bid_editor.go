@@ -0,0 +1,216 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoBidEditor is an autogenerated mock type for the RepoBidEditor type
+type RepoBidEditor struct {
+	mock.Mock
+}
+
+type RepoBidEditor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoBidEditor) EXPECT() *RepoBidEditor_Expecter {
+	return &RepoBidEditor_Expecter{mock: &_m.Mock}
+}
+
+// EditBid provides a mock function with given fields: ctx, bidId, name, description, offer, expectedVersion
+func (_m *RepoBidEditor) EditBid(ctx context.Context, bidId string, name string, description string, offer *model.Offer, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, bidId, name, description, offer, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditBid")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *model.Offer, int32) (string, error)); ok {
+		return rf(ctx, bidId, name, description, offer, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *model.Offer, int32) string); ok {
+		r0 = rf(ctx, bidId, name, description, offer, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *model.Offer, int32) error); ok {
+		r1 = rf(ctx, bidId, name, description, offer, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidEditor_EditBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditBid'
+type RepoBidEditor_EditBid_Call struct {
+	*mock.Call
+}
+
+// EditBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - name string
+//   - description string
+//   - offer *model.Offer
+//   - expectedVersion int32
+func (_e *RepoBidEditor_Expecter) EditBid(ctx interface{}, bidId interface{}, name interface{}, description interface{}, offer interface{}, expectedVersion interface{}) *RepoBidEditor_EditBid_Call {
+	return &RepoBidEditor_EditBid_Call{Call: _e.mock.On("EditBid", ctx, bidId, name, description, offer, expectedVersion)}
+}
+
+func (_c *RepoBidEditor_EditBid_Call) Run(run func(ctx context.Context, bidId string, name string, description string, offer *model.Offer, expectedVersion int32)) *RepoBidEditor_EditBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*model.Offer), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoBidEditor_EditBid_Call) Return(_a0 string, _a1 error) *RepoBidEditor_EditBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidEditor_EditBid_Call) RunAndReturn(run func(context.Context, string, string, string, *model.Offer, int32) (string, error)) *RepoBidEditor_EditBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackBid provides a mock function with given fields: ctx, bidId, version, expectedVersion
+func (_m *RepoBidEditor) RollbackBid(ctx context.Context, bidId string, version int32, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, bidId, version, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackBid")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) (string, error)); ok {
+		return rf(ctx, bidId, version, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) string); ok {
+		r0 = rf(ctx, bidId, version, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
+		r1 = rf(ctx, bidId, version, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidEditor_RollbackBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackBid'
+type RepoBidEditor_RollbackBid_Call struct {
+	*mock.Call
+}
+
+// RollbackBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - version int32
+//   - expectedVersion int32
+func (_e *RepoBidEditor_Expecter) RollbackBid(ctx interface{}, bidId interface{}, version interface{}, expectedVersion interface{}) *RepoBidEditor_RollbackBid_Call {
+	return &RepoBidEditor_RollbackBid_Call{Call: _e.mock.On("RollbackBid", ctx, bidId, version, expectedVersion)}
+}
+
+func (_c *RepoBidEditor_RollbackBid_Call) Run(run func(ctx context.Context, bidId string, version int32, expectedVersion int32)) *RepoBidEditor_RollbackBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoBidEditor_RollbackBid_Call) Return(_a0 string, _a1 error) *RepoBidEditor_RollbackBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidEditor_RollbackBid_Call) RunAndReturn(run func(context.Context, string, int32, int32) (string, error)) *RepoBidEditor_RollbackBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBidStatus provides a mock function with given fields: ctx, bidId, status, expectedVersion
+func (_m *RepoBidEditor) UpdateBidStatus(ctx context.Context, bidId string, status string, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, bidId, status, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBidStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) (string, error)); ok {
+		return rf(ctx, bidId, status, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) string); ok {
+		r0 = rf(ctx, bidId, status, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, bidId, status, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidEditor_UpdateBidStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBidStatus'
+type RepoBidEditor_UpdateBidStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateBidStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - status string
+//   - expectedVersion int32
+func (_e *RepoBidEditor_Expecter) UpdateBidStatus(ctx interface{}, bidId interface{}, status interface{}, expectedVersion interface{}) *RepoBidEditor_UpdateBidStatus_Call {
+	return &RepoBidEditor_UpdateBidStatus_Call{Call: _e.mock.On("UpdateBidStatus", ctx, bidId, status, expectedVersion)}
+}
+
+func (_c *RepoBidEditor_UpdateBidStatus_Call) Run(run func(ctx context.Context, bidId string, status string, expectedVersion int32)) *RepoBidEditor_UpdateBidStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoBidEditor_UpdateBidStatus_Call) Return(_a0 string, _a1 error) *RepoBidEditor_UpdateBidStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidEditor_UpdateBidStatus_Call) RunAndReturn(run func(context.Context, string, string, int32) (string, error)) *RepoBidEditor_UpdateBidStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoBidEditor creates a new instance of RepoBidEditor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoBidEditor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoBidEditor {
+	mock := &RepoBidEditor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,341 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoBidFeedbacker is an autogenerated mock type for the RepoBidFeedbacker type
+type RepoBidFeedbacker struct {
+	mock.Mock
+}
+
+type RepoBidFeedbacker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoBidFeedbacker) EXPECT() *RepoBidFeedbacker_Expecter {
+	return &RepoBidFeedbacker_Expecter{mock: &_m.Mock}
+}
+
+// MarkThreadRead provides a mock function with given fields: ctx, bidId, employeeId
+func (_m *RepoBidFeedbacker) MarkThreadRead(ctx context.Context, bidId string, employeeId string) error {
+	ret := _m.Called(ctx, bidId, employeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkThreadRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bidId, employeeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoBidFeedbacker_MarkThreadRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkThreadRead'
+type RepoBidFeedbacker_MarkThreadRead_Call struct {
+	*mock.Call
+}
+
+// MarkThreadRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - employeeId string
+func (_e *RepoBidFeedbacker_Expecter) MarkThreadRead(ctx interface{}, bidId interface{}, employeeId interface{}) *RepoBidFeedbacker_MarkThreadRead_Call {
+	return &RepoBidFeedbacker_MarkThreadRead_Call{Call: _e.mock.On("MarkThreadRead", ctx, bidId, employeeId)}
+}
+
+func (_c *RepoBidFeedbacker_MarkThreadRead_Call) Run(run func(ctx context.Context, bidId string, employeeId string)) *RepoBidFeedbacker_MarkThreadRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_MarkThreadRead_Call) Return(_a0 error) *RepoBidFeedbacker_MarkThreadRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_MarkThreadRead_Call) RunAndReturn(run func(context.Context, string, string) error) *RepoBidFeedbacker_MarkThreadRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostMessage provides a mock function with given fields: ctx, bidId, authorId, side, text, mentions, ratings
+func (_m *RepoBidFeedbacker) PostMessage(ctx context.Context, bidId string, authorId string, side string, text string, mentions []string, ratings map[string]int) (model.ThreadMessageDB, error) {
+	ret := _m.Called(ctx, bidId, authorId, side, text, mentions, ratings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostMessage")
+	}
+
+	var r0 model.ThreadMessageDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, map[string]int) (model.ThreadMessageDB, error)); ok {
+		return rf(ctx, bidId, authorId, side, text, mentions, ratings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, map[string]int) model.ThreadMessageDB); ok {
+		r0 = rf(ctx, bidId, authorId, side, text, mentions, ratings)
+	} else {
+		r0 = ret.Get(0).(model.ThreadMessageDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string, map[string]int) error); ok {
+		r1 = rf(ctx, bidId, authorId, side, text, mentions, ratings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidFeedbacker_PostMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostMessage'
+type RepoBidFeedbacker_PostMessage_Call struct {
+	*mock.Call
+}
+
+// PostMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - authorId string
+//   - side string
+//   - text string
+//   - mentions []string
+//   - ratings map[string]int
+func (_e *RepoBidFeedbacker_Expecter) PostMessage(ctx interface{}, bidId interface{}, authorId interface{}, side interface{}, text interface{}, mentions interface{}, ratings interface{}) *RepoBidFeedbacker_PostMessage_Call {
+	return &RepoBidFeedbacker_PostMessage_Call{Call: _e.mock.On("PostMessage", ctx, bidId, authorId, side, text, mentions, ratings)}
+}
+
+func (_c *RepoBidFeedbacker_PostMessage_Call) Run(run func(ctx context.Context, bidId string, authorId string, side string, text string, mentions []string, ratings map[string]int)) *RepoBidFeedbacker_PostMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]string), args[6].(map[string]int))
+	})
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_PostMessage_Call) Return(_a0 model.ThreadMessageDB, _a1 error) *RepoBidFeedbacker_PostMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_PostMessage_Call) RunAndReturn(run func(context.Context, string, string, string, string, []string, map[string]int) (model.ThreadMessageDB, error)) *RepoBidFeedbacker_PostMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reputation provides a mock function with given fields: ctx, authorType, authorId
+func (_m *RepoBidFeedbacker) Reputation(ctx context.Context, authorType string, authorId string) (model.ReputationDB, error) {
+	ret := _m.Called(ctx, authorType, authorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reputation")
+	}
+
+	var r0 model.ReputationDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.ReputationDB, error)); ok {
+		return rf(ctx, authorType, authorId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.ReputationDB); ok {
+		r0 = rf(ctx, authorType, authorId)
+	} else {
+		r0 = ret.Get(0).(model.ReputationDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, authorType, authorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidFeedbacker_Reputation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reputation'
+type RepoBidFeedbacker_Reputation_Call struct {
+	*mock.Call
+}
+
+// Reputation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorType string
+//   - authorId string
+func (_e *RepoBidFeedbacker_Expecter) Reputation(ctx interface{}, authorType interface{}, authorId interface{}) *RepoBidFeedbacker_Reputation_Call {
+	return &RepoBidFeedbacker_Reputation_Call{Call: _e.mock.On("Reputation", ctx, authorType, authorId)}
+}
+
+func (_c *RepoBidFeedbacker_Reputation_Call) Run(run func(ctx context.Context, authorType string, authorId string)) *RepoBidFeedbacker_Reputation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Reputation_Call) Return(_a0 model.ReputationDB, _a1 error) *RepoBidFeedbacker_Reputation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Reputation_Call) RunAndReturn(run func(context.Context, string, string) (model.ReputationDB, error)) *RepoBidFeedbacker_Reputation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reviews provides a mock function with given fields: ctx, scope, filter, page
+func (_m *RepoBidFeedbacker) Reviews(ctx context.Context, scope model.ReviewScope, filter model.ReviewFilter, page model.PageQuery) ([]model.Feedback, int, error) {
+	ret := _m.Called(ctx, scope, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reviews")
+	}
+
+	var r0 []model.Feedback
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ReviewScope, model.ReviewFilter, model.PageQuery) ([]model.Feedback, int, error)); ok {
+		return rf(ctx, scope, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ReviewScope, model.ReviewFilter, model.PageQuery) []model.Feedback); ok {
+		r0 = rf(ctx, scope, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Feedback)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ReviewScope, model.ReviewFilter, model.PageQuery) int); ok {
+		r1 = rf(ctx, scope, filter, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ReviewScope, model.ReviewFilter, model.PageQuery) error); ok {
+		r2 = rf(ctx, scope, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoBidFeedbacker_Reviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reviews'
+type RepoBidFeedbacker_Reviews_Call struct {
+	*mock.Call
+}
+
+// Reviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope model.ReviewScope
+//   - filter model.ReviewFilter
+//   - page model.PageQuery
+func (_e *RepoBidFeedbacker_Expecter) Reviews(ctx interface{}, scope interface{}, filter interface{}, page interface{}) *RepoBidFeedbacker_Reviews_Call {
+	return &RepoBidFeedbacker_Reviews_Call{Call: _e.mock.On("Reviews", ctx, scope, filter, page)}
+}
+
+func (_c *RepoBidFeedbacker_Reviews_Call) Run(run func(ctx context.Context, scope model.ReviewScope, filter model.ReviewFilter, page model.PageQuery)) *RepoBidFeedbacker_Reviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ReviewScope), args[2].(model.ReviewFilter), args[3].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Reviews_Call) Return(_a0 []model.Feedback, _a1 int, _a2 error) *RepoBidFeedbacker_Reviews_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Reviews_Call) RunAndReturn(run func(context.Context, model.ReviewScope, model.ReviewFilter, model.PageQuery) ([]model.Feedback, int, error)) *RepoBidFeedbacker_Reviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Thread provides a mock function with given fields: ctx, bidId, viewerId, page
+func (_m *RepoBidFeedbacker) Thread(ctx context.Context, bidId string, viewerId string, page model.PageQuery) ([]model.ThreadMessageDB, int, error) {
+	ret := _m.Called(ctx, bidId, viewerId, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Thread")
+	}
+
+	var r0 []model.ThreadMessageDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) ([]model.ThreadMessageDB, int, error)); ok {
+		return rf(ctx, bidId, viewerId, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) []model.ThreadMessageDB); ok {
+		r0 = rf(ctx, bidId, viewerId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ThreadMessageDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PageQuery) int); ok {
+		r1 = rf(ctx, bidId, viewerId, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, model.PageQuery) error); ok {
+		r2 = rf(ctx, bidId, viewerId, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoBidFeedbacker_Thread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Thread'
+type RepoBidFeedbacker_Thread_Call struct {
+	*mock.Call
+}
+
+// Thread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - viewerId string
+//   - page model.PageQuery
+func (_e *RepoBidFeedbacker_Expecter) Thread(ctx interface{}, bidId interface{}, viewerId interface{}, page interface{}) *RepoBidFeedbacker_Thread_Call {
+	return &RepoBidFeedbacker_Thread_Call{Call: _e.mock.On("Thread", ctx, bidId, viewerId, page)}
+}
+
+func (_c *RepoBidFeedbacker_Thread_Call) Run(run func(ctx context.Context, bidId string, viewerId string, page model.PageQuery)) *RepoBidFeedbacker_Thread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Thread_Call) Return(_a0 []model.ThreadMessageDB, _a1 int, _a2 error) *RepoBidFeedbacker_Thread_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoBidFeedbacker_Thread_Call) RunAndReturn(run func(context.Context, string, string, model.PageQuery) ([]model.ThreadMessageDB, int, error)) *RepoBidFeedbacker_Thread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoBidFeedbacker creates a new instance of RepoBidFeedbacker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoBidFeedbacker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoBidFeedbacker {
+	mock := &RepoBidFeedbacker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,415 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoBidProvider is an autogenerated mock type for the RepoBidProvider type
+type RepoBidProvider struct {
+	mock.Mock
+}
+
+type RepoBidProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoBidProvider) EXPECT() *RepoBidProvider_Expecter {
+	return &RepoBidProvider_Expecter{mock: &_m.Mock}
+}
+
+// BidStatus provides a mock function with given fields: ctx, bidId
+func (_m *RepoBidProvider) BidStatus(ctx context.Context, bidId string) (string, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidProvider_BidStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BidStatus'
+type RepoBidProvider_BidStatus_Call struct {
+	*mock.Call
+}
+
+// BidStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *RepoBidProvider_Expecter) BidStatus(ctx interface{}, bidId interface{}) *RepoBidProvider_BidStatus_Call {
+	return &RepoBidProvider_BidStatus_Call{Call: _e.mock.On("BidStatus", ctx, bidId)}
+}
+
+func (_c *RepoBidProvider_BidStatus_Call) Run(run func(ctx context.Context, bidId string)) *RepoBidProvider_BidStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_BidStatus_Call) Return(_a0 string, _a1 error) *RepoBidProvider_BidStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidProvider_BidStatus_Call) RunAndReturn(run func(context.Context, string) (string, error)) *RepoBidProvider_BidStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BidVersion provides a mock function with given fields: ctx, bidId, version
+func (_m *RepoBidProvider) BidVersion(ctx context.Context, bidId string, version int32) (model.BidDB, error) {
+	ret := _m.Called(ctx, bidId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidVersion")
+	}
+
+	var r0 model.BidDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) (model.BidDB, error)); ok {
+		return rf(ctx, bidId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) model.BidDB); ok {
+		r0 = rf(ctx, bidId, version)
+	} else {
+		r0 = ret.Get(0).(model.BidDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, bidId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidProvider_BidVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BidVersion'
+type RepoBidProvider_BidVersion_Call struct {
+	*mock.Call
+}
+
+// BidVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+//   - version int32
+func (_e *RepoBidProvider_Expecter) BidVersion(ctx interface{}, bidId interface{}, version interface{}) *RepoBidProvider_BidVersion_Call {
+	return &RepoBidProvider_BidVersion_Call{Call: _e.mock.On("BidVersion", ctx, bidId, version)}
+}
+
+func (_c *RepoBidProvider_BidVersion_Call) Run(run func(ctx context.Context, bidId string, version int32)) *RepoBidProvider_BidVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_BidVersion_Call) Return(_a0 model.BidDB, _a1 error) *RepoBidProvider_BidVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidProvider_BidVersion_Call) RunAndReturn(run func(context.Context, string, int32) (model.BidDB, error)) *RepoBidProvider_BidVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BidVersions provides a mock function with given fields: ctx, bidId
+func (_m *RepoBidProvider) BidVersions(ctx context.Context, bidId string) ([]model.BidDB, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidVersions")
+	}
+
+	var r0 []model.BidDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.BidDB, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.BidDB); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BidDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoBidProvider_BidVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BidVersions'
+type RepoBidProvider_BidVersions_Call struct {
+	*mock.Call
+}
+
+// BidVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bidId string
+func (_e *RepoBidProvider_Expecter) BidVersions(ctx interface{}, bidId interface{}) *RepoBidProvider_BidVersions_Call {
+	return &RepoBidProvider_BidVersions_Call{Call: _e.mock.On("BidVersions", ctx, bidId)}
+}
+
+func (_c *RepoBidProvider_BidVersions_Call) Run(run func(ctx context.Context, bidId string)) *RepoBidProvider_BidVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_BidVersions_Call) Return(_a0 []model.BidDB, _a1 error) *RepoBidProvider_BidVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoBidProvider_BidVersions_Call) RunAndReturn(run func(context.Context, string) ([]model.BidDB, error)) *RepoBidProvider_BidVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BidsForTender provides a mock function with given fields: ctx, tenderId, viewer, page
+func (_m *RepoBidProvider) BidsForTender(ctx context.Context, tenderId string, viewer string, page model.PageQuery) ([]model.BidDB, int, error) {
+	ret := _m.Called(ctx, tenderId, viewer, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidsForTender")
+	}
+
+	var r0 []model.BidDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) ([]model.BidDB, int, error)); ok {
+		return rf(ctx, tenderId, viewer, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PageQuery) []model.BidDB); ok {
+		r0 = rf(ctx, tenderId, viewer, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BidDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PageQuery) int); ok {
+		r1 = rf(ctx, tenderId, viewer, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, model.PageQuery) error); ok {
+		r2 = rf(ctx, tenderId, viewer, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoBidProvider_BidsForTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BidsForTender'
+type RepoBidProvider_BidsForTender_Call struct {
+	*mock.Call
+}
+
+// BidsForTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - viewer string
+//   - page model.PageQuery
+func (_e *RepoBidProvider_Expecter) BidsForTender(ctx interface{}, tenderId interface{}, viewer interface{}, page interface{}) *RepoBidProvider_BidsForTender_Call {
+	return &RepoBidProvider_BidsForTender_Call{Call: _e.mock.On("BidsForTender", ctx, tenderId, viewer, page)}
+}
+
+func (_c *RepoBidProvider_BidsForTender_Call) Run(run func(ctx context.Context, tenderId string, viewer string, page model.PageQuery)) *RepoBidProvider_BidsForTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_BidsForTender_Call) Return(_a0 []model.BidDB, _a1 int, _a2 error) *RepoBidProvider_BidsForTender_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoBidProvider_BidsForTender_Call) RunAndReturn(run func(context.Context, string, string, model.PageQuery) ([]model.BidDB, int, error)) *RepoBidProvider_BidsForTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBidsById provides a mock function with given fields: ctx, page, authorIds
+func (_m *RepoBidProvider) GetBidsById(ctx context.Context, page model.PageQuery, authorIds []string) ([]model.BidDB, int, error) {
+	ret := _m.Called(ctx, page, authorIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBidsById")
+	}
+
+	var r0 []model.BidDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) ([]model.BidDB, int, error)); ok {
+		return rf(ctx, page, authorIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) []model.BidDB); ok {
+		r0 = rf(ctx, page, authorIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BidDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PageQuery, []string) int); ok {
+		r1 = rf(ctx, page, authorIds)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.PageQuery, []string) error); ok {
+		r2 = rf(ctx, page, authorIds)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoBidProvider_GetBidsById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBidsById'
+type RepoBidProvider_GetBidsById_Call struct {
+	*mock.Call
+}
+
+// GetBidsById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page model.PageQuery
+//   - authorIds []string
+func (_e *RepoBidProvider_Expecter) GetBidsById(ctx interface{}, page interface{}, authorIds interface{}) *RepoBidProvider_GetBidsById_Call {
+	return &RepoBidProvider_GetBidsById_Call{Call: _e.mock.On("GetBidsById", ctx, page, authorIds)}
+}
+
+func (_c *RepoBidProvider_GetBidsById_Call) Run(run func(ctx context.Context, page model.PageQuery, authorIds []string)) *RepoBidProvider_GetBidsById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PageQuery), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_GetBidsById_Call) Return(_a0 []model.BidDB, _a1 int, _a2 error) *RepoBidProvider_GetBidsById_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoBidProvider_GetBidsById_Call) RunAndReturn(run func(context.Context, model.PageQuery, []string) ([]model.BidDB, int, error)) *RepoBidProvider_GetBidsById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchBids provides a mock function with given fields: ctx, filter, authorIds, organizationIds, page
+func (_m *RepoBidProvider) SearchBids(ctx context.Context, filter model.SearchFilter, authorIds []string, organizationIds []string, page model.PageQuery) ([]model.BidSearchDB, int, error) {
+	ret := _m.Called(ctx, filter, authorIds, organizationIds, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchBids")
+	}
+
+	var r0 []model.BidSearchDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter, []string, []string, model.PageQuery) ([]model.BidSearchDB, int, error)); ok {
+		return rf(ctx, filter, authorIds, organizationIds, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter, []string, []string, model.PageQuery) []model.BidSearchDB); ok {
+		r0 = rf(ctx, filter, authorIds, organizationIds, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BidSearchDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SearchFilter, []string, []string, model.PageQuery) int); ok {
+		r1 = rf(ctx, filter, authorIds, organizationIds, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.SearchFilter, []string, []string, model.PageQuery) error); ok {
+		r2 = rf(ctx, filter, authorIds, organizationIds, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoBidProvider_SearchBids_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchBids'
+type RepoBidProvider_SearchBids_Call struct {
+	*mock.Call
+}
+
+// SearchBids is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.SearchFilter
+//   - authorIds []string
+//   - organizationIds []string
+//   - page model.PageQuery
+func (_e *RepoBidProvider_Expecter) SearchBids(ctx interface{}, filter interface{}, authorIds interface{}, organizationIds interface{}, page interface{}) *RepoBidProvider_SearchBids_Call {
+	return &RepoBidProvider_SearchBids_Call{Call: _e.mock.On("SearchBids", ctx, filter, authorIds, organizationIds, page)}
+}
+
+func (_c *RepoBidProvider_SearchBids_Call) Run(run func(ctx context.Context, filter model.SearchFilter, authorIds []string, organizationIds []string, page model.PageQuery)) *RepoBidProvider_SearchBids_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SearchFilter), args[2].([]string), args[3].([]string), args[4].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoBidProvider_SearchBids_Call) Return(_a0 []model.BidSearchDB, _a1 int, _a2 error) *RepoBidProvider_SearchBids_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoBidProvider_SearchBids_Call) RunAndReturn(run func(context.Context, model.SearchFilter, []string, []string, model.PageQuery) ([]model.BidSearchDB, int, error)) *RepoBidProvider_SearchBids_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoBidProvider creates a new instance of RepoBidProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoBidProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoBidProvider {
+	mock := &RepoBidProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,213 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoCategories is an autogenerated mock type for the RepoCategories type
+type RepoCategories struct {
+	mock.Mock
+}
+
+type RepoCategories_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoCategories) EXPECT() *RepoCategories_Expecter {
+	return &RepoCategories_Expecter{mock: &_m.Mock}
+}
+
+// Categories provides a mock function with given fields: ctx
+func (_m *RepoCategories) Categories(ctx context.Context) ([]model.CategoryDB, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Categories")
+	}
+
+	var r0 []model.CategoryDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.CategoryDB, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.CategoryDB); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CategoryDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoCategories_Categories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Categories'
+type RepoCategories_Categories_Call struct {
+	*mock.Call
+}
+
+// Categories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RepoCategories_Expecter) Categories(ctx interface{}) *RepoCategories_Categories_Call {
+	return &RepoCategories_Categories_Call{Call: _e.mock.On("Categories", ctx)}
+}
+
+func (_c *RepoCategories_Categories_Call) Run(run func(ctx context.Context)) *RepoCategories_Categories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RepoCategories_Categories_Call) Return(_a0 []model.CategoryDB, _a1 error) *RepoCategories_Categories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoCategories_Categories_Call) RunAndReturn(run func(context.Context) ([]model.CategoryDB, error)) *RepoCategories_Categories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, code, name, parentCode
+func (_m *RepoCategories) CreateCategory(ctx context.Context, code string, name string, parentCode string) (model.CategoryDB, error) {
+	ret := _m.Called(ctx, code, name, parentCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 model.CategoryDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (model.CategoryDB, error)); ok {
+		return rf(ctx, code, name, parentCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) model.CategoryDB); ok {
+		r0 = rf(ctx, code, name, parentCode)
+	} else {
+		r0 = ret.Get(0).(model.CategoryDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, code, name, parentCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoCategories_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type RepoCategories_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - name string
+//   - parentCode string
+func (_e *RepoCategories_Expecter) CreateCategory(ctx interface{}, code interface{}, name interface{}, parentCode interface{}) *RepoCategories_CreateCategory_Call {
+	return &RepoCategories_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, code, name, parentCode)}
+}
+
+func (_c *RepoCategories_CreateCategory_Call) Run(run func(ctx context.Context, code string, name string, parentCode string)) *RepoCategories_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoCategories_CreateCategory_Call) Return(_a0 model.CategoryDB, _a1 error) *RepoCategories_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoCategories_CreateCategory_Call) RunAndReturn(run func(context.Context, string, string, string) (model.CategoryDB, error)) *RepoCategories_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditCategory provides a mock function with given fields: ctx, code, name, deprecated
+func (_m *RepoCategories) EditCategory(ctx context.Context, code string, name string, deprecated *bool) (model.CategoryDB, error) {
+	ret := _m.Called(ctx, code, name, deprecated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditCategory")
+	}
+
+	var r0 model.CategoryDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *bool) (model.CategoryDB, error)); ok {
+		return rf(ctx, code, name, deprecated)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *bool) model.CategoryDB); ok {
+		r0 = rf(ctx, code, name, deprecated)
+	} else {
+		r0 = ret.Get(0).(model.CategoryDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *bool) error); ok {
+		r1 = rf(ctx, code, name, deprecated)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoCategories_EditCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditCategory'
+type RepoCategories_EditCategory_Call struct {
+	*mock.Call
+}
+
+// EditCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - name string
+//   - deprecated *bool
+func (_e *RepoCategories_Expecter) EditCategory(ctx interface{}, code interface{}, name interface{}, deprecated interface{}) *RepoCategories_EditCategory_Call {
+	return &RepoCategories_EditCategory_Call{Call: _e.mock.On("EditCategory", ctx, code, name, deprecated)}
+}
+
+func (_c *RepoCategories_EditCategory_Call) Run(run func(ctx context.Context, code string, name string, deprecated *bool)) *RepoCategories_EditCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*bool))
+	})
+	return _c
+}
+
+func (_c *RepoCategories_EditCategory_Call) Return(_a0 model.CategoryDB, _a1 error) *RepoCategories_EditCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoCategories_EditCategory_Call) RunAndReturn(run func(context.Context, string, string, *bool) (model.CategoryDB, error)) *RepoCategories_EditCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoCategories creates a new instance of RepoCategories. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoCategories(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoCategories {
+	mock := &RepoCategories{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoEmployeeEditor is an autogenerated mock type for the RepoEmployeeEditor type
+type RepoEmployeeEditor struct {
+	mock.Mock
+}
+
+type RepoEmployeeEditor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoEmployeeEditor) EXPECT() *RepoEmployeeEditor_Expecter {
+	return &RepoEmployeeEditor_Expecter{mock: &_m.Mock}
+}
+
+// CreateEmployee provides a mock function with given fields: ctx, username, firstName, lastName
+func (_m *RepoEmployeeEditor) CreateEmployee(ctx context.Context, username string, firstName string, lastName string) (string, error) {
+	ret := _m.Called(ctx, username, firstName, lastName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmployee")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, username, firstName, lastName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, username, firstName, lastName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, username, firstName, lastName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoEmployeeEditor_CreateEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmployee'
+type RepoEmployeeEditor_CreateEmployee_Call struct {
+	*mock.Call
+}
+
+// CreateEmployee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - firstName string
+//   - lastName string
+func (_e *RepoEmployeeEditor_Expecter) CreateEmployee(ctx interface{}, username interface{}, firstName interface{}, lastName interface{}) *RepoEmployeeEditor_CreateEmployee_Call {
+	return &RepoEmployeeEditor_CreateEmployee_Call{Call: _e.mock.On("CreateEmployee", ctx, username, firstName, lastName)}
+}
+
+func (_c *RepoEmployeeEditor_CreateEmployee_Call) Run(run func(ctx context.Context, username string, firstName string, lastName string)) *RepoEmployeeEditor_CreateEmployee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_CreateEmployee_Call) Return(_a0 string, _a1 error) *RepoEmployeeEditor_CreateEmployee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_CreateEmployee_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *RepoEmployeeEditor_CreateEmployee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEmployee provides a mock function with given fields: ctx, employeeId
+func (_m *RepoEmployeeEditor) DeleteEmployee(ctx context.Context, employeeId string) error {
+	ret := _m.Called(ctx, employeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmployee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, employeeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoEmployeeEditor_DeleteEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEmployee'
+type RepoEmployeeEditor_DeleteEmployee_Call struct {
+	*mock.Call
+}
+
+// DeleteEmployee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+func (_e *RepoEmployeeEditor_Expecter) DeleteEmployee(ctx interface{}, employeeId interface{}) *RepoEmployeeEditor_DeleteEmployee_Call {
+	return &RepoEmployeeEditor_DeleteEmployee_Call{Call: _e.mock.On("DeleteEmployee", ctx, employeeId)}
+}
+
+func (_c *RepoEmployeeEditor_DeleteEmployee_Call) Run(run func(ctx context.Context, employeeId string)) *RepoEmployeeEditor_DeleteEmployee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_DeleteEmployee_Call) Return(_a0 error) *RepoEmployeeEditor_DeleteEmployee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_DeleteEmployee_Call) RunAndReturn(run func(context.Context, string) error) *RepoEmployeeEditor_DeleteEmployee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditEmployee provides a mock function with given fields: ctx, employeeId, firstName, lastName
+func (_m *RepoEmployeeEditor) EditEmployee(ctx context.Context, employeeId string, firstName string, lastName string) error {
+	ret := _m.Called(ctx, employeeId, firstName, lastName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditEmployee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, employeeId, firstName, lastName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoEmployeeEditor_EditEmployee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditEmployee'
+type RepoEmployeeEditor_EditEmployee_Call struct {
+	*mock.Call
+}
+
+// EditEmployee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+//   - firstName string
+//   - lastName string
+func (_e *RepoEmployeeEditor_Expecter) EditEmployee(ctx interface{}, employeeId interface{}, firstName interface{}, lastName interface{}) *RepoEmployeeEditor_EditEmployee_Call {
+	return &RepoEmployeeEditor_EditEmployee_Call{Call: _e.mock.On("EditEmployee", ctx, employeeId, firstName, lastName)}
+}
+
+func (_c *RepoEmployeeEditor_EditEmployee_Call) Run(run func(ctx context.Context, employeeId string, firstName string, lastName string)) *RepoEmployeeEditor_EditEmployee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_EditEmployee_Call) Return(_a0 error) *RepoEmployeeEditor_EditEmployee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoEmployeeEditor_EditEmployee_Call) RunAndReturn(run func(context.Context, string, string, string) error) *RepoEmployeeEditor_EditEmployee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoEmployeeEditor creates a new instance of RepoEmployeeEditor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoEmployeeEditor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoEmployeeEditor {
+	mock := &RepoEmployeeEditor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,160 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoEmployeeProvider is an autogenerated mock type for the RepoEmployeeProvider type
+type RepoEmployeeProvider struct {
+	mock.Mock
+}
+
+type RepoEmployeeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoEmployeeProvider) EXPECT() *RepoEmployeeProvider_Expecter {
+	return &RepoEmployeeProvider_Expecter{mock: &_m.Mock}
+}
+
+// Employee provides a mock function with given fields: ctx, employeeId
+func (_m *RepoEmployeeProvider) Employee(ctx context.Context, employeeId string) (model.EmployeeDB, error) {
+	ret := _m.Called(ctx, employeeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Employee")
+	}
+
+	var r0 model.EmployeeDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.EmployeeDB, error)); ok {
+		return rf(ctx, employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.EmployeeDB); ok {
+		r0 = rf(ctx, employeeId)
+	} else {
+		r0 = ret.Get(0).(model.EmployeeDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoEmployeeProvider_Employee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Employee'
+type RepoEmployeeProvider_Employee_Call struct {
+	*mock.Call
+}
+
+// Employee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - employeeId string
+func (_e *RepoEmployeeProvider_Expecter) Employee(ctx interface{}, employeeId interface{}) *RepoEmployeeProvider_Employee_Call {
+	return &RepoEmployeeProvider_Employee_Call{Call: _e.mock.On("Employee", ctx, employeeId)}
+}
+
+func (_c *RepoEmployeeProvider_Employee_Call) Run(run func(ctx context.Context, employeeId string)) *RepoEmployeeProvider_Employee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoEmployeeProvider_Employee_Call) Return(_a0 model.EmployeeDB, _a1 error) *RepoEmployeeProvider_Employee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoEmployeeProvider_Employee_Call) RunAndReturn(run func(context.Context, string) (model.EmployeeDB, error)) *RepoEmployeeProvider_Employee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Employees provides a mock function with given fields: ctx, page
+func (_m *RepoEmployeeProvider) Employees(ctx context.Context, page model.PageQuery) ([]model.EmployeeDB, int, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Employees")
+	}
+
+	var r0 []model.EmployeeDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery) ([]model.EmployeeDB, int, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery) []model.EmployeeDB); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.EmployeeDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PageQuery) int); ok {
+		r1 = rf(ctx, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.PageQuery) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoEmployeeProvider_Employees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Employees'
+type RepoEmployeeProvider_Employees_Call struct {
+	*mock.Call
+}
+
+// Employees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page model.PageQuery
+func (_e *RepoEmployeeProvider_Expecter) Employees(ctx interface{}, page interface{}) *RepoEmployeeProvider_Employees_Call {
+	return &RepoEmployeeProvider_Employees_Call{Call: _e.mock.On("Employees", ctx, page)}
+}
+
+func (_c *RepoEmployeeProvider_Employees_Call) Run(run func(ctx context.Context, page model.PageQuery)) *RepoEmployeeProvider_Employees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoEmployeeProvider_Employees_Call) Return(_a0 []model.EmployeeDB, _a1 int, _a2 error) *RepoEmployeeProvider_Employees_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoEmployeeProvider_Employees_Call) RunAndReturn(run func(context.Context, model.PageQuery) ([]model.EmployeeDB, int, error)) *RepoEmployeeProvider_Employees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoEmployeeProvider creates a new instance of RepoEmployeeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoEmployeeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoEmployeeProvider {
+	mock := &RepoEmployeeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,339 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoOrganizationEditor is an autogenerated mock type for the RepoOrganizationEditor type
+type RepoOrganizationEditor struct {
+	mock.Mock
+}
+
+type RepoOrganizationEditor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoOrganizationEditor) EXPECT() *RepoOrganizationEditor_Expecter {
+	return &RepoOrganizationEditor_Expecter{mock: &_m.Mock}
+}
+
+// AddResponsible provides a mock function with given fields: ctx, organizationId, userId, role
+func (_m *RepoOrganizationEditor) AddResponsible(ctx context.Context, organizationId string, userId string, role string) error {
+	ret := _m.Called(ctx, organizationId, userId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddResponsible")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, organizationId, userId, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOrganizationEditor_AddResponsible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddResponsible'
+type RepoOrganizationEditor_AddResponsible_Call struct {
+	*mock.Call
+}
+
+// AddResponsible is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+//   - role string
+func (_e *RepoOrganizationEditor_Expecter) AddResponsible(ctx interface{}, organizationId interface{}, userId interface{}, role interface{}) *RepoOrganizationEditor_AddResponsible_Call {
+	return &RepoOrganizationEditor_AddResponsible_Call{Call: _e.mock.On("AddResponsible", ctx, organizationId, userId, role)}
+}
+
+func (_c *RepoOrganizationEditor_AddResponsible_Call) Run(run func(ctx context.Context, organizationId string, userId string, role string)) *RepoOrganizationEditor_AddResponsible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_AddResponsible_Call) Return(_a0 error) *RepoOrganizationEditor_AddResponsible_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_AddResponsible_Call) RunAndReturn(run func(context.Context, string, string, string) error) *RepoOrganizationEditor_AddResponsible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, name, description, organizationType, responsibleId
+func (_m *RepoOrganizationEditor) CreateOrganization(ctx context.Context, name string, description string, organizationType string, responsibleId string) (string, error) {
+	ret := _m.Called(ctx, name, description, organizationType, responsibleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, name, description, organizationType, responsibleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, name, description, organizationType, responsibleId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, name, description, organizationType, responsibleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoOrganizationEditor_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type RepoOrganizationEditor_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description string
+//   - organizationType string
+//   - responsibleId string
+func (_e *RepoOrganizationEditor_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, organizationType interface{}, responsibleId interface{}) *RepoOrganizationEditor_CreateOrganization_Call {
+	return &RepoOrganizationEditor_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, organizationType, responsibleId)}
+}
+
+func (_c *RepoOrganizationEditor_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description string, organizationType string, responsibleId string)) *RepoOrganizationEditor_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_CreateOrganization_Call) Return(_a0 string, _a1 error) *RepoOrganizationEditor_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, error)) *RepoOrganizationEditor_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganization provides a mock function with given fields: ctx, organizationId
+func (_m *RepoOrganizationEditor) DeleteOrganization(ctx context.Context, organizationId string) error {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOrganizationEditor_DeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganization'
+type RepoOrganizationEditor_DeleteOrganization_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *RepoOrganizationEditor_Expecter) DeleteOrganization(ctx interface{}, organizationId interface{}) *RepoOrganizationEditor_DeleteOrganization_Call {
+	return &RepoOrganizationEditor_DeleteOrganization_Call{Call: _e.mock.On("DeleteOrganization", ctx, organizationId)}
+}
+
+func (_c *RepoOrganizationEditor_DeleteOrganization_Call) Run(run func(ctx context.Context, organizationId string)) *RepoOrganizationEditor_DeleteOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_DeleteOrganization_Call) Return(_a0 error) *RepoOrganizationEditor_DeleteOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_DeleteOrganization_Call) RunAndReturn(run func(context.Context, string) error) *RepoOrganizationEditor_DeleteOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditOrganization provides a mock function with given fields: ctx, organizationId, name, description, organizationType
+func (_m *RepoOrganizationEditor) EditOrganization(ctx context.Context, organizationId string, name string, description string, organizationType string) error {
+	ret := _m.Called(ctx, organizationId, name, description, organizationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditOrganization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, organizationId, name, description, organizationType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOrganizationEditor_EditOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditOrganization'
+type RepoOrganizationEditor_EditOrganization_Call struct {
+	*mock.Call
+}
+
+// EditOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - name string
+//   - description string
+//   - organizationType string
+func (_e *RepoOrganizationEditor_Expecter) EditOrganization(ctx interface{}, organizationId interface{}, name interface{}, description interface{}, organizationType interface{}) *RepoOrganizationEditor_EditOrganization_Call {
+	return &RepoOrganizationEditor_EditOrganization_Call{Call: _e.mock.On("EditOrganization", ctx, organizationId, name, description, organizationType)}
+}
+
+func (_c *RepoOrganizationEditor_EditOrganization_Call) Run(run func(ctx context.Context, organizationId string, name string, description string, organizationType string)) *RepoOrganizationEditor_EditOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_EditOrganization_Call) Return(_a0 error) *RepoOrganizationEditor_EditOrganization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_EditOrganization_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *RepoOrganizationEditor_EditOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveResponsible provides a mock function with given fields: ctx, organizationId, userId
+func (_m *RepoOrganizationEditor) RemoveResponsible(ctx context.Context, organizationId string, userId string) error {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveResponsible")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOrganizationEditor_RemoveResponsible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveResponsible'
+type RepoOrganizationEditor_RemoveResponsible_Call struct {
+	*mock.Call
+}
+
+// RemoveResponsible is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+func (_e *RepoOrganizationEditor_Expecter) RemoveResponsible(ctx interface{}, organizationId interface{}, userId interface{}) *RepoOrganizationEditor_RemoveResponsible_Call {
+	return &RepoOrganizationEditor_RemoveResponsible_Call{Call: _e.mock.On("RemoveResponsible", ctx, organizationId, userId)}
+}
+
+func (_c *RepoOrganizationEditor_RemoveResponsible_Call) Run(run func(ctx context.Context, organizationId string, userId string)) *RepoOrganizationEditor_RemoveResponsible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_RemoveResponsible_Call) Return(_a0 error) *RepoOrganizationEditor_RemoveResponsible_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_RemoveResponsible_Call) RunAndReturn(run func(context.Context, string, string) error) *RepoOrganizationEditor_RemoveResponsible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRole provides a mock function with given fields: ctx, organizationId, userId, role
+func (_m *RepoOrganizationEditor) SetRole(ctx context.Context, organizationId string, userId string, role string) error {
+	ret := _m.Called(ctx, organizationId, userId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, organizationId, userId, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOrganizationEditor_SetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRole'
+type RepoOrganizationEditor_SetRole_Call struct {
+	*mock.Call
+}
+
+// SetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - userId string
+//   - role string
+func (_e *RepoOrganizationEditor_Expecter) SetRole(ctx interface{}, organizationId interface{}, userId interface{}, role interface{}) *RepoOrganizationEditor_SetRole_Call {
+	return &RepoOrganizationEditor_SetRole_Call{Call: _e.mock.On("SetRole", ctx, organizationId, userId, role)}
+}
+
+func (_c *RepoOrganizationEditor_SetRole_Call) Run(run func(ctx context.Context, organizationId string, userId string, role string)) *RepoOrganizationEditor_SetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_SetRole_Call) Return(_a0 error) *RepoOrganizationEditor_SetRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOrganizationEditor_SetRole_Call) RunAndReturn(run func(context.Context, string, string, string) error) *RepoOrganizationEditor_SetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoOrganizationEditor creates a new instance of RepoOrganizationEditor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoOrganizationEditor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoOrganizationEditor {
+	mock := &RepoOrganizationEditor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,219 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoOrganizationProvider is an autogenerated mock type for the RepoOrganizationProvider type
+type RepoOrganizationProvider struct {
+	mock.Mock
+}
+
+type RepoOrganizationProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoOrganizationProvider) EXPECT() *RepoOrganizationProvider_Expecter {
+	return &RepoOrganizationProvider_Expecter{mock: &_m.Mock}
+}
+
+// Organization provides a mock function with given fields: ctx, organizationId
+func (_m *RepoOrganizationProvider) Organization(ctx context.Context, organizationId string) (model.OrganizationDB, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Organization")
+	}
+
+	var r0 model.OrganizationDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.OrganizationDB, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.OrganizationDB); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		r0 = ret.Get(0).(model.OrganizationDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoOrganizationProvider_Organization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Organization'
+type RepoOrganizationProvider_Organization_Call struct {
+	*mock.Call
+}
+
+// Organization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *RepoOrganizationProvider_Expecter) Organization(ctx interface{}, organizationId interface{}) *RepoOrganizationProvider_Organization_Call {
+	return &RepoOrganizationProvider_Organization_Call{Call: _e.mock.On("Organization", ctx, organizationId)}
+}
+
+func (_c *RepoOrganizationProvider_Organization_Call) Run(run func(ctx context.Context, organizationId string)) *RepoOrganizationProvider_Organization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Organization_Call) Return(_a0 model.OrganizationDB, _a1 error) *RepoOrganizationProvider_Organization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Organization_Call) RunAndReturn(run func(context.Context, string) (model.OrganizationDB, error)) *RepoOrganizationProvider_Organization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Organizations provides a mock function with given fields: ctx, page
+func (_m *RepoOrganizationProvider) Organizations(ctx context.Context, page model.PageQuery) ([]model.OrganizationDB, int, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Organizations")
+	}
+
+	var r0 []model.OrganizationDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery) ([]model.OrganizationDB, int, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery) []model.OrganizationDB); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrganizationDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PageQuery) int); ok {
+		r1 = rf(ctx, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.PageQuery) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoOrganizationProvider_Organizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Organizations'
+type RepoOrganizationProvider_Organizations_Call struct {
+	*mock.Call
+}
+
+// Organizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page model.PageQuery
+func (_e *RepoOrganizationProvider_Expecter) Organizations(ctx interface{}, page interface{}) *RepoOrganizationProvider_Organizations_Call {
+	return &RepoOrganizationProvider_Organizations_Call{Call: _e.mock.On("Organizations", ctx, page)}
+}
+
+func (_c *RepoOrganizationProvider_Organizations_Call) Run(run func(ctx context.Context, page model.PageQuery)) *RepoOrganizationProvider_Organizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Organizations_Call) Return(_a0 []model.OrganizationDB, _a1 int, _a2 error) *RepoOrganizationProvider_Organizations_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Organizations_Call) RunAndReturn(run func(context.Context, model.PageQuery) ([]model.OrganizationDB, int, error)) *RepoOrganizationProvider_Organizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Responsibles provides a mock function with given fields: ctx, organizationId
+func (_m *RepoOrganizationProvider) Responsibles(ctx context.Context, organizationId string) ([]model.MemberDB, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Responsibles")
+	}
+
+	var r0 []model.MemberDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.MemberDB, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.MemberDB); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MemberDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoOrganizationProvider_Responsibles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Responsibles'
+type RepoOrganizationProvider_Responsibles_Call struct {
+	*mock.Call
+}
+
+// Responsibles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *RepoOrganizationProvider_Expecter) Responsibles(ctx interface{}, organizationId interface{}) *RepoOrganizationProvider_Responsibles_Call {
+	return &RepoOrganizationProvider_Responsibles_Call{Call: _e.mock.On("Responsibles", ctx, organizationId)}
+}
+
+func (_c *RepoOrganizationProvider_Responsibles_Call) Run(run func(ctx context.Context, organizationId string)) *RepoOrganizationProvider_Responsibles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Responsibles_Call) Return(_a0 []model.MemberDB, _a1 error) *RepoOrganizationProvider_Responsibles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoOrganizationProvider_Responsibles_Call) RunAndReturn(run func(context.Context, string) ([]model.MemberDB, error)) *RepoOrganizationProvider_Responsibles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoOrganizationProvider creates a new instance of RepoOrganizationProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoOrganizationProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoOrganizationProvider {
+	mock := &RepoOrganizationProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,209 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepoOutbox is an autogenerated mock type for the RepoOutbox type
+type RepoOutbox struct {
+	mock.Mock
+}
+
+type RepoOutbox_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoOutbox) EXPECT() *RepoOutbox_Expecter {
+	return &RepoOutbox_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDeliveries provides a mock function with given fields: ctx, now, lease, limit
+func (_m *RepoOutbox) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int32) ([]model.Delivery, error) {
+	ret := _m.Called(ctx, now, lease, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDeliveries")
+	}
+
+	var r0 []model.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int32) ([]model.Delivery, error)); ok {
+		return rf(ctx, now, lease, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int32) []model.Delivery); ok {
+		r0 = rf(ctx, now, lease, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Duration, int32) error); ok {
+		r1 = rf(ctx, now, lease, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoOutbox_ClaimDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDeliveries'
+type RepoOutbox_ClaimDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - lease time.Duration
+//   - limit int32
+func (_e *RepoOutbox_Expecter) ClaimDeliveries(ctx interface{}, now interface{}, lease interface{}, limit interface{}) *RepoOutbox_ClaimDeliveries_Call {
+	return &RepoOutbox_ClaimDeliveries_Call{Call: _e.mock.On("ClaimDeliveries", ctx, now, lease, limit)}
+}
+
+func (_c *RepoOutbox_ClaimDeliveries_Call) Run(run func(ctx context.Context, now time.Time, lease time.Duration, limit int32)) *RepoOutbox_ClaimDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Duration), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoOutbox_ClaimDeliveries_Call) Return(_a0 []model.Delivery, _a1 error) *RepoOutbox_ClaimDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoOutbox_ClaimDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, time.Duration, int32) ([]model.Delivery, error)) *RepoOutbox_ClaimDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FanOutEvents provides a mock function with given fields: ctx, limit
+func (_m *RepoOutbox) FanOutEvents(ctx context.Context, limit int32) (int, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FanOutEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (int, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) int); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoOutbox_FanOutEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanOutEvents'
+type RepoOutbox_FanOutEvents_Call struct {
+	*mock.Call
+}
+
+// FanOutEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int32
+func (_e *RepoOutbox_Expecter) FanOutEvents(ctx interface{}, limit interface{}) *RepoOutbox_FanOutEvents_Call {
+	return &RepoOutbox_FanOutEvents_Call{Call: _e.mock.On("FanOutEvents", ctx, limit)}
+}
+
+func (_c *RepoOutbox_FanOutEvents_Call) Run(run func(ctx context.Context, limit int32)) *RepoOutbox_FanOutEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoOutbox_FanOutEvents_Call) Return(_a0 int, _a1 error) *RepoOutbox_FanOutEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoOutbox_FanOutEvents_Call) RunAndReturn(run func(context.Context, int32) (int, error)) *RepoOutbox_FanOutEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDelivery provides a mock function with given fields: ctx, deliveryId, status, attempts, responseCode, errText, nextAttemptAt
+func (_m *RepoOutbox) RecordDelivery(ctx context.Context, deliveryId string, status string, attempts int32, responseCode int, errText string, nextAttemptAt time.Time) error {
+	ret := _m.Called(ctx, deliveryId, status, attempts, responseCode, errText, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int, string, time.Time) error); ok {
+		r0 = rf(ctx, deliveryId, status, attempts, responseCode, errText, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoOutbox_RecordDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDelivery'
+type RepoOutbox_RecordDelivery_Call struct {
+	*mock.Call
+}
+
+// RecordDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryId string
+//   - status string
+//   - attempts int32
+//   - responseCode int
+//   - errText string
+//   - nextAttemptAt time.Time
+func (_e *RepoOutbox_Expecter) RecordDelivery(ctx interface{}, deliveryId interface{}, status interface{}, attempts interface{}, responseCode interface{}, errText interface{}, nextAttemptAt interface{}) *RepoOutbox_RecordDelivery_Call {
+	return &RepoOutbox_RecordDelivery_Call{Call: _e.mock.On("RecordDelivery", ctx, deliveryId, status, attempts, responseCode, errText, nextAttemptAt)}
+}
+
+func (_c *RepoOutbox_RecordDelivery_Call) Run(run func(ctx context.Context, deliveryId string, status string, attempts int32, responseCode int, errText string, nextAttemptAt time.Time)) *RepoOutbox_RecordDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int), args[5].(string), args[6].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RepoOutbox_RecordDelivery_Call) Return(_a0 error) *RepoOutbox_RecordDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoOutbox_RecordDelivery_Call) RunAndReturn(run func(context.Context, string, string, int32, int, string, time.Time) error) *RepoOutbox_RecordDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoOutbox creates a new instance of RepoOutbox. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoOutbox(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoOutbox {
+	mock := &RepoOutbox{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,103 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	quorum "zadanie-6105/internal/domain/quorum"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepoTenderCreator is an autogenerated mock type for the RepoTenderCreator type
+type RepoTenderCreator struct {
+	mock.Mock
+}
+
+type RepoTenderCreator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoTenderCreator) EXPECT() *RepoTenderCreator_Expecter {
+	return &RepoTenderCreator_Expecter{mock: &_m.Mock}
+}
+
+// CreateTender provides a mock function with given fields: ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy
+func (_m *RepoTenderCreator) CreateTender(ctx context.Context, name string, description string, serviceType string, organizationId string, creatorUsername string, submissionDeadline *time.Time, decisionDeadline *time.Time, decisionPolicy quorum.Policy) (string, error) {
+	ret := _m.Called(ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTender")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, *time.Time, *time.Time, quorum.Policy) (string, error)); ok {
+		return rf(ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, *time.Time, *time.Time, quorum.Policy) string); ok {
+		r0 = rf(ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, *time.Time, *time.Time, quorum.Policy) error); ok {
+		r1 = rf(ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderCreator_CreateTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTender'
+type RepoTenderCreator_CreateTender_Call struct {
+	*mock.Call
+}
+
+// CreateTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description string
+//   - serviceType string
+//   - organizationId string
+//   - creatorUsername string
+//   - submissionDeadline *time.Time
+//   - decisionDeadline *time.Time
+//   - decisionPolicy quorum.Policy
+func (_e *RepoTenderCreator_Expecter) CreateTender(ctx interface{}, name interface{}, description interface{}, serviceType interface{}, organizationId interface{}, creatorUsername interface{}, submissionDeadline interface{}, decisionDeadline interface{}, decisionPolicy interface{}) *RepoTenderCreator_CreateTender_Call {
+	return &RepoTenderCreator_CreateTender_Call{Call: _e.mock.On("CreateTender", ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)}
+}
+
+func (_c *RepoTenderCreator_CreateTender_Call) Run(run func(ctx context.Context, name string, description string, serviceType string, organizationId string, creatorUsername string, submissionDeadline *time.Time, decisionDeadline *time.Time, decisionPolicy quorum.Policy)) *RepoTenderCreator_CreateTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(*time.Time), args[7].(*time.Time), args[8].(quorum.Policy))
+	})
+	return _c
+}
+
+func (_c *RepoTenderCreator_CreateTender_Call) Return(_a0 string, _a1 error) *RepoTenderCreator_CreateTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderCreator_CreateTender_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, *time.Time, *time.Time, quorum.Policy) (string, error)) *RepoTenderCreator_CreateTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoTenderCreator creates a new instance of RepoTenderCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoTenderCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoTenderCreator {
+	mock := &RepoTenderCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,219 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepoTenderEditor is an autogenerated mock type for the RepoTenderEditor type
+type RepoTenderEditor struct {
+	mock.Mock
+}
+
+type RepoTenderEditor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoTenderEditor) EXPECT() *RepoTenderEditor_Expecter {
+	return &RepoTenderEditor_Expecter{mock: &_m.Mock}
+}
+
+// ChangeTenderStatus provides a mock function with given fields: ctx, id, status, expectedVersion
+func (_m *RepoTenderEditor) ChangeTenderStatus(ctx context.Context, id string, status string, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, id, status, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeTenderStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) (string, error)); ok {
+		return rf(ctx, id, status, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) string); ok {
+		r0 = rf(ctx, id, status, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, id, status, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderEditor_ChangeTenderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeTenderStatus'
+type RepoTenderEditor_ChangeTenderStatus_Call struct {
+	*mock.Call
+}
+
+// ChangeTenderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status string
+//   - expectedVersion int32
+func (_e *RepoTenderEditor_Expecter) ChangeTenderStatus(ctx interface{}, id interface{}, status interface{}, expectedVersion interface{}) *RepoTenderEditor_ChangeTenderStatus_Call {
+	return &RepoTenderEditor_ChangeTenderStatus_Call{Call: _e.mock.On("ChangeTenderStatus", ctx, id, status, expectedVersion)}
+}
+
+func (_c *RepoTenderEditor_ChangeTenderStatus_Call) Run(run func(ctx context.Context, id string, status string, expectedVersion int32)) *RepoTenderEditor_ChangeTenderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoTenderEditor_ChangeTenderStatus_Call) Return(_a0 string, _a1 error) *RepoTenderEditor_ChangeTenderStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderEditor_ChangeTenderStatus_Call) RunAndReturn(run func(context.Context, string, string, int32) (string, error)) *RepoTenderEditor_ChangeTenderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditTender provides a mock function with given fields: ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion
+func (_m *RepoTenderEditor) EditTender(ctx context.Context, id string, name string, description string, serviceType string, submissionDeadline *time.Time, decisionDeadline *time.Time, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditTender")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *time.Time, *time.Time, int32) (string, error)); ok {
+		return rf(ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *time.Time, *time.Time, int32) string); ok {
+		r0 = rf(ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, *time.Time, *time.Time, int32) error); ok {
+		r1 = rf(ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderEditor_EditTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditTender'
+type RepoTenderEditor_EditTender_Call struct {
+	*mock.Call
+}
+
+// EditTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - name string
+//   - description string
+//   - serviceType string
+//   - submissionDeadline *time.Time
+//   - decisionDeadline *time.Time
+//   - expectedVersion int32
+func (_e *RepoTenderEditor_Expecter) EditTender(ctx interface{}, id interface{}, name interface{}, description interface{}, serviceType interface{}, submissionDeadline interface{}, decisionDeadline interface{}, expectedVersion interface{}) *RepoTenderEditor_EditTender_Call {
+	return &RepoTenderEditor_EditTender_Call{Call: _e.mock.On("EditTender", ctx, id, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)}
+}
+
+func (_c *RepoTenderEditor_EditTender_Call) Run(run func(ctx context.Context, id string, name string, description string, serviceType string, submissionDeadline *time.Time, decisionDeadline *time.Time, expectedVersion int32)) *RepoTenderEditor_EditTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(*time.Time), args[6].(*time.Time), args[7].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoTenderEditor_EditTender_Call) Return(_a0 string, _a1 error) *RepoTenderEditor_EditTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderEditor_EditTender_Call) RunAndReturn(run func(context.Context, string, string, string, string, *time.Time, *time.Time, int32) (string, error)) *RepoTenderEditor_EditTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackTender provides a mock function with given fields: ctx, id, version, expectedVersion
+func (_m *RepoTenderEditor) RollbackTender(ctx context.Context, id string, version int32, expectedVersion int32) (string, error) {
+	ret := _m.Called(ctx, id, version, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackTender")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) (string, error)); ok {
+		return rf(ctx, id, version, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) string); ok {
+		r0 = rf(ctx, id, version, expectedVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
+		r1 = rf(ctx, id, version, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderEditor_RollbackTender_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackTender'
+type RepoTenderEditor_RollbackTender_Call struct {
+	*mock.Call
+}
+
+// RollbackTender is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version int32
+//   - expectedVersion int32
+func (_e *RepoTenderEditor_Expecter) RollbackTender(ctx interface{}, id interface{}, version interface{}, expectedVersion interface{}) *RepoTenderEditor_RollbackTender_Call {
+	return &RepoTenderEditor_RollbackTender_Call{Call: _e.mock.On("RollbackTender", ctx, id, version, expectedVersion)}
+}
+
+func (_c *RepoTenderEditor_RollbackTender_Call) Run(run func(ctx context.Context, id string, version int32, expectedVersion int32)) *RepoTenderEditor_RollbackTender_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoTenderEditor_RollbackTender_Call) Return(_a0 string, _a1 error) *RepoTenderEditor_RollbackTender_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderEditor_RollbackTender_Call) RunAndReturn(run func(context.Context, string, int32, int32) (string, error)) *RepoTenderEditor_RollbackTender_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoTenderEditor creates a new instance of RepoTenderEditor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoTenderEditor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoTenderEditor {
+	mock := &RepoTenderEditor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,474 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RepoTenderProvider is an autogenerated mock type for the RepoTenderProvider type
+type RepoTenderProvider struct {
+	mock.Mock
+}
+
+type RepoTenderProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoTenderProvider) EXPECT() *RepoTenderProvider_Expecter {
+	return &RepoTenderProvider_Expecter{mock: &_m.Mock}
+}
+
+// ExpiredTenders provides a mock function with given fields: ctx, now
+func (_m *RepoTenderProvider) ExpiredTenders(ctx context.Context, now time.Time) ([]model.TenderDB, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpiredTenders")
+	}
+
+	var r0 []model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.TenderDB, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.TenderDB); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TenderDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderProvider_ExpiredTenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiredTenders'
+type RepoTenderProvider_ExpiredTenders_Call struct {
+	*mock.Call
+}
+
+// ExpiredTenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *RepoTenderProvider_Expecter) ExpiredTenders(ctx interface{}, now interface{}) *RepoTenderProvider_ExpiredTenders_Call {
+	return &RepoTenderProvider_ExpiredTenders_Call{Call: _e.mock.On("ExpiredTenders", ctx, now)}
+}
+
+func (_c *RepoTenderProvider_ExpiredTenders_Call) Run(run func(ctx context.Context, now time.Time)) *RepoTenderProvider_ExpiredTenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_ExpiredTenders_Call) Return(_a0 []model.TenderDB, _a1 error) *RepoTenderProvider_ExpiredTenders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderProvider_ExpiredTenders_Call) RunAndReturn(run func(context.Context, time.Time) ([]model.TenderDB, error)) *RepoTenderProvider_ExpiredTenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTenders provides a mock function with given fields: ctx, filter, organizationIds, page
+func (_m *RepoTenderProvider) SearchTenders(ctx context.Context, filter model.SearchFilter, organizationIds []string, page model.PageQuery) ([]model.TenderSearchDB, int, error) {
+	ret := _m.Called(ctx, filter, organizationIds, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTenders")
+	}
+
+	var r0 []model.TenderSearchDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter, []string, model.PageQuery) ([]model.TenderSearchDB, int, error)); ok {
+		return rf(ctx, filter, organizationIds, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter, []string, model.PageQuery) []model.TenderSearchDB); ok {
+		r0 = rf(ctx, filter, organizationIds, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TenderSearchDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SearchFilter, []string, model.PageQuery) int); ok {
+		r1 = rf(ctx, filter, organizationIds, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.SearchFilter, []string, model.PageQuery) error); ok {
+		r2 = rf(ctx, filter, organizationIds, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoTenderProvider_SearchTenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTenders'
+type RepoTenderProvider_SearchTenders_Call struct {
+	*mock.Call
+}
+
+// SearchTenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.SearchFilter
+//   - organizationIds []string
+//   - page model.PageQuery
+func (_e *RepoTenderProvider_Expecter) SearchTenders(ctx interface{}, filter interface{}, organizationIds interface{}, page interface{}) *RepoTenderProvider_SearchTenders_Call {
+	return &RepoTenderProvider_SearchTenders_Call{Call: _e.mock.On("SearchTenders", ctx, filter, organizationIds, page)}
+}
+
+func (_c *RepoTenderProvider_SearchTenders_Call) Run(run func(ctx context.Context, filter model.SearchFilter, organizationIds []string, page model.PageQuery)) *RepoTenderProvider_SearchTenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SearchFilter), args[2].([]string), args[3].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_SearchTenders_Call) Return(_a0 []model.TenderSearchDB, _a1 int, _a2 error) *RepoTenderProvider_SearchTenders_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoTenderProvider_SearchTenders_Call) RunAndReturn(run func(context.Context, model.SearchFilter, []string, model.PageQuery) ([]model.TenderSearchDB, int, error)) *RepoTenderProvider_SearchTenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: ctx, id
+func (_m *RepoTenderProvider) Status(ctx context.Context, id string) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderProvider_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type RepoTenderProvider_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RepoTenderProvider_Expecter) Status(ctx interface{}, id interface{}) *RepoTenderProvider_Status_Call {
+	return &RepoTenderProvider_Status_Call{Call: _e.mock.On("Status", ctx, id)}
+}
+
+func (_c *RepoTenderProvider_Status_Call) Run(run func(ctx context.Context, id string)) *RepoTenderProvider_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_Status_Call) Return(_a0 string, _a1 error) *RepoTenderProvider_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderProvider_Status_Call) RunAndReturn(run func(context.Context, string) (string, error)) *RepoTenderProvider_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenderVersion provides a mock function with given fields: ctx, tenderId, version
+func (_m *RepoTenderProvider) TenderVersion(ctx context.Context, tenderId string, version int32) (model.TenderDB, error) {
+	ret := _m.Called(ctx, tenderId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderVersion")
+	}
+
+	var r0 model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) (model.TenderDB, error)); ok {
+		return rf(ctx, tenderId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) model.TenderDB); ok {
+		r0 = rf(ctx, tenderId, version)
+	} else {
+		r0 = ret.Get(0).(model.TenderDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, tenderId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderProvider_TenderVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenderVersion'
+type RepoTenderProvider_TenderVersion_Call struct {
+	*mock.Call
+}
+
+// TenderVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+//   - version int32
+func (_e *RepoTenderProvider_Expecter) TenderVersion(ctx interface{}, tenderId interface{}, version interface{}) *RepoTenderProvider_TenderVersion_Call {
+	return &RepoTenderProvider_TenderVersion_Call{Call: _e.mock.On("TenderVersion", ctx, tenderId, version)}
+}
+
+func (_c *RepoTenderProvider_TenderVersion_Call) Run(run func(ctx context.Context, tenderId string, version int32)) *RepoTenderProvider_TenderVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_TenderVersion_Call) Return(_a0 model.TenderDB, _a1 error) *RepoTenderProvider_TenderVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderProvider_TenderVersion_Call) RunAndReturn(run func(context.Context, string, int32) (model.TenderDB, error)) *RepoTenderProvider_TenderVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenderVersions provides a mock function with given fields: ctx, tenderId
+func (_m *RepoTenderProvider) TenderVersions(ctx context.Context, tenderId string) ([]model.TenderDB, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderVersions")
+	}
+
+	var r0 []model.TenderDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.TenderDB, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.TenderDB); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TenderDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoTenderProvider_TenderVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenderVersions'
+type RepoTenderProvider_TenderVersions_Call struct {
+	*mock.Call
+}
+
+// TenderVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenderId string
+func (_e *RepoTenderProvider_Expecter) TenderVersions(ctx interface{}, tenderId interface{}) *RepoTenderProvider_TenderVersions_Call {
+	return &RepoTenderProvider_TenderVersions_Call{Call: _e.mock.On("TenderVersions", ctx, tenderId)}
+}
+
+func (_c *RepoTenderProvider_TenderVersions_Call) Run(run func(ctx context.Context, tenderId string)) *RepoTenderProvider_TenderVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_TenderVersions_Call) Return(_a0 []model.TenderDB, _a1 error) *RepoTenderProvider_TenderVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoTenderProvider_TenderVersions_Call) RunAndReturn(run func(context.Context, string) ([]model.TenderDB, error)) *RepoTenderProvider_TenderVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tenders provides a mock function with given fields: ctx, page, serviceTypes
+func (_m *RepoTenderProvider) Tenders(ctx context.Context, page model.PageQuery, serviceTypes []string) ([]model.TenderDB, int, error) {
+	ret := _m.Called(ctx, page, serviceTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tenders")
+	}
+
+	var r0 []model.TenderDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) ([]model.TenderDB, int, error)); ok {
+		return rf(ctx, page, serviceTypes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) []model.TenderDB); ok {
+		r0 = rf(ctx, page, serviceTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TenderDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PageQuery, []string) int); ok {
+		r1 = rf(ctx, page, serviceTypes)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.PageQuery, []string) error); ok {
+		r2 = rf(ctx, page, serviceTypes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoTenderProvider_Tenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tenders'
+type RepoTenderProvider_Tenders_Call struct {
+	*mock.Call
+}
+
+// Tenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page model.PageQuery
+//   - serviceTypes []string
+func (_e *RepoTenderProvider_Expecter) Tenders(ctx interface{}, page interface{}, serviceTypes interface{}) *RepoTenderProvider_Tenders_Call {
+	return &RepoTenderProvider_Tenders_Call{Call: _e.mock.On("Tenders", ctx, page, serviceTypes)}
+}
+
+func (_c *RepoTenderProvider_Tenders_Call) Run(run func(ctx context.Context, page model.PageQuery, serviceTypes []string)) *RepoTenderProvider_Tenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PageQuery), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_Tenders_Call) Return(_a0 []model.TenderDB, _a1 int, _a2 error) *RepoTenderProvider_Tenders_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoTenderProvider_Tenders_Call) RunAndReturn(run func(context.Context, model.PageQuery, []string) ([]model.TenderDB, int, error)) *RepoTenderProvider_Tenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TendersByOrganizations provides a mock function with given fields: ctx, page, organizationIds
+func (_m *RepoTenderProvider) TendersByOrganizations(ctx context.Context, page model.PageQuery, organizationIds []string) ([]model.TenderDB, int, error) {
+	ret := _m.Called(ctx, page, organizationIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TendersByOrganizations")
+	}
+
+	var r0 []model.TenderDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) ([]model.TenderDB, int, error)); ok {
+		return rf(ctx, page, organizationIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PageQuery, []string) []model.TenderDB); ok {
+		r0 = rf(ctx, page, organizationIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TenderDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PageQuery, []string) int); ok {
+		r1 = rf(ctx, page, organizationIds)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.PageQuery, []string) error); ok {
+		r2 = rf(ctx, page, organizationIds)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoTenderProvider_TendersByOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TendersByOrganizations'
+type RepoTenderProvider_TendersByOrganizations_Call struct {
+	*mock.Call
+}
+
+// TendersByOrganizations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page model.PageQuery
+//   - organizationIds []string
+func (_e *RepoTenderProvider_Expecter) TendersByOrganizations(ctx interface{}, page interface{}, organizationIds interface{}) *RepoTenderProvider_TendersByOrganizations_Call {
+	return &RepoTenderProvider_TendersByOrganizations_Call{Call: _e.mock.On("TendersByOrganizations", ctx, page, organizationIds)}
+}
+
+func (_c *RepoTenderProvider_TendersByOrganizations_Call) Run(run func(ctx context.Context, page model.PageQuery, organizationIds []string)) *RepoTenderProvider_TendersByOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PageQuery), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *RepoTenderProvider_TendersByOrganizations_Call) Return(_a0 []model.TenderDB, _a1 int, _a2 error) *RepoTenderProvider_TendersByOrganizations_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoTenderProvider_TendersByOrganizations_Call) RunAndReturn(run func(context.Context, model.PageQuery, []string) ([]model.TenderDB, int, error)) *RepoTenderProvider_TendersByOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoTenderProvider creates a new instance of RepoTenderProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoTenderProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoTenderProvider {
+	mock := &RepoTenderProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
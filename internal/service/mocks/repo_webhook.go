@@ -0,0 +1,327 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepoWebhook is an autogenerated mock type for the RepoWebhook type
+type RepoWebhook struct {
+	mock.Mock
+}
+
+type RepoWebhook_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoWebhook) EXPECT() *RepoWebhook_Expecter {
+	return &RepoWebhook_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, organizationId, url, secret, events
+func (_m *RepoWebhook) CreateWebhook(ctx context.Context, organizationId string, url string, secret string, events []string) (string, error) {
+	ret := _m.Called(ctx, organizationId, url, secret, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string) (string, error)); ok {
+		return rf(ctx, organizationId, url, secret, events)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string) string); ok {
+		r0 = rf(ctx, organizationId, url, secret, events)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []string) error); ok {
+		r1 = rf(ctx, organizationId, url, secret, events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoWebhook_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type RepoWebhook_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+//   - url string
+//   - secret string
+//   - events []string
+func (_e *RepoWebhook_Expecter) CreateWebhook(ctx interface{}, organizationId interface{}, url interface{}, secret interface{}, events interface{}) *RepoWebhook_CreateWebhook_Call {
+	return &RepoWebhook_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, organizationId, url, secret, events)}
+}
+
+func (_c *RepoWebhook_CreateWebhook_Call) Run(run func(ctx context.Context, organizationId string, url string, secret string, events []string)) *RepoWebhook_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *RepoWebhook_CreateWebhook_Call) Return(_a0 string, _a1 error) *RepoWebhook_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoWebhook_CreateWebhook_Call) RunAndReturn(run func(context.Context, string, string, string, []string) (string, error)) *RepoWebhook_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, webhookId
+func (_m *RepoWebhook) DeleteWebhook(ctx context.Context, webhookId string) error {
+	ret := _m.Called(ctx, webhookId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, webhookId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepoWebhook_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type RepoWebhook_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+func (_e *RepoWebhook_Expecter) DeleteWebhook(ctx interface{}, webhookId interface{}) *RepoWebhook_DeleteWebhook_Call {
+	return &RepoWebhook_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookId)}
+}
+
+func (_c *RepoWebhook_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookId string)) *RepoWebhook_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoWebhook_DeleteWebhook_Call) Return(_a0 error) *RepoWebhook_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepoWebhook_DeleteWebhook_Call) RunAndReturn(run func(context.Context, string) error) *RepoWebhook_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Webhook provides a mock function with given fields: ctx, webhookId
+func (_m *RepoWebhook) Webhook(ctx context.Context, webhookId string) (model.WebhookDB, error) {
+	ret := _m.Called(ctx, webhookId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Webhook")
+	}
+
+	var r0 model.WebhookDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.WebhookDB, error)); ok {
+		return rf(ctx, webhookId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.WebhookDB); ok {
+		r0 = rf(ctx, webhookId)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDB)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, webhookId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoWebhook_Webhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Webhook'
+type RepoWebhook_Webhook_Call struct {
+	*mock.Call
+}
+
+// Webhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+func (_e *RepoWebhook_Expecter) Webhook(ctx interface{}, webhookId interface{}) *RepoWebhook_Webhook_Call {
+	return &RepoWebhook_Webhook_Call{Call: _e.mock.On("Webhook", ctx, webhookId)}
+}
+
+func (_c *RepoWebhook_Webhook_Call) Run(run func(ctx context.Context, webhookId string)) *RepoWebhook_Webhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoWebhook_Webhook_Call) Return(_a0 model.WebhookDB, _a1 error) *RepoWebhook_Webhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoWebhook_Webhook_Call) RunAndReturn(run func(context.Context, string) (model.WebhookDB, error)) *RepoWebhook_Webhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookDeliveries provides a mock function with given fields: ctx, webhookId, page
+func (_m *RepoWebhook) WebhookDeliveries(ctx context.Context, webhookId string, page model.PageQuery) ([]model.WebhookDeliveryDB, int, error) {
+	ret := _m.Called(ctx, webhookId, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDeliveryDB
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PageQuery) ([]model.WebhookDeliveryDB, int, error)); ok {
+		return rf(ctx, webhookId, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PageQuery) []model.WebhookDeliveryDB); ok {
+		r0 = rf(ctx, webhookId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDeliveryDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PageQuery) int); ok {
+		r1 = rf(ctx, webhookId, page)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.PageQuery) error); ok {
+		r2 = rf(ctx, webhookId, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RepoWebhook_WebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveries'
+type RepoWebhook_WebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// WebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+//   - page model.PageQuery
+func (_e *RepoWebhook_Expecter) WebhookDeliveries(ctx interface{}, webhookId interface{}, page interface{}) *RepoWebhook_WebhookDeliveries_Call {
+	return &RepoWebhook_WebhookDeliveries_Call{Call: _e.mock.On("WebhookDeliveries", ctx, webhookId, page)}
+}
+
+func (_c *RepoWebhook_WebhookDeliveries_Call) Run(run func(ctx context.Context, webhookId string, page model.PageQuery)) *RepoWebhook_WebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepoWebhook_WebhookDeliveries_Call) Return(_a0 []model.WebhookDeliveryDB, _a1 int, _a2 error) *RepoWebhook_WebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RepoWebhook_WebhookDeliveries_Call) RunAndReturn(run func(context.Context, string, model.PageQuery) ([]model.WebhookDeliveryDB, int, error)) *RepoWebhook_WebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Webhooks provides a mock function with given fields: ctx, organizationId
+func (_m *RepoWebhook) Webhooks(ctx context.Context, organizationId string) ([]model.WebhookDB, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Webhooks")
+	}
+
+	var r0 []model.WebhookDB
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.WebhookDB, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.WebhookDB); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDB)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoWebhook_Webhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Webhooks'
+type RepoWebhook_Webhooks_Call struct {
+	*mock.Call
+}
+
+// Webhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *RepoWebhook_Expecter) Webhooks(ctx interface{}, organizationId interface{}) *RepoWebhook_Webhooks_Call {
+	return &RepoWebhook_Webhooks_Call{Call: _e.mock.On("Webhooks", ctx, organizationId)}
+}
+
+func (_c *RepoWebhook_Webhooks_Call) Run(run func(ctx context.Context, organizationId string)) *RepoWebhook_Webhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepoWebhook_Webhooks_Call) Return(_a0 []model.WebhookDB, _a1 error) *RepoWebhook_Webhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepoWebhook_Webhooks_Call) RunAndReturn(run func(context.Context, string) ([]model.WebhookDB, error)) *RepoWebhook_Webhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepoWebhook creates a new instance of RepoWebhook. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoWebhook(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoWebhook {
+	mock := &RepoWebhook{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
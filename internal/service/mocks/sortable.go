@@ -0,0 +1,88 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// sortable is an autogenerated mock type for the sortable type
+type sortable struct {
+	mock.Mock
+}
+
+type sortable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *sortable) EXPECT() *sortable_Expecter {
+	return &sortable_Expecter{mock: &_m.Mock}
+}
+
+// SortKey provides a mock function with given fields: sort
+func (_m *sortable) SortKey(sort string) (string, string) {
+	ret := _m.Called(sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SortKey")
+	}
+
+	var r0 string
+	var r1 string
+	if rf, ok := ret.Get(0).(func(string) (string, string)); ok {
+		return rf(sort)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(sort)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(sort)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
+// sortable_SortKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SortKey'
+type sortable_SortKey_Call struct {
+	*mock.Call
+}
+
+// SortKey is a helper method to define mock.On call
+//   - sort string
+func (_e *sortable_Expecter) SortKey(sort interface{}) *sortable_SortKey_Call {
+	return &sortable_SortKey_Call{Call: _e.mock.On("SortKey", sort)}
+}
+
+func (_c *sortable_SortKey_Call) Run(run func(sort string)) *sortable_SortKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *sortable_SortKey_Call) Return(_a0 string, _a1 string) *sortable_SortKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *sortable_SortKey_Call) RunAndReturn(run func(string) (string, string)) *sortable_SortKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newSortable creates a new instance of sortable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newSortable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *sortable {
+	mock := &sortable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
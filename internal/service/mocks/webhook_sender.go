@@ -0,0 +1,141 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "zadanie-6105/internal/domain/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookSender is an autogenerated mock type for the WebhookSender type
+type WebhookSender struct {
+	mock.Mock
+}
+
+type WebhookSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookSender) EXPECT() *WebhookSender_Expecter {
+	return &WebhookSender_Expecter{mock: &_m.Mock}
+}
+
+// CheckURL provides a mock function with given fields: ctx, url
+func (_m *WebhookSender) CheckURL(ctx context.Context, url string) error {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckURL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookSender_CheckURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckURL'
+type WebhookSender_CheckURL_Call struct {
+	*mock.Call
+}
+
+// CheckURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *WebhookSender_Expecter) CheckURL(ctx interface{}, url interface{}) *WebhookSender_CheckURL_Call {
+	return &WebhookSender_CheckURL_Call{Call: _e.mock.On("CheckURL", ctx, url)}
+}
+
+func (_c *WebhookSender_CheckURL_Call) Run(run func(ctx context.Context, url string)) *WebhookSender_CheckURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookSender_CheckURL_Call) Return(_a0 error) *WebhookSender_CheckURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookSender_CheckURL_Call) RunAndReturn(run func(context.Context, string) error) *WebhookSender_CheckURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, delivery
+func (_m *WebhookSender) Send(ctx context.Context, delivery model.Delivery) (int, error) {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Delivery) (int, error)); ok {
+		return rf(ctx, delivery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Delivery) int); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Delivery) error); ok {
+		r1 = rf(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type WebhookSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery model.Delivery
+func (_e *WebhookSender_Expecter) Send(ctx interface{}, delivery interface{}) *WebhookSender_Send_Call {
+	return &WebhookSender_Send_Call{Call: _e.mock.On("Send", ctx, delivery)}
+}
+
+func (_c *WebhookSender_Send_Call) Run(run func(ctx context.Context, delivery model.Delivery)) *WebhookSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Delivery))
+	})
+	return _c
+}
+
+func (_c *WebhookSender_Send_Call) Return(_a0 int, _a1 error) *WebhookSender_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookSender_Send_Call) RunAndReturn(run func(context.Context, model.Delivery) (int, error)) *WebhookSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookSender creates a new instance of WebhookSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookSender {
+	mock := &WebhookSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+type RepoOrganizationProvider interface {
+	Organizations(
+		ctx context.Context,
+		page model.PageQuery,
+	) ([]model.OrganizationDB, int, error)
+	Organization(
+		ctx context.Context,
+		organizationId string,
+	) (model.OrganizationDB, error)
+	Responsibles(
+		ctx context.Context,
+		organizationId string,
+	) ([]model.MemberDB, error)
+}
+type RepoOrganizationEditor interface {
+	CreateOrganization(
+		ctx context.Context,
+		name string,
+		description string,
+		organizationType string,
+		responsibleId string,
+	) (string, error)
+	EditOrganization(
+		ctx context.Context,
+		organizationId string,
+		name string,
+		description string,
+		organizationType string,
+	) error
+	DeleteOrganization(
+		ctx context.Context,
+		organizationId string,
+	) error
+	AddResponsible(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+		role string,
+	) error
+	SetRole(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+		role string,
+	) error
+	RemoveResponsible(
+		ctx context.Context,
+		organizationId string,
+		userId string,
+	) error
+}
+
+func (s *Service) Organizations(ctx context.Context, page model.PageQuery) (model.Page[model.OrganizationResponse], error) {
+	const op = "Service.Organizations"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	_, err := s.caller(ctx)
+	if err != nil {
+		return model.Page[model.OrganizationResponse]{}, err
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
+	if err != nil {
+		return model.Page[model.OrganizationResponse]{}, err
+	}
+
+	organizationsDB, total, err := s.repoOrganizationProvider.Organizations(ctx, page)
+	if err != nil {
+		return model.Page[model.OrganizationResponse]{}, err
+	}
+	log.Info("Organizations from DB", slog.Int("count", len(organizationsDB)))
+	organizationsDB, nextCursor := nextPage(organizationsDB, page)
+
+	return model.Page[model.OrganizationResponse]{
+		Items:      model.ConvertOrganizations(organizationsDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+// MyOrganizations lists every organization caller is responsible for together with his role
+func (s *Service) MyOrganizations(ctx context.Context) ([]model.MembershipResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	membershipsDB, err := s.checkers.CheckMemberships(ctx, caller.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertMemberships(membershipsDB), nil
+}
+
+func (s *Service) Organization(ctx context.Context, organizationId string) (model.OrganizationResponse, error) {
+	// check status 401
+	_, err := s.caller(ctx)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+	// check status 404
+	organizationDB, err := s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	return model.ConvertOrganizationToResponse(organizationDB), nil
+}
+
+func (s *Service) CreateOrganization(ctx context.Context, name string, description string, organizationType string) (model.OrganizationResponse, error) {
+	const op = "Service.CreateOrganization"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	organizationId, err := s.repoOrganizationEditor.CreateOrganization(ctx, name, description, organizationType, caller.Id)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+	log.Info("Created organization", slog.String("id", organizationId))
+
+	organizationDB, err := s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	return model.ConvertOrganizationToResponse(organizationDB), nil
+}
+
+func (s *Service) EditOrganization(ctx context.Context, organizationId string, name string, description string, organizationType string) (model.OrganizationResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageOrganization)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	err = s.repoOrganizationEditor.EditOrganization(ctx, organizationId, name, description, organizationType)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	organizationDB, err := s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return model.OrganizationResponse{}, err
+	}
+
+	return model.ConvertOrganizationToResponse(organizationDB), nil
+}
+
+func (s *Service) DeleteOrganization(ctx context.Context, organizationId string) error {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageOrganization)
+	if err != nil {
+		return err
+	}
+
+	return s.repoOrganizationEditor.DeleteOrganization(ctx, organizationId)
+}
+
+func (s *Service) Responsibles(ctx context.Context, organizationId string) ([]model.MemberResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ViewMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	responsiblesDB, err := s.repoOrganizationProvider.Responsibles(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertMembers(responsiblesDB), nil
+}
+
+// AddResponsible makes user a member of organization, responsibles had no roles before, so owner is the default
+func (s *Service) AddResponsible(ctx context.Context, organizationId string, username string, role string) ([]model.MemberResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// check status 400
+	role, err = checkRole(role)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageMembers)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	userId, err := s.repoAuth.EmployeeIdByName(ctx, username)
+	// unknown username is 401 for login, here it is 404
+	if errs.KindOf(err) == errs.KindUnauthorized {
+		return nil, errs.NotFound(fmt.Errorf("user not found"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// check status 409
+	err = s.repoOrganizationEditor.AddResponsible(ctx, organizationId, userId, role)
+	if err != nil {
+		return nil, err
+	}
+
+	responsiblesDB, err := s.repoOrganizationProvider.Responsibles(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertMembers(responsiblesDB), nil
+}
+
+func (s *Service) SetRole(ctx context.Context, organizationId string, userId string, role string) ([]model.MemberResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// check status 400
+	role, err = checkRole(role)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageMembers)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404, 403
+	if role != string(policy.RoleOwner) {
+		err = s.checkNotLastOwner(ctx, organizationId, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repoOrganizationEditor.SetRole(ctx, organizationId, userId, role)
+	if err != nil {
+		return nil, err
+	}
+
+	responsiblesDB, err := s.repoOrganizationProvider.Responsibles(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertMembers(responsiblesDB), nil
+}
+
+func (s *Service) RemoveResponsible(ctx context.Context, organizationId string, userId string) ([]model.MemberResponse, error) {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	// check status 403
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageMembers)
+	if err != nil {
+		return nil, err
+	}
+	// check status 404, 403
+	err = s.checkNotLastOwner(ctx, organizationId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// check status 404
+	err = s.repoOrganizationEditor.RemoveResponsible(ctx, organizationId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responsiblesDB, err := s.repoOrganizationProvider.Responsibles(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertMembers(responsiblesDB), nil
+}
+
+// checkNotLastOwner keeps organization manageable, the last owner can be neither removed nor demoted
+func (s *Service) checkNotLastOwner(ctx context.Context, organizationId string, userId string) error {
+	role, err := s.checkers.CheckRole(ctx, organizationId, userId)
+	if err != nil {
+		return errs.NotFound(fmt.Errorf("user is not responsible for organization"))
+	}
+	if role != string(policy.RoleOwner) {
+		return nil
+	}
+	count, err := s.checkers.CheckResponsibleCount(ctx, organizationId, []string{string(policy.RoleOwner)})
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return errs.Forbidden(fmt.Errorf("organization must have at least one owner"))
+	}
+	return nil
+}
+
+func checkRole(role string) (string, error) {
+	if role == "" {
+		return string(policy.RoleOwner), nil
+	}
+	if !policy.Valid(role) {
+		return "", errs.Validation(fmt.Errorf("unknown role %s", role))
+	}
+	return role, nil
+}
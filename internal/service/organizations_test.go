@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+// members is what storage returns after membership change
+var members = []model.MemberDB{
+	{EmployeeDB: model.EmployeeDB{Id: userId, Username: username}, Role: "owner"},
+}
+
+func TestSetRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		role  string
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			role: "reviewer",
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "unknown role",
+			ctx:  callerCtx(),
+			role: "admin",
+			want: errs.KindValidation,
+		},
+		{
+			name: "manager cannot manage members",
+			ctx:  callerCtx(),
+			role: "reviewer",
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "not a member",
+			ctx:  callerCtx(),
+			role: "reviewer",
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, otherUserId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "last owner cannot be demoted",
+			ctx:  callerCtx(),
+			role: "reviewer",
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, otherUserId).Return("owner", nil)
+				d.checkers.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, []string{"owner"}).Return(1, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "promoted to owner",
+			ctx:  callerCtx(),
+			role: "owner",
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.orgEditor.EXPECT().SetRole(mock.Anything, organizationId, otherUserId, "owner").Return(nil)
+				d.organizations.EXPECT().Responsibles(mock.Anything, organizationId).Return(members, nil)
+			},
+		},
+		{
+			name: "one of owners demoted",
+			ctx:  callerCtx(),
+			role: "viewer",
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, otherUserId).Return("owner", nil)
+				d.checkers.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, []string{"owner"}).Return(2, nil)
+				d.orgEditor.EXPECT().SetRole(mock.Anything, organizationId, otherUserId, "viewer").Return(nil)
+				d.organizations.EXPECT().Responsibles(mock.Anything, organizationId).Return(members, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			_, err := svc.SetRole(tt.ctx, organizationId, otherUserId, tt.role)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestAddResponsible_EmployeeLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want errs.Kind
+	}{
+		{
+			name: "unknown username",
+			err:  domainError(errs.KindUnauthorized),
+			want: errs.KindNotFound,
+		},
+		{
+			name: "storage failure",
+			err:  domainError(errs.KindInternal),
+			want: errs.KindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+			d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			d.auth.EXPECT().EmployeeIdByName(mock.Anything, "newbie").Return("", tt.err)
+
+			_, err := svc.AddResponsible(callerCtx(), organizationId, "newbie", "viewer")
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestRemoveResponsible_LastOwner(t *testing.T) {
+	svc, d := newService(t)
+
+	d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+	d.checkers.EXPECT().CheckResponsibleCount(mock.Anything, organizationId, []string{"owner"}).Return(1, nil)
+
+	_, err := svc.RemoveResponsible(callerCtx(), organizationId, userId)
+	requireKind(t, err, errs.KindForbidden)
+}
+
+func TestMyOrganizations(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+		Return([]model.MembershipDB{membership(organizationId, "owner"), membership(otherOrganizationId, "reviewer")}, nil)
+
+	got, err := svc.MyOrganizations(callerCtx())
+	requireKind(t, err, "")
+	require.Len(t, got, 2)
+	assert.Equal(t, otherOrganizationId, got[1].Id)
+	assert.Equal(t, "reviewer", got[1].Role)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"fmt"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/lib/cursor"
+)
+
+// pageQuery decodes cursor and asks repo for one item more than limit,
+// so nextPage knows whether there is one
+func pageQuery(page model.PageQuery, defaultSort string) (model.PageQuery, error) {
+	if page.Sort == "" {
+		page.Sort = defaultSort
+	}
+	if page.Cursor != "" {
+		after, err := cursor.Decode(page.Cursor)
+		if err != nil {
+			return model.PageQuery{}, errs.Validation(err)
+		}
+		if after.Sort != page.Sort || after.Desc != page.Desc {
+			return model.PageQuery{}, errs.Validation(fmt.Errorf("cursor was made for another sort order"))
+		}
+		page.After = &after
+		page.Offset = 0
+	}
+	if page.Limit > 0 {
+		page.Limit++
+	}
+	return page, nil
+}
+
+type sortable interface {
+	SortKey(sort string) (string, string)
+}
+
+// nextPage drops the extra item asked by pageQuery and makes cursor from the last item left
+func nextPage[T sortable](items []T, page model.PageQuery) ([]T, string) {
+	if page.Limit == 0 || len(items) < int(page.Limit) {
+		return items, ""
+	}
+	items = items[:page.Limit-1]
+	if len(items) == 0 {
+		return items, ""
+	}
+	value, id := items[len(items)-1].SortKey(page.Sort)
+	return items, cursor.Encode(model.Cursor{Sort: page.Sort, Desc: page.Desc, Value: value, Id: id})
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+// authorize is the only place where role of caller is matched against permission,
+// storage just tells the role
+func (s *Service) authorize(ctx context.Context, organizationId string, userId string, permission policy.Permission) error {
+	// check status 403
+	role, err := s.checkers.CheckRole(ctx, organizationId, userId)
+	if err != nil {
+		return err
+	}
+	if !policy.Can(policy.Role(role), permission) {
+		return errs.Forbidden(fmt.Errorf("role %s does not allow %s", role, permission))
+	}
+	return nil
+}
+
+// authorizeBidAuthor lets personal bids be used only by their author,
+// bids of organization by its members with permission
+func (s *Service) authorizeBidAuthor(ctx context.Context, bid model.BidDB, caller model.Caller, permission policy.Permission) error {
+	if strings.EqualFold(bid.AuthorType, "Organization") {
+		return s.authorize(ctx, bid.AuthorId, caller.Id, permission)
+	}
+	if bid.AuthorId != caller.Id {
+		return errs.Forbidden(fmt.Errorf("user have no access to bid "))
+	}
+	return nil
+}
+
+// organizationsWith lists organizations where role of user grants permission
+func (s *Service) organizationsWith(ctx context.Context, userId string, permission policy.Permission) ([]string, error) {
+	memberships, err := s.checkers.CheckMemberships(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	var organizationIds []string
+	for _, membership := range memberships {
+		if policy.Can(policy.Role(membership.Role), permission) {
+			organizationIds = append(organizationIds, membership.Id)
+		}
+	}
+	return organizationIds, nil
+}
+
+// soleOrganizationWith picks organization for actions where it may be omitted,
+// it is an error to omit it when there is a choice
+func (s *Service) soleOrganizationWith(ctx context.Context, userId string, permission policy.Permission) (string, error) {
+	organizationIds, err := s.organizationsWith(ctx, userId, permission)
+	if err != nil {
+		return "", err
+	}
+	switch len(organizationIds) {
+	case 0:
+		return "", errs.Forbidden(fmt.Errorf("user does not responsible for any organization allowing %s", permission))
+	case 1:
+		return organizationIds[0], nil
+	default:
+		return "", errs.Validation(fmt.Errorf("user is responsible for several organizations, organization must be set explicitly"))
+	}
+}
+
+// checkActingOrganization is for actions where organization is taken from tender,
+// if caller names organization explicitly it must be the one owning tender
+func checkActingOrganization(tender model.TenderDB, organizationId string) error {
+	if organizationId != "" && organizationId != tender.OrganizationId {
+		return errs.Forbidden(fmt.Errorf("tender does not belong to organization %s", organizationId))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+// checkRatings allows only configured criteria scored from 1 to 5
+func (s *Service) checkRatings(ratings map[string]int) error {
+	for criterion, score := range ratings {
+		if !slices.Contains(s.reviewCriteria, criterion) {
+			return errs.Validation(fmt.Errorf("unknown review criterion %q", criterion))
+		}
+		if score < 1 || score > 5 {
+			return errs.Validation(fmt.Errorf("score of %s must be from 1 to 5", criterion))
+		}
+	}
+	return nil
+}
+
+// Reputation is available to the same responsibles who can read reviews of author
+func (s *Service) Reputation(ctx context.Context, tenderId string, authorType string, author string) (model.Reputation, error) {
+	const op = "Service.Reputation"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	scope, err := s.reviewScope(ctx, tenderId, model.ReviewFilter{AuthorType: authorType, Author: author})
+	if err != nil {
+		return model.Reputation{}, err
+	}
+
+	reputationDB, err := s.repoBidFeedbacker.Reputation(ctx, scope.AuthorType, scope.AuthorId)
+	if err != nil {
+		return model.Reputation{}, err
+	}
+	log.Info("Reputation from DB", slog.Any("reputation", reputationDB))
+
+	reputation := model.Reputation{
+		AuthorType: scope.AuthorType,
+		AuthorId:   scope.AuthorId,
+		Reviews:    reputationDB.Reviews,
+		Criteria:   make(map[string]float64, len(reputationDB.Criteria)),
+		Won:        reputationDB.Won,
+		Lost:       reputationDB.Lost,
+	}
+	if scope.AuthorType == "User" {
+		reputation.AuthorUsername = author
+	}
+	var count, sum int
+	for _, criterion := range reputationDB.Criteria {
+		reputation.Criteria[criterion.Criterion] = round(float64(criterion.Sum) / float64(criterion.Count))
+		count += criterion.Count
+		sum += criterion.Sum
+	}
+	if count > 0 {
+		reputation.AverageRating = round(float64(sum) / float64(count))
+	}
+	if decided := reputation.Won + reputation.Lost; decided > 0 {
+		reputation.ApprovalRate = round(float64(reputation.Won) / float64(decided))
+	}
+
+	return reputation, nil
+}
+
+// round keeps two decimals, it is enough for scores from 1 to 5
+func round(x float64) float64 {
+	return math.Round(x*100) / 100
+}
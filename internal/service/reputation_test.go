@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestReputation(t *testing.T) {
+	const authorUsername = "author"
+
+	tests := []struct {
+		name       string
+		reputation model.ReputationDB
+		want       model.Reputation
+	}{
+		{
+			name: "nothing decided nor rated",
+			reputation: model.ReputationDB{
+				Reviews: 1,
+			},
+			want: model.Reputation{
+				AuthorType:     "User",
+				AuthorId:       otherUserId,
+				AuthorUsername: authorUsername,
+				Reviews:        1,
+				Criteria:       map[string]float64{},
+			},
+		},
+		{
+			name: "averages",
+			reputation: model.ReputationDB{
+				Won:     1,
+				Lost:    2,
+				Reviews: 3,
+				Criteria: []model.CriterionDB{
+					{Criterion: "price", Count: 1, Sum: 2},
+					{Criterion: "quality", Count: 3, Sum: 13},
+				},
+			},
+			want: model.Reputation{
+				AuthorType:     "User",
+				AuthorId:       otherUserId,
+				AuthorUsername: authorUsername,
+				Reviews:        3,
+				AverageRating:  3.75,
+				Criteria:       map[string]float64{"price": 2, "quality": 4.33},
+				Won:            1,
+				Lost:           2,
+				ApprovalRate:   0.33,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+			d.checkers.EXPECT().CheckIdByName(mock.Anything, authorUsername).Return(otherUserId, nil)
+			d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			d.bidProvider.EXPECT().GetBidsById(mock.Anything, model.PageQuery{}, []string{otherUserId}).
+				Return([]model.BidDB{bid(bidId, "Published")}, 1, nil)
+			d.bidFeedbacker.EXPECT().Reputation(mock.Anything, "User", otherUserId).Return(tt.reputation, nil)
+
+			got, err := svc.Reputation(callerCtx(), tenderId, "User", authorUsername)
+			requireKind(t, err, "")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReputation_NotResponsible(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+	d.checkers.EXPECT().CheckIdByName(mock.Anything, "author").Return(otherUserId, nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("bidder", nil)
+
+	_, err := svc.Reputation(callerCtx(), tenderId, "User", "author")
+	requireKind(t, err, errs.KindForbidden)
+}
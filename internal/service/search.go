@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+// SearchTenders finds tenders by words in name and description, not published tenders
+// are found only by members allowed to view tenders of their organization
+func (s *Service) SearchTenders(ctx context.Context, filter model.SearchFilter, page model.PageQuery) (model.Page[model.TenderSearchResponse], error) {
+	const op = "Service.SearchTenders"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+	// check status 400
+	err = checkCreatedRange(filter)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+	// check status 400
+	filter.ServiceTypes, err = s.serviceTypesFilter(ctx, filter.ServiceTypes)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortRank)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+
+	organizationIds, err := s.organizationsWith(ctx, caller.Id, policy.ViewTenders)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+
+	resultsDB, total, err := s.repoTenderProvider.SearchTenders(ctx, filter, organizationIds, page)
+	if err != nil {
+		return model.Page[model.TenderSearchResponse]{}, err
+	}
+	log.Info("Found tenders", slog.Int("total", total))
+	resultsDB, nextCursor := nextPage(resultsDB, page)
+
+	return model.Page[model.TenderSearchResponse]{
+		Items:      model.ConvertTenderSearch(resultsDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+// SearchBids finds personal bids of caller, bids of organizations where caller may view bids
+// and not created bids on tenders of organizations where caller may view tenders
+func (s *Service) SearchBids(ctx context.Context, filter model.SearchFilter, page model.PageQuery) (model.Page[model.BidSearchResponse], error) {
+	const op = "Service.SearchBids"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+	// check status 400
+	err = checkCreatedRange(filter)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+	// check status 400
+	filter.ServiceTypes, err = s.serviceTypesFilter(ctx, filter.ServiceTypes)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortRank)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+
+	authorIds, err := s.organizationsWith(ctx, caller.Id, policy.ViewBids)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+	authorIds = append(authorIds, caller.Id)
+	organizationIds, err := s.organizationsWith(ctx, caller.Id, policy.ViewTenders)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+
+	resultsDB, total, err := s.repoBidProvider.SearchBids(ctx, filter, authorIds, organizationIds, page)
+	if err != nil {
+		return model.Page[model.BidSearchResponse]{}, err
+	}
+	log.Info("Found bids", slog.Int("total", total))
+	resultsDB, nextCursor := nextPage(resultsDB, page)
+
+	return model.Page[model.BidSearchResponse]{
+		Items:      model.ConvertBidSearch(resultsDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+func checkCreatedRange(filter model.SearchFilter) error {
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return errs.Validation(fmt.Errorf("createdFrom must be before createdTo"))
+	}
+	return nil
+}
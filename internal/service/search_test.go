@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestSearchTenders(t *testing.T) {
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		filter model.SearchFilter
+		setup  func(d *deps)
+		want   errs.Kind
+	}{
+		{
+			name:   "no caller",
+			ctx:    context.Background(),
+			filter: model.SearchFilter{Query: "delivery"},
+			want:   errs.KindUnauthorized,
+		},
+		{
+			name:   "empty created range",
+			ctx:    callerCtx(),
+			filter: model.SearchFilter{Query: "delivery", CreatedFrom: &from, CreatedTo: &to},
+			want:   errs.KindValidation,
+		},
+		{
+			name:   "not published tenders only of organizations caller may view tenders of",
+			ctx:    callerCtx(),
+			filter: model.SearchFilter{Query: "delivery", Statuses: []string{"Published"}},
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{
+					membership(organizationId, "reviewer"),
+					membership(otherOrganizationId, "bidder"),
+				}, nil)
+				next := tender("Published")
+				next.Id = "99999999-9999-4999-8999-999999999999"
+				// one more than limit means there is next page
+				d.tenderProvider.EXPECT().
+					SearchTenders(mock.Anything, model.SearchFilter{Query: "delivery", Statuses: []string{"Published"}}, []string{organizationId}, model.PageQuery{Limit: 2, Sort: model.SortRank, Desc: true}).
+					Return([]model.TenderSearchDB{
+						{TenderDB: tender("Published"), Rank: 0.5, NameHighlight: "<img onerror=x> " + model.HighlightStart + "name" + model.HighlightStop},
+						{TenderDB: next, Rank: 0.25},
+					}, 3, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			results, err := svc.SearchTenders(tt.ctx, tt.filter, model.PageQuery{Limit: 1, Desc: true})
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				require.Len(t, results.Items, 1)
+				assert.Equal(t, "&lt;img onerror=x&gt; <b>name</b>", results.Items[0].Highlights.Name)
+				assert.Equal(t, 3, results.Total)
+				assert.NotEmpty(t, results.NextCursor)
+			}
+		})
+	}
+}
+
+func TestSearchBids(t *testing.T) {
+	svc, d := newService(t)
+
+	// bidder sees bids of organization, but not bids on its tenders
+	d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return([]model.MembershipDB{
+		membership(organizationId, "bidder"),
+		membership(otherOrganizationId, "viewer"),
+	}, nil)
+	d.bidProvider.EXPECT().
+		SearchBids(mock.Anything, model.SearchFilter{Query: "offer"}, []string{organizationId, otherOrganizationId, userId}, []string{otherOrganizationId}, model.PageQuery{Sort: model.SortRank, Desc: true}).
+		Return([]model.BidSearchDB{{BidDB: bid(bidId, "Published"), Rank: 1}}, 1, nil)
+
+	results, err := svc.SearchBids(callerCtx(), model.SearchFilter{Query: "offer"}, model.PageQuery{Desc: true})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+	assert.Equal(t, bidId, results.Items[0].Id)
+	assert.Empty(t, results.NextCursor)
+}
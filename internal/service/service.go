@@ -6,7 +6,13 @@ import (
 )
 
 type Service struct {
-	log *slog.Logger
+	log    *slog.Logger
+	tokens TokenConfig
+	// reviewCriteria are the only criteria reviews may rate
+	reviewCriteria []string
+	attachments    AttachmentConfig
+	// catalogAdmins are usernames allowed to manage categories
+	catalogAdmins []string
 
 	repoTenderProvider RepoTenderProvider
 	repoTenderCreator  RepoTenderCreator
@@ -18,11 +24,32 @@ type Service struct {
 	repoBidDecisionMaker RepoBidDecisionMaker
 	repoBidFeedbacker    RepoBidFeedbacker
 
-	checkers repo.Checkers
+	repoOrganizationProvider RepoOrganizationProvider
+	repoOrganizationEditor   RepoOrganizationEditor
+	repoEmployeeProvider     RepoEmployeeProvider
+	repoEmployeeEditor       RepoEmployeeEditor
+
+	repoAuth RepoAuth
+
+	repoWebhook   RepoWebhook
+	repoOutbox    RepoOutbox
+	webhookSender WebhookSender
+
+	repoAttachments RepoAttachments
+	blobStore       BlobStore
+
+	repoCategories RepoCategories
+
+	checkers   repo.Checkers
+	transactor repo.Transactor
 }
 
 func New(
 	log *slog.Logger,
+	tokens TokenConfig,
+	reviewCriteria []string,
+	attachments AttachmentConfig,
+	catalogAdmins []string,
 
 	tenderProvider RepoTenderProvider,
 	tenderCreator RepoTenderCreator,
@@ -34,18 +61,51 @@ func New(
 	bidDecisionMaker RepoBidDecisionMaker,
 	bidFeedbacker RepoBidFeedbacker,
 
+	organizationProvider RepoOrganizationProvider,
+	organizationEditor RepoOrganizationEditor,
+	employeeProvider RepoEmployeeProvider,
+	employeeEditor RepoEmployeeEditor,
+
+	authRepo RepoAuth,
+
+	webhookRepo RepoWebhook,
+	outboxRepo RepoOutbox,
+	webhookSender WebhookSender,
+
+	attachmentRepo RepoAttachments,
+	blobStore BlobStore,
+
+	categoryRepo RepoCategories,
+
 	checkers repo.Checkers,
+	transactor repo.Transactor,
 ) *Service {
 	return &Service{
-		log:                  log,
-		repoTenderProvider:   tenderProvider,
-		repoTenderCreator:    tenderCreator,
-		repoTenderEditor:     tenderEditor,
-		repoBidProvider:      bidProvider,
-		repoBidCreator:       bidCreator,
-		repoBidEditor:        bidEditor,
-		repoBidDecisionMaker: bidDecisionMaker,
-		repoBidFeedbacker:    bidFeedbacker,
-		checkers:             checkers,
+		log:                      log,
+		tokens:                   tokens,
+		reviewCriteria:           reviewCriteria,
+		attachments:              attachments,
+		catalogAdmins:            catalogAdmins,
+		repoTenderProvider:       tenderProvider,
+		repoTenderCreator:        tenderCreator,
+		repoTenderEditor:         tenderEditor,
+		repoBidProvider:          bidProvider,
+		repoBidCreator:           bidCreator,
+		repoBidEditor:            bidEditor,
+		repoBidDecisionMaker:     bidDecisionMaker,
+		repoBidFeedbacker:        bidFeedbacker,
+		repoOrganizationProvider: organizationProvider,
+		repoOrganizationEditor:   organizationEditor,
+		repoEmployeeProvider:     employeeProvider,
+		repoEmployeeEditor:       employeeEditor,
+		repoAuth:                 authRepo,
+		repoWebhook:              webhookRepo,
+		repoOutbox:               outboxRepo,
+		webhookSender:            webhookSender,
+		repoAttachments:          attachmentRepo,
+		blobStore:                blobStore,
+		repoCategories:           categoryRepo,
+		checkers:                 checkers,
+		transactor:               transactor,
 	}
 }
@@ -0,0 +1,211 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/lib/auth"
+	"zadanie-6105/internal/repo"
+	repomocks "zadanie-6105/internal/repo/mocks"
+	"zadanie-6105/internal/service"
+	"zadanie-6105/internal/service/mocks"
+)
+
+const (
+	userId         = "11111111-1111-4111-8111-111111111111"
+	otherUserId    = "66666666-6666-4666-8666-666666666666"
+	username       = "jambo"
+	organizationId = "22222222-2222-4222-8222-222222222222"
+	// otherOrganizationId is the second organization of caller in multi-organization cases
+	otherOrganizationId = "77777777-7777-4777-8777-777777777777"
+	tenderId            = "33333333-3333-4333-8333-333333333333"
+	bidId               = "44444444-4444-4444-8444-444444444444"
+	otherBidId          = "55555555-5555-4555-8555-555555555555"
+	createdAt           = "2024-09-01T12:00:00Z"
+)
+
+// criteria of reviews as configured by default
+var criteria = []string{"quality", "timeliness", "price"}
+
+// attachmentConfig keeps limits small, so tests do not need big files
+var attachmentConfig = service.AttachmentConfig{
+	MaxSize: 1024,
+	Types:   []string{"application/pdf", "text/plain"},
+}
+
+// catalogAdmins lets caller manage categories
+var catalogAdmins = []string{username}
+
+// deps holds every mock passed to service.New, expectations are asserted on cleanup
+type deps struct {
+	tenderProvider   *mocks.RepoTenderProvider
+	tenderCreator    *mocks.RepoTenderCreator
+	tenderEditor     *mocks.RepoTenderEditor
+	bidProvider      *mocks.RepoBidProvider
+	bidCreator       *mocks.RepoBidCreator
+	bidEditor        *mocks.RepoBidEditor
+	bidDecisionMaker *mocks.RepoBidDecisionMaker
+	bidFeedbacker    *mocks.RepoBidFeedbacker
+	organizations    *mocks.RepoOrganizationProvider
+	orgEditor        *mocks.RepoOrganizationEditor
+	employees        *mocks.RepoEmployeeProvider
+	employeeEditor   *mocks.RepoEmployeeEditor
+	auth             *mocks.RepoAuth
+	webhooks         *mocks.RepoWebhook
+	outbox           *mocks.RepoOutbox
+	sender           *mocks.WebhookSender
+	attachments      *mocks.RepoAttachments
+	blobs            *mocks.BlobStore
+	categories       *mocks.RepoCategories
+	checkers         *repomocks.Checkers
+	transactor       *repomocks.Transactor
+	tx               *repomocks.Tx
+	// catalog is returned by categories mock, default categories unless test changes it
+	catalog []model.CategoryDB
+}
+
+func newService(t *testing.T) (*service.Service, *deps) {
+	t.Helper()
+
+	d := &deps{
+		tenderProvider:   mocks.NewRepoTenderProvider(t),
+		tenderCreator:    mocks.NewRepoTenderCreator(t),
+		tenderEditor:     mocks.NewRepoTenderEditor(t),
+		bidProvider:      mocks.NewRepoBidProvider(t),
+		bidCreator:       mocks.NewRepoBidCreator(t),
+		bidEditor:        mocks.NewRepoBidEditor(t),
+		bidDecisionMaker: mocks.NewRepoBidDecisionMaker(t),
+		bidFeedbacker:    mocks.NewRepoBidFeedbacker(t),
+		organizations:    mocks.NewRepoOrganizationProvider(t),
+		orgEditor:        mocks.NewRepoOrganizationEditor(t),
+		employees:        mocks.NewRepoEmployeeProvider(t),
+		employeeEditor:   mocks.NewRepoEmployeeEditor(t),
+		auth:             mocks.NewRepoAuth(t),
+		webhooks:         mocks.NewRepoWebhook(t),
+		outbox:           mocks.NewRepoOutbox(t),
+		sender:           mocks.NewWebhookSender(t),
+		attachments:      mocks.NewRepoAttachments(t),
+		blobs:            mocks.NewBlobStore(t),
+		categories:       mocks.NewRepoCategories(t),
+		checkers:         repomocks.NewCheckers(t),
+		transactor:       repomocks.NewTransactor(t),
+		tx:               repomocks.NewTx(t),
+		catalog: []model.CategoryDB{
+			{Code: "Construction", Name: "Construction"},
+			{Code: "Delivery", Name: "Delivery"},
+			{Code: "Manufacture", Name: "Manufacture"},
+		},
+	}
+	// transaction just runs fn against tx mock
+	d.transactor.EXPECT().InTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(tx repo.Tx) error) error {
+			return fn(d.tx)
+		}).Maybe()
+	d.categories.EXPECT().Categories(mock.Anything).
+		RunAndReturn(func(context.Context) ([]model.CategoryDB, error) {
+			return d.catalog, nil
+		}).Maybe()
+
+	log := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	svc := service.New(log,
+		service.TokenConfig{InviteTTL: time.Hour},
+		criteria,
+		attachmentConfig,
+		catalogAdmins,
+		d.tenderProvider, d.tenderCreator, d.tenderEditor,
+		d.bidProvider, d.bidCreator, d.bidEditor, d.bidDecisionMaker, d.bidFeedbacker,
+		d.organizations, d.orgEditor,
+		d.employees, d.employeeEditor,
+		d.auth,
+		d.webhooks, d.outbox, d.sender,
+		d.attachments, d.blobs,
+		d.categories,
+		d.checkers,
+		d.transactor,
+	)
+
+	return svc, d
+}
+
+// callerCtx is a context of authenticated request
+func callerCtx() context.Context {
+	return auth.WithCaller(context.Background(), model.Caller{
+		Id:       userId,
+		Username: username,
+	})
+}
+
+func domainError(kind errs.Kind) error {
+	return &errs.Error{Kind: kind, Err: fmt.Errorf("%s", kind)}
+}
+
+// requireKind checks error is domain error of expected kind, empty kind means no error
+func requireKind(t *testing.T, err error, kind errs.Kind) {
+	t.Helper()
+
+	if kind == "" {
+		require.NoError(t, err)
+		return
+	}
+	var domainErr *errs.Error
+	require.True(t, errors.As(err, &domainErr), "expected domain error %s, got %v", kind, err)
+	require.Equal(t, kind, domainErr.Kind)
+}
+
+func tender(status string) model.TenderDB {
+	return model.TenderDB{
+		Id:              tenderId,
+		Name:            "tender",
+		Description:     "description",
+		ServiceType:     "Delivery",
+		Status:          status,
+		OrganizationId:  organizationId,
+		CreatorUsername: username,
+		Version:         1,
+		CreatedAt:       createdAt,
+	}
+}
+
+func bid(id string, status string) model.BidDB {
+	return model.BidDB{
+		Id:          id,
+		Name:        "bid",
+		Description: "description",
+		Status:      status,
+		TenderId:    tenderId,
+		AuthorType:  "User",
+		AuthorId:    userId,
+		Version:     1,
+		CreatedAt:   createdAt,
+	}
+}
+
+// orgBid is a bid submitted on behalf of organization
+func orgBid(id string, status string) model.BidDB {
+	b := bid(id, status)
+	b.AuthorType = "Organization"
+	b.AuthorId = organizationId
+	return b
+}
+
+// foreignBid is a personal bid of another user
+func foreignBid(id string, status string) model.BidDB {
+	b := bid(id, status)
+	b.AuthorId = otherUserId
+	return b
+}
+
+func membership(organizationId string, role string) model.MembershipDB {
+	return model.MembershipDB{
+		OrganizationDB: model.OrganizationDB{Id: organizationId, Name: "org", CreatedAt: createdAt},
+		Role:           role,
+	}
+}
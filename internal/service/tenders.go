@@ -1,56 +1,96 @@
 package service
 
 import (
+	"cmp"
+	"context"
 	"fmt"
-	"github.com/labstack/echo/v4"
 	"log/slog"
-	"net/http"
 	"strings"
+	"time"
+	"zadanie-6105/internal/domain/errs"
 	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	"zadanie-6105/internal/domain/quorum"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/repo"
 )
 
 type RepoTenderProvider interface {
 	Tenders(
-		limit int32,
-		offset int32,
+		ctx context.Context,
+		page model.PageQuery,
 		serviceTypes []string,
-	) ([]model.TenderDB, error)
-	TendersByUser(
-		limit int32,
-		offset int32,
-		username string,
-	) ([]model.TenderDB, error)
+	) ([]model.TenderDB, int, error)
+	// TendersByOrganizations merges tenders of all organizations into one list
+	TendersByOrganizations(
+		ctx context.Context,
+		page model.PageQuery,
+		organizationIds []string,
+	) ([]model.TenderDB, int, error)
 	Status(
+		ctx context.Context,
 		id string,
 	) (string, error)
+	ExpiredTenders(
+		ctx context.Context,
+		now time.Time,
+	) ([]model.TenderDB, error)
+	TenderVersions(
+		ctx context.Context,
+		tenderId string,
+	) ([]model.TenderDB, error)
+	TenderVersion(
+		ctx context.Context,
+		tenderId string,
+		version int32,
+	) (model.TenderDB, error)
+	// SearchTenders finds published tenders and any tenders of organizationIds
+	SearchTenders(
+		ctx context.Context,
+		filter model.SearchFilter,
+		organizationIds []string,
+		page model.PageQuery,
+	) ([]model.TenderSearchDB, int, error)
 }
 type RepoTenderCreator interface {
 	CreateTender(
+		ctx context.Context,
 		name string,
 		description string,
 		serviceType string,
 		organizationId string,
 		creatorUsername string,
+		submissionDeadline *time.Time,
+		decisionDeadline *time.Time,
+		decisionPolicy quorum.Policy,
 	) (string, error)
 }
 type RepoTenderEditor interface {
 	ChangeTenderStatus(
+		ctx context.Context,
 		id string,
 		status string,
+		expectedVersion int32,
 	) (string, error)
 	EditTender(
+		ctx context.Context,
 		id string,
 		name string,
 		description string,
 		serviceType string,
+		submissionDeadline *time.Time,
+		decisionDeadline *time.Time,
+		expectedVersion int32,
 	) (string, error)
 	RollbackTender(
+		ctx context.Context,
 		id string,
 		version int32,
+		expectedVersion int32,
 	) (string, error)
 }
 
-func (s *Service) Tenders(limit int32, offset int32, serviceType []string) ([]model.TenderResponse, error) {
+func (s *Service) Tenders(ctx context.Context, page model.PageQuery, serviceType []string) (model.Page[model.TenderResponse], error) {
 	const op = "Service.Tenders"
 	log := s.log.With(
 		slog.String("op", op),
@@ -59,14 +99,26 @@ func (s *Service) Tenders(limit int32, offset int32, serviceType []string) ([]mo
 	var (
 		Tenders         []model.TenderDB
 		TendersResponse []model.TenderResponse
+		total           int
 		err             error
 	)
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
+	if err != nil {
+		return model.Page[model.TenderResponse]{}, err
+	}
+	// check status 400
+	serviceType, err = s.serviceTypesFilter(ctx, serviceType)
+	if err != nil {
+		return model.Page[model.TenderResponse]{}, err
+	}
 
-	Tenders, err = s.repoTenderProvider.Tenders(limit, offset, serviceType)
+	Tenders, total, err = s.repoTenderProvider.Tenders(ctx, page, serviceType)
 	if err != nil {
-		return nil, err
+		return model.Page[model.TenderResponse]{}, err
 	}
-	log.Info("Tenders from DB:", Tenders)
+	log.Info("Tenders from DB", slog.Any("tenders", Tenders))
+	Tenders, nextCursor := nextPage(Tenders, page)
 
 	var tendersToExport = make([]model.TenderDB, 0, len(Tenders))
 	for _, tender := range Tenders {
@@ -74,14 +126,18 @@ func (s *Service) Tenders(limit int32, offset int32, serviceType []string) ([]mo
 			tendersToExport = append(tendersToExport, tender)
 		}
 	}
-	log.Info("Tenders to export:", tendersToExport)
+	log.Info("Tenders to export", slog.Any("tendersToExport", tendersToExport))
 	TendersResponse = model.ConvertTenders(tendersToExport)
-	log.Info("Converted Tenders to response:", TendersResponse)
+	log.Info("Converted Tenders to response", slog.Any("tendersResponse", TendersResponse))
 
-	return TendersResponse, nil
+	return model.Page[model.TenderResponse]{
+		Items:      TendersResponse,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
 }
 
-func (s *Service) CreateTender(name string, description string, serviceType string, organizationId string, creatorUsername string) (model.TenderResponse, error) {
+func (s *Service) CreateTender(ctx context.Context, name string, description string, serviceType string, organizationId string, submissionDeadline *time.Time, decisionDeadline *time.Time, decisionPolicy quorum.Policy) (model.TenderResponse, error) {
 	const op = "Service.CreateTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -90,43 +146,61 @@ func (s *Service) CreateTender(name string, description string, serviceType stri
 		TenderDB       model.TenderDB
 		TenderResponse model.TenderResponse
 		err            error
-		orgId          string
 	)
 
 	// check status 401
-	_, err = s.checkers.CheckIdByName(creatorUsername)
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+	creatorUsername := caller.Username
+	// check status 400
+	err = checkDeadlines(submissionDeadline, decisionDeadline)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 401
-	_, err = s.checkers.CheckCorporateById(organizationId)
+	_, err = s.checkers.CheckCorporateById(ctx, organizationId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
-	orgId, err = s.checkers.CheckResponsibility(creatorUsername)
-	if err != nil || orgId != organizationId {
-		return model.TenderResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("user does not responsible for this organization"))
+	err = s.authorize(ctx, organizationId, caller.Id, policy.ManageTenders)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+	// check status 400
+	if decisionPolicy.Kind == "" {
+		decisionPolicy = quorum.Default()
+	}
+	err = s.checkDecisionPolicy(ctx, organizationId, decisionPolicy)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+	// check status 400
+	err = s.checkServiceType(ctx, serviceType)
+	if err != nil {
+		return model.TenderResponse{}, err
 	}
 
-	tenderId, err := s.repoTenderCreator.CreateTender(name, description, serviceType, organizationId, creatorUsername)
+	tenderId, err := s.repoTenderCreator.CreateTender(ctx, name, description, serviceType, organizationId, creatorUsername, submissionDeadline, decisionDeadline, decisionPolicy)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 
-	tenderDB, err := s.checkers.CheckTender(tenderId)
+	tenderDB, err := s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Created tender from DB:", TenderDB)
+	log.Info("Created tender from DB", slog.Any("tenderDB", TenderDB))
 
 	TenderResponse = model.ConvertTenderToResponse(tenderDB)
-	log.Info("Converted Tender to response:", TenderResponse)
+	log.Info("Converted Tender to response", slog.Any("tenderResponse", TenderResponse))
 
 	return TenderResponse, nil
 }
 
-func (s *Service) GetTenderByUser(limit int32, offset int32, username string) ([]model.TenderResponse, error) {
+func (s *Service) GetTenderByUser(ctx context.Context, page model.PageQuery) (model.Page[model.TenderResponse], error) {
 	const op = "Service.GetTenderByUser"
 	log := s.log.With(
 		slog.String("op", op),
@@ -135,32 +209,47 @@ func (s *Service) GetTenderByUser(limit int32, offset int32, username string) ([
 	var (
 		TendersDB       []model.TenderDB
 		TendersResponse []model.TenderResponse
+		total           int
 		err             error
 	)
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
-		return nil, err
+		return model.Page[model.TenderResponse]{}, err
 	}
 	//check status 403
-	_, err = s.checkers.CheckResponsibility(username)
+	// tenders of every organization caller is responsible for are listed together
+	organizationIds, err := s.organizationsWith(ctx, caller.Id, policy.ViewTenders)
 	if err != nil {
-		return nil, err
+		return model.Page[model.TenderResponse]{}, err
+	}
+	if len(organizationIds) == 0 {
+		return model.Page[model.TenderResponse]{}, errs.Forbidden(fmt.Errorf("user does not responsible for any organization"))
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortName)
+	if err != nil {
+		return model.Page[model.TenderResponse]{}, err
 	}
 
-	TendersDB, err = s.repoTenderProvider.TendersByUser(limit, offset, username)
+	TendersDB, total, err = s.repoTenderProvider.TendersByOrganizations(ctx, page, organizationIds)
 	if err != nil {
-		return nil, err
+		return model.Page[model.TenderResponse]{}, err
 	}
-	log.Info("Tenders from DB:", TendersDB)
+	log.Info("Tenders from DB", slog.Any("tendersDB", TendersDB))
+	TendersDB, nextCursor := nextPage(TendersDB, page)
 
 	TendersResponse = model.ConvertTenders(TendersDB)
-	log.Info("Converted Tenders to response:", TendersResponse)
+	log.Info("Converted Tenders to response", slog.Any("tendersResponse", TendersResponse))
 
-	return TendersResponse, nil
+	return model.Page[model.TenderResponse]{
+		Items:      TendersResponse,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
 }
 
-func (s *Service) TenderStatus(tenderId string, username string) (string, error) {
+func (s *Service) TenderStatus(ctx context.Context, tenderId string) (string, error) {
 	const op = "Service.TenderStatus"
 	log := s.log.With(
 		slog.String("op", op),
@@ -171,25 +260,25 @@ func (s *Service) TenderStatus(tenderId string, username string) (string, error)
 		err    error
 	)
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return "", err
 	}
 	// check status 404
-	_, err = s.checkers.CheckTender(tenderId)
+	tenderDB, err := s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return "", err
 	}
 
-	status, err = s.repoTenderProvider.Status(tenderId)
+	status, err = s.repoTenderProvider.Status(ctx, tenderId)
 	if err != nil {
 		return "", err
 	}
-	log.Info("Status tender from DB:", status)
+	log.Info("Status tender from DB", slog.Any("status", status))
 
 	// check status 403
 	if strings.EqualFold(status, "Created") || strings.EqualFold(status, "Closed") {
-		err = s.checkers.CheckResponsibleToTender(tenderId, username)
+		err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ViewTenders)
 		if err != nil {
 			return "", err
 		}
@@ -198,7 +287,7 @@ func (s *Service) TenderStatus(tenderId string, username string) (string, error)
 	return status, nil
 }
 
-func (s *Service) ChangeTenderStatus(tenderId string, status string, username string) (model.TenderResponse, error) {
+func (s *Service) ChangeTenderStatus(ctx context.Context, tenderId string, status string, expectedVersion int32) (model.TenderResponse, error) {
 	const op = "Service.ChangeTenderStatus"
 	log := s.log.With(
 		slog.String("op", op),
@@ -208,61 +297,61 @@ func (s *Service) ChangeTenderStatus(tenderId string, status string, username st
 		TenderDB       model.TenderDB
 		TenderResponse model.TenderResponse
 		err            error
-		relatedBids    []model.BidDB
 	)
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 404
-	TenderDB, err = s.checkers.CheckTender(tenderId)
-	if err != nil {
-		return model.TenderResponse{}, err
-	}
-	// check status 403
-	err = s.checkers.CheckResponsibleToTender(tenderId, username)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
-	if strings.EqualFold(TenderDB.Status, "Closed") {
-		return model.TenderResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("tender is closed"))
-	}
-	_, err = s.repoTenderEditor.ChangeTenderStatus(tenderId, status)
+	err = s.authorize(ctx, TenderDB.OrganizationId, caller.Id, policy.ManageTenders)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-
-	//reject all related bids
-	relatedBids, err = s.repoBidProvider.BidsForTender(tenderId, 0, 0)
-	if err != nil {
-		return model.TenderResponse{}, err
-	}
-	for _, bid := range relatedBids {
-		_, err = s.repoBidEditor.UpdateBidStatus(bid.Id, "Canceled")
+	// status change and canceling of related bids are one unit of work
+	err = s.transactor.InTransaction(ctx, func(tx repo.Tx) error {
+		lockedTender, err := tx.LockTender(ctx, tenderId)
 		if err != nil {
-			return model.TenderResponse{}, err
+			return err
 		}
-		err = s.repoBidDecisionMaker.ApplyDecision(bid.Id, "Rejected")
+		// check status 403
+		if strings.EqualFold(lockedTender.Status, "Closed") {
+			return errs.Forbidden(fmt.Errorf("tender is closed"))
+		}
+		_, err = tx.ChangeTenderStatus(ctx, tenderId, status, expectedVersion)
 		if err != nil {
-			return model.TenderResponse{}, err
+			return err
+		}
+
+		//reject all related bids
+		relatedBids, _, err := tx.BidsForTender(ctx, tenderId, "", model.PageQuery{})
+		if err != nil {
+			return err
 		}
+		return cancelBids(ctx, tx, relatedBids)
+	})
+	if err != nil {
+		return model.TenderResponse{}, err
 	}
 
-	TenderDB, err = s.checkers.CheckTender(tenderId)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Tender with changed status from DB:", TenderDB)
+	log.Info("Tender with changed status from DB", slog.Any("tenderDB", TenderDB))
 
 	TenderResponse = model.ConvertTenderToResponse(TenderDB)
-	log.Info("Converted Tender to response:", TenderResponse)
+	log.Info("Converted Tender to response", slog.Any("tenderResponse", TenderResponse))
 
 	return TenderResponse, nil
 }
 
-func (s *Service) EditTender(tenderId string, username string, name string, description string, serviceType string) (model.TenderResponse, error) {
+func (s *Service) EditTender(ctx context.Context, tenderId string, name string, description string, serviceType string, submissionDeadline *time.Time, decisionDeadline *time.Time, expectedVersion int32) (model.TenderResponse, error) {
 	const op = "Service.EditTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -274,44 +363,60 @@ func (s *Service) EditTender(tenderId string, username string, name string, desc
 		err            error
 	)
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 404
-	TenderDB, err = s.checkers.CheckTender(tenderId)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
-	err = s.checkers.CheckResponsibleToTender(tenderId, username)
+	err = s.authorize(ctx, TenderDB.OrganizationId, caller.Id, policy.ManageTenders)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
 	if strings.EqualFold(TenderDB.Status, "closed") {
-		return model.TenderResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("tender is closed"))
+		return model.TenderResponse{}, errs.Forbidden(fmt.Errorf("tender is closed"))
+	}
+	// check status 400
+	// deadlines not sent stay the same, so the order is checked against current ones
+	err = checkDeadlines(
+		cmp.Or(submissionDeadline, TenderDB.SubmissionDeadline),
+		cmp.Or(decisionDeadline, TenderDB.DecisionDeadline),
+	)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+	// check status 400
+	if serviceType != "" {
+		err = s.checkServiceType(ctx, serviceType)
+		if err != nil {
+			return model.TenderResponse{}, err
+		}
 	}
 
-	_, err = s.repoTenderEditor.EditTender(tenderId, name, description, serviceType)
+	_, err = s.repoTenderEditor.EditTender(ctx, tenderId, name, description, serviceType, submissionDeadline, decisionDeadline, expectedVersion)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Edited tender from DB:", TenderDB)
+	log.Info("Edited tender from DB", slog.Any("tenderDB", TenderDB))
 
-	TenderDB, err = s.checkers.CheckTender(tenderId)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Created tender from DB:", TenderDB)
+	log.Info("Created tender from DB", slog.Any("tenderDB", TenderDB))
 
 	TenderResponse = model.ConvertTenderToResponse(TenderDB)
-	log.Info("Converted Tender to response:", TenderResponse)
+	log.Info("Converted Tender to response", slog.Any("tenderResponse", TenderResponse))
 
 	return TenderResponse, nil
 }
 
-func (s *Service) RollbackTender(tenderId string, version int32, username string) (model.TenderResponse, error) {
+func (s *Service) RollbackTender(ctx context.Context, tenderId string, version int32, expectedVersion int32) (model.TenderResponse, error) {
 	const op = "Service.RollbackTender"
 	log := s.log.With(
 		slog.String("op", op),
@@ -324,44 +429,132 @@ func (s *Service) RollbackTender(tenderId string, version int32, username string
 	)
 
 	// check status 401
-	_, err = s.checkers.CheckIdByName(username)
+	caller, err := s.caller(ctx)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 404
-	TenderDB, err = s.checkers.CheckTender(tenderId)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 404
-	err = s.checkers.CheckTenderVersion(tenderId, version)
+	err = s.checkers.CheckTenderVersion(ctx, tenderId, version)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
-	err = s.checkers.CheckResponsibleToTender(tenderId, username)
+	err = s.authorize(ctx, TenderDB.OrganizationId, caller.Id, policy.ManageTenders)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
 	// check status 403
 	if strings.EqualFold(TenderDB.Status, "Closed") {
-		return model.TenderResponse{}, echo.NewHTTPError(http.StatusForbidden, fmt.Errorf("tender is closed"))
+		return model.TenderResponse{}, errs.Forbidden(fmt.Errorf("tender is closed"))
 	}
 
-	_, err = s.repoTenderEditor.RollbackTender(tenderId, version)
+	_, err = s.repoTenderEditor.RollbackTender(ctx, tenderId, version, expectedVersion)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Rollback tender from DB:", TenderDB)
+	log.Info("Rollback tender from DB", slog.Any("tenderDB", TenderDB))
 
-	TenderDB, err = s.checkers.CheckTender(tenderId)
+	TenderDB, err = s.checkers.CheckTender(ctx, tenderId)
 	if err != nil {
 		return model.TenderResponse{}, err
 	}
-	log.Info("Created tender from DB:", TenderDB)
+	log.Info("Created tender from DB", slog.Any("tenderDB", TenderDB))
 
 	TenderResponse = model.ConvertTenderToResponse(TenderDB)
-	log.Info("Converted Tender to response:", TenderResponse)
+	log.Info("Converted Tender to response", slog.Any("tenderResponse", TenderResponse))
 
 	return TenderResponse, nil
 }
+
+// CloseExpiredTenders closes published tenders whose decision deadline has passed,
+// bids of such tenders are canceled the same way as on manual closing
+func (s *Service) CloseExpiredTenders(ctx context.Context) (int, error) {
+	const op = "Service.CloseExpiredTenders"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	now := time.Now()
+	expired, err := s.repoTenderProvider.ExpiredTenders(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, tender := range expired {
+		if ctx.Err() != nil {
+			return closed, ctx.Err()
+		}
+		closedNow := false
+		err = s.transactor.InTransaction(ctx, func(tx repo.Tx) error {
+			lockedTender, err := tx.LockTender(ctx, tender.Id)
+			if err != nil {
+				return err
+			}
+			// tender could be closed or edited after it was selected
+			if !isExpired(lockedTender, now) {
+				return nil
+			}
+			_, err = tx.ChangeTenderStatus(ctx, tender.Id, "Closed", int32(lockedTender.Version))
+			if err != nil {
+				return err
+			}
+			closedNow = true
+
+			relatedBids, _, err := tx.BidsForTender(ctx, tender.Id, "", model.PageQuery{})
+			if err != nil {
+				return err
+			}
+			return cancelBids(ctx, tx, relatedBids)
+		})
+		if err != nil {
+			// one broken tender should not block the others
+			log.Error("failed to close expired tender", slog.String("tenderId", tender.Id), sl.Err(err))
+			continue
+		}
+		if closedNow {
+			closed++
+		}
+	}
+	if closed > 0 {
+		log.Info("closed expired tenders", slog.Int("count", closed))
+	}
+
+	return closed, nil
+}
+
+// cancelBids cancels and rejects bids of closed tender. Bids already canceled or decided are left as they are,
+// every change writes bid version and event
+func cancelBids(ctx context.Context, tx repo.Tx, bids []model.BidDB) error {
+	for _, bid := range bids {
+		if strings.EqualFold(bid.Status, "Canceled") || bid.Decision != "" {
+			continue
+		}
+		_, err := tx.UpdateBidStatus(ctx, bid.Id, "Canceled", 0)
+		if err != nil {
+			return err
+		}
+		err = tx.ApplyDecision(ctx, bid.Id, "Rejected")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isExpired(tender model.TenderDB, now time.Time) bool {
+	return strings.EqualFold(tender.Status, "Published") &&
+		tender.DecisionDeadline != nil && !tender.DecisionDeadline.After(now)
+}
+
+func checkDeadlines(submissionDeadline *time.Time, decisionDeadline *time.Time) error {
+	if submissionDeadline != nil && decisionDeadline != nil && decisionDeadline.Before(*submissionDeadline) {
+		return errs.Validation(fmt.Errorf("decision deadline is before submission deadline"))
+	}
+	return nil
+}
@@ -0,0 +1,613 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/quorum"
+	"zadanie-6105/internal/lib/cursor"
+)
+
+func TestTenders_HidesCreatedAndClosed(t *testing.T) {
+	svc, d := newService(t)
+
+	published := tender("Published")
+	created := tender("Created")
+	created.Id = "created"
+	closed := tender("Closed")
+	closed.Id = "closed"
+	d.tenderProvider.EXPECT().Tenders(mock.Anything, model.PageQuery{Limit: 6, Sort: model.SortName}, []string{"Delivery"}).
+		Return([]model.TenderDB{published, created, closed}, 3, nil)
+
+	tenders, err := svc.Tenders(context.Background(), model.PageQuery{Limit: 5}, []string{"Delivery"})
+	require.NoError(t, err)
+	require.Len(t, tenders.Items, 1)
+	assert.Equal(t, tenderId, tenders.Items[0].Id)
+}
+
+func TestCreateTender(t *testing.T) {
+	submission := time.Now().Add(24 * time.Hour)
+	decision := submission.Add(24 * time.Hour)
+	beforeSubmission := submission.Add(-time.Hour)
+
+	approvers := quorum.Policy{Kind: quorum.Approvers, Approvers: []string{otherUserId}}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		decision *time.Time
+		policy   quorum.Policy
+		setup    func(d *deps)
+		want     errs.Kind
+	}{
+		{
+			name:     "no caller",
+			ctx:      context.Background(),
+			decision: &decision,
+			want:     errs.KindUnauthorized,
+		},
+		{
+			name:     "decision deadline before submission deadline",
+			ctx:      callerCtx(),
+			decision: &beforeSubmission,
+			want:     errs.KindValidation,
+		},
+		{
+			name:     "unknown organization",
+			ctx:      callerCtx(),
+			decision: &decision,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("", domainError(errs.KindUnauthorized))
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name:     "not a member of organization",
+			ctx:      callerCtx(),
+			decision: &decision,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "role cannot manage tenders",
+			ctx:      callerCtx(),
+			decision: &decision,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name:     "created",
+			ctx:      callerCtx(),
+			decision: &decision,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+				d.tenderCreator.EXPECT().CreateTender(mock.Anything, "tender", "description", "Delivery", organizationId, username, &submission, &decision, quorum.Default()).
+					Return(tenderId, nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+			},
+		},
+		{
+			name:     "invalid decision policy",
+			ctx:      callerCtx(),
+			decision: &decision,
+			policy:   quorum.Policy{Kind: quorum.Fixed},
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+			},
+			want: errs.KindValidation,
+		},
+		{
+			name:     "approver cannot decide bids",
+			ctx:      callerCtx(),
+			decision: &decision,
+			policy:   approvers,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, otherUserId).Return("bidder", nil)
+			},
+			want: errs.KindValidation,
+		},
+		{
+			name:     "created with approvers",
+			ctx:      callerCtx(),
+			decision: &decision,
+			policy:   approvers,
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckCorporateById(mock.Anything, organizationId).Return("org", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("procurement_manager", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, otherUserId).Return("reviewer", nil)
+				d.tenderCreator.EXPECT().CreateTender(mock.Anything, "tender", "description", "Delivery", organizationId, username, &submission, &decision, approvers).
+					Return(tenderId, nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.CreateTender(tt.ctx, "tender", "description", "Delivery", organizationId, &submission, tt.decision, tt.policy)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, tenderId, got.Id)
+				assert.Equal(t, "Created", got.Status)
+			}
+		})
+	}
+}
+
+func TestGetTenderByUser(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "not responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).Return(nil, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "bidder sees no tenders",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "bidder")}, nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "tenders of every organization",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckMemberships(mock.Anything, userId).
+					Return([]model.MembershipDB{membership(organizationId, "owner"), membership(otherOrganizationId, "viewer")}, nil)
+				d.tenderProvider.EXPECT().
+					TendersByOrganizations(mock.Anything, model.PageQuery{Sort: model.SortName}, []string{organizationId, otherOrganizationId}).
+					Return([]model.TenderDB{tender("Created")}, 1, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.GetTenderByUser(tt.ctx, model.PageQuery{})
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Len(t, got.Items, 1)
+			}
+		})
+	}
+}
+
+func TestTenderStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		setup  func(d *deps)
+		want   errs.Kind
+		status string
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "published is visible to everyone",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Published", nil)
+			},
+			status: "Published",
+		},
+		{
+			name: "created is hidden from outsiders",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Created", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "closed is visible to responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+				d.tenderProvider.EXPECT().Status(mock.Anything, tenderId).Return("Closed", nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			},
+			status: "Closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			got, err := svc.TenderStatus(tt.ctx, tenderId)
+			requireKind(t, err, tt.want)
+			assert.Equal(t, tt.status, got)
+		})
+	}
+}
+
+func TestChangeTenderStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "not responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "viewer cannot change status",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "tender closed meanwhile",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "stale version",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().ChangeTenderStatus(mock.Anything, tenderId, "Closed", int32(7)).
+					Return("", domainError(errs.KindVersionMismatch))
+			},
+			want: errs.KindVersionMismatch,
+		},
+		{
+			name: "closing cancels every bid",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tx.EXPECT().ChangeTenderStatus(mock.Anything, tenderId, "Closed", int32(7)).Return(tenderId, nil)
+				d.tx.EXPECT().BidsForTender(mock.Anything, tenderId, "", model.PageQuery{}).
+					Return([]model.BidDB{bid(bidId, "Published"), bid(otherBidId, "Created")}, 2, nil)
+				for _, id := range []string{bidId, otherBidId} {
+					d.tx.EXPECT().UpdateBidStatus(mock.Anything, id, "Canceled", int32(0)).Return(id, nil).Once()
+					d.tx.EXPECT().ApplyDecision(mock.Anything, id, "Rejected").Return(nil).Once()
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			_, err := svc.ChangeTenderStatus(tt.ctx, tenderId, "Closed", 7)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestEditTender(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "not responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "tender closed",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "edited",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tenderEditor.EXPECT().EditTender(mock.Anything, tenderId, "new", "", "", (*time.Time)(nil), (*time.Time)(nil), int32(1)).Return(tenderId, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			_, err := svc.EditTender(tt.ctx, tenderId, "new", "", "", nil, nil, 1)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestRollbackTender(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "tender not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "version not found",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckTenderVersion(mock.Anything, tenderId, int32(1)).Return(domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "not responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckTenderVersion(mock.Anything, tenderId, int32(1)).Return(nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "tender closed",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Closed"), nil)
+				d.checkers.EXPECT().CheckTenderVersion(mock.Anything, tenderId, int32(1)).Return(nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "rolled back",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckTenderVersion(mock.Anything, tenderId, int32(1)).Return(nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.tenderEditor.EXPECT().RollbackTender(mock.Anything, tenderId, int32(1), int32(0)).Return(tenderId, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			_, err := svc.RollbackTender(tt.ctx, tenderId, 1, 0)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestCloseExpiredTenders(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	expired := tender("Published")
+	expired.DecisionDeadline = &past
+	extended := expired
+	extended.DecisionDeadline = &future
+
+	t.Run("closes tender and cancels bids", func(t *testing.T) {
+		svc, d := newService(t)
+		d.tenderProvider.EXPECT().ExpiredTenders(mock.Anything, mock.Anything).Return([]model.TenderDB{expired}, nil)
+		d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(expired, nil)
+		d.tx.EXPECT().ChangeTenderStatus(mock.Anything, tenderId, "Closed", int32(1)).Return(tenderId, nil)
+		d.tx.EXPECT().BidsForTender(mock.Anything, tenderId, "", model.PageQuery{}).Return([]model.BidDB{bid(bidId, "Published")}, 1, nil)
+		d.tx.EXPECT().UpdateBidStatus(mock.Anything, bidId, "Canceled", int32(0)).Return(bidId, nil)
+		d.tx.EXPECT().ApplyDecision(mock.Anything, bidId, "Rejected").Return(nil)
+
+		closed, err := svc.CloseExpiredTenders(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, closed)
+	})
+
+	t.Run("leaves canceled and decided bids as they are", func(t *testing.T) {
+		decided := bid(otherBidId, "Published")
+		decided.Decision = "Rejected"
+
+		svc, d := newService(t)
+		d.tenderProvider.EXPECT().ExpiredTenders(mock.Anything, mock.Anything).Return([]model.TenderDB{expired}, nil)
+		d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(expired, nil)
+		d.tx.EXPECT().ChangeTenderStatus(mock.Anything, tenderId, "Closed", int32(1)).Return(tenderId, nil)
+		d.tx.EXPECT().BidsForTender(mock.Anything, tenderId, "", model.PageQuery{}).Return([]model.BidDB{bid(bidId, "Canceled"), decided}, 2, nil)
+
+		closed, err := svc.CloseExpiredTenders(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, closed)
+	})
+
+	t.Run("skips tender extended meanwhile", func(t *testing.T) {
+		svc, d := newService(t)
+		d.tenderProvider.EXPECT().ExpiredTenders(mock.Anything, mock.Anything).Return([]model.TenderDB{expired}, nil)
+		d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(extended, nil)
+
+		closed, err := svc.CloseExpiredTenders(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, closed)
+	})
+
+	t.Run("failed tender does not stop the rest", func(t *testing.T) {
+		other := expired
+		other.Id = "other"
+
+		svc, d := newService(t)
+		d.tenderProvider.EXPECT().ExpiredTenders(mock.Anything, mock.Anything).Return([]model.TenderDB{expired, other}, nil)
+		d.tx.EXPECT().LockTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindInternal))
+		d.tx.EXPECT().LockTender(mock.Anything, "other").Return(other, nil)
+		d.tx.EXPECT().ChangeTenderStatus(mock.Anything, "other", "Closed", int32(1)).Return("other", nil)
+		d.tx.EXPECT().BidsForTender(mock.Anything, "other", "", model.PageQuery{}).Return(nil, 0, nil)
+
+		closed, err := svc.CloseExpiredTenders(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, closed)
+	})
+}
+
+func TestTenders_Cursor(t *testing.T) {
+	t.Run("next cursor points to the last item", func(t *testing.T) {
+		svc, d := newService(t)
+
+		first, second, extra := tender("Published"), tender("Published"), tender("Published")
+		first.Id, second.Id, extra.Id = "a", "b", "c"
+		d.tenderProvider.EXPECT().Tenders(mock.Anything, model.PageQuery{Limit: 3, Sort: model.SortVersion}, []string(nil)).
+			Return([]model.TenderDB{first, second, extra}, 5, nil)
+
+		page, err := svc.Tenders(context.Background(), model.PageQuery{Limit: 2, Sort: model.SortVersion}, nil)
+		require.NoError(t, err)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, 5, page.Total)
+
+		after, err := cursor.Decode(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, model.Cursor{Sort: model.SortVersion, Value: "1", Id: "b"}, after)
+
+		d.tenderProvider.EXPECT().Tenders(mock.Anything, model.PageQuery{Limit: 3, Sort: model.SortVersion, Cursor: page.NextCursor, After: &after}, []string(nil)).
+			Return([]model.TenderDB{extra}, 5, nil)
+		page, err = svc.Tenders(context.Background(), model.PageQuery{Limit: 2, Sort: model.SortVersion, Cursor: page.NextCursor}, nil)
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("cursor of another sort order", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		c := cursor.Encode(model.Cursor{Sort: model.SortName, Value: "a", Id: "a"})
+		_, err := svc.Tenders(context.Background(), model.PageQuery{Sort: model.SortName, Desc: true, Cursor: c}, nil)
+		requireKind(t, err, errs.KindValidation)
+	})
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		_, err := svc.Tenders(context.Background(), model.PageQuery{Cursor: "garbage"}, nil)
+		requireKind(t, err, errs.KindValidation)
+	})
+}
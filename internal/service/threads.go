@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9_.-]+)`)
+
+// maxMentions caps names looked up for one message, further mentions stay plain text
+const maxMentions = 20
+
+func (s *Service) PostMessage(ctx context.Context, bidId string, text string) (model.ThreadMessageResponse, error) {
+	const op = "Service.PostMessage"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 404
+	bidDB, err := s.checkers.CheckBid(ctx, bidId)
+	if err != nil {
+		return model.ThreadMessageResponse{}, err
+	}
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.ThreadMessageResponse{}, err
+	}
+	// check status 403
+	// there is no feedback on just created bids, organization does not see them yet
+	if strings.EqualFold(bidDB.Status, "Created") {
+		return model.ThreadMessageResponse{}, errs.Forbidden(fmt.Errorf("organization have no access to just created bids"))
+	}
+	side, err := s.threadSide(ctx, bidDB, caller, policy.SubmitBids, policy.FeedbackBids)
+	if err != nil {
+		return model.ThreadMessageResponse{}, err
+	}
+
+	mentions, err := s.mentions(ctx, text)
+	if err != nil {
+		return model.ThreadMessageResponse{}, err
+	}
+
+	messageDB, err := s.repoBidFeedbacker.PostMessage(ctx, bidId, caller.Id, side, text, mentions, nil)
+	if err != nil {
+		return model.ThreadMessageResponse{}, err
+	}
+	log.Info("Posted message", slog.String("side", side))
+
+	return model.ConvertThreadMessageToResponse(messageDB), nil
+}
+
+func (s *Service) Thread(ctx context.Context, bidId string, page model.PageQuery) (model.Page[model.ThreadMessageResponse], error) {
+	const op = "Service.Thread"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 404
+	bidDB, err := s.checkers.CheckBid(ctx, bidId)
+	if err != nil {
+		return model.Page[model.ThreadMessageResponse]{}, err
+	}
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return model.Page[model.ThreadMessageResponse]{}, err
+	}
+	// check status 403
+	side, err := s.threadSide(ctx, bidDB, caller, policy.ViewBids, policy.ViewReviews)
+	if err != nil {
+		return model.Page[model.ThreadMessageResponse]{}, err
+	}
+	if side == model.SideOrganization && strings.EqualFold(bidDB.Status, "Created") {
+		return model.Page[model.ThreadMessageResponse]{}, errs.Forbidden(fmt.Errorf("organization have no access to just created bids"))
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortCreatedAt)
+	if err != nil {
+		return model.Page[model.ThreadMessageResponse]{}, err
+	}
+
+	messagesDB, total, err := s.repoBidFeedbacker.Thread(ctx, bidId, caller.Id, page)
+	if err != nil {
+		return model.Page[model.ThreadMessageResponse]{}, err
+	}
+	log.Info("Thread from DB", slog.Int("count", len(messagesDB)))
+	messagesDB, nextCursor := nextPage(messagesDB, page)
+
+	return model.Page[model.ThreadMessageResponse]{
+		Items:      model.ConvertThreadMessages(messagesDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+// MarkThreadRead marks every message of thread posted so far as read by caller
+func (s *Service) MarkThreadRead(ctx context.Context, bidId string) error {
+	// check status 404
+	bidDB, err := s.checkers.CheckBid(ctx, bidId)
+	if err != nil {
+		return err
+	}
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	_, err = s.threadSide(ctx, bidDB, caller, policy.ViewBids, policy.ViewReviews)
+	if err != nil {
+		return err
+	}
+
+	return s.repoBidFeedbacker.MarkThreadRead(ctx, bidId, caller.Id)
+}
+
+// threadSide tells for which side of bid thread caller speaks: author of the bid
+// or organization of the tender. Author is checked first, so organization
+// answering its own bid speaks as bidder
+func (s *Service) threadSide(ctx context.Context, bid model.BidDB, caller model.Caller, bidderPermission policy.Permission, organizationPermission policy.Permission) (string, error) {
+	err := s.authorizeBidAuthor(ctx, bid, caller, bidderPermission)
+	if err == nil {
+		return model.SideBidder, nil
+	}
+	if errs.KindOf(err) != errs.KindForbidden {
+		return "", err
+	}
+	tenderDB, err := s.checkers.CheckTender(ctx, bid.TenderId)
+	if err != nil {
+		return "", err
+	}
+	err = s.authorize(ctx, tenderDB.OrganizationId, caller.Id, organizationPermission)
+	if err != nil {
+		return "", err
+	}
+	return model.SideOrganization, nil
+}
+
+// mentions returns known usernames mentioned as @username in order of text, unknown ones stay plain text
+func (s *Service) mentions(ctx context.Context, text string) ([]string, error) {
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		if len(usernames) == maxMentions {
+			break
+		}
+		if !slices.Contains(usernames, match[1]) {
+			usernames = append(usernames, match[1])
+		}
+	}
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	known, err := s.checkers.CheckKnownNames(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(usernames, func(username string) bool {
+		return !slices.Contains(known, username)
+	}), nil
+}
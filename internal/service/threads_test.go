@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func message(side string, mentions []string) model.ThreadMessageDB {
+	return model.ThreadMessageDB{
+		Id:             otherBidId,
+		BidId:          bidId,
+		AuthorId:       userId,
+		AuthorUsername: username,
+		Side:           side,
+		Text:           "text",
+		Mentions:       mentions,
+		CreatedAt:      createdAt,
+	}
+}
+
+func TestPostMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		text     string
+		setup    func(d *deps)
+		want     errs.Kind
+		wantSide string
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			text: "text",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+			},
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "just created bid",
+			ctx:  callerCtx(),
+			text: "text",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "author replies with mention",
+			ctx:  callerCtx(),
+			text: "@boss see @nobody and @boss",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckKnownNames(mock.Anything, []string{"boss", "nobody"}).Return([]string{"boss"}, nil)
+				d.bidFeedbacker.EXPECT().PostMessage(mock.Anything, bidId, userId, "Bidder", "@boss see @nobody and @boss", []string{"boss"}, map[string]int(nil)).
+					Return(message("Bidder", []string{"boss"}), nil)
+			},
+			wantSide: "Bidder",
+		},
+		{
+			name: "mentions are not looked up",
+			ctx:  callerCtx(),
+			text: "@boss see",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckKnownNames(mock.Anything, []string{"boss"}).Return(nil, domainError(errs.KindInternal))
+			},
+			want: errs.KindInternal,
+		},
+		{
+			name: "organization comments",
+			ctx:  callerCtx(),
+			text: "text",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("reviewer", nil)
+				d.bidFeedbacker.EXPECT().PostMessage(mock.Anything, bidId, userId, "Organization", "text", []string(nil), map[string]int(nil)).
+					Return(message("Organization", nil), nil)
+			},
+			wantSide: "Organization",
+		},
+		{
+			name: "viewer cannot comment",
+			ctx:  callerCtx(),
+			text: "text",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Published"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "member of bidding organization without submit",
+			ctx:  callerCtx(),
+			text: "text",
+			setup: func(d *deps) {
+				b := orgBid(bidId, "Published")
+				b.AuthorId = otherOrganizationId
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(b, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, otherOrganizationId, userId).Return("reviewer", nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			got, err := svc.PostMessage(tt.ctx, bidId, tt.text)
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				assert.Equal(t, tt.wantSide, got.Side)
+				assert.NotNil(t, got.Mentions)
+			}
+		})
+	}
+}
+
+// one lookup serves all mentions of message, there are at most 20 of them
+func TestPostMessage_MentionsCapped(t *testing.T) {
+	svc, d := newService(t)
+
+	var text []string
+	var names []string
+	for i := range 25 {
+		name := fmt.Sprintf("user%d", i)
+		text = append(text, "@"+name)
+		if i < 20 {
+			names = append(names, name)
+		}
+	}
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Published"), nil)
+	d.checkers.EXPECT().CheckKnownNames(mock.Anything, names).Return([]string{"user3", "user1"}, nil)
+	d.bidFeedbacker.EXPECT().PostMessage(mock.Anything, bidId, userId, "Bidder", strings.Join(text, " "), []string{"user1", "user3"}, map[string]int(nil)).
+		Return(message("Bidder", []string{"user1", "user3"}), nil)
+
+	_, err := svc.PostMessage(callerCtx(), bidId, strings.Join(text, " "))
+	require.NoError(t, err)
+}
+
+func TestThread(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "organization does not see just created bid",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Created"), nil)
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "author reads thread of own bid",
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(bid(bidId, "Created"), nil)
+				messages := []model.ThreadMessageDB{message("Organization", nil), message("Bidder", nil)}
+				messages[0].Unread = true
+				d.bidFeedbacker.EXPECT().Thread(mock.Anything, bidId, userId, model.PageQuery{Limit: 3, Sort: model.SortCreatedAt}).
+					Return(messages, 2, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			tt.setup(d)
+
+			got, err := svc.Thread(callerCtx(), bidId, model.PageQuery{Limit: 2})
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				require.Len(t, got.Items, 2)
+				assert.True(t, got.Items[0].Unread)
+				assert.Empty(t, got.NextCursor)
+				assert.Equal(t, 2, got.Total)
+			}
+		})
+	}
+}
+
+func TestMarkThreadRead(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Published"), nil)
+	d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("viewer", nil)
+	d.bidFeedbacker.EXPECT().MarkThreadRead(mock.Anything, bidId, userId).Return(nil)
+
+	requireKind(t, svc.MarkThreadRead(callerCtx(), bidId), "")
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+)
+
+func (s *Service) TenderVersions(ctx context.Context, tenderId string) ([]model.TenderResponse, error) {
+	const op = "Service.TenderVersions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	err := s.checkTenderHistoryAccess(ctx, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tendersDB, err := s.repoTenderProvider.TenderVersions(ctx, tenderId)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Tender versions from DB", slog.Int("count", len(tendersDB)))
+
+	return model.ConvertTenders(tendersDB), nil
+}
+
+func (s *Service) TenderVersion(ctx context.Context, tenderId string, version int32) (model.TenderResponse, error) {
+	// check status 401, 404, 403
+	err := s.checkTenderHistoryAccess(ctx, tenderId)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+	// check status 404
+	tenderDB, err := s.repoTenderProvider.TenderVersion(ctx, tenderId, version)
+	if err != nil {
+		return model.TenderResponse{}, err
+	}
+
+	return model.ConvertTenderToResponse(tenderDB), nil
+}
+
+func (s *Service) TenderVersionsDiff(ctx context.Context, tenderId string, from int32, to int32) (model.VersionDiff, error) {
+	// check status 401, 404, 403
+	err := s.checkTenderHistoryAccess(ctx, tenderId)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+	// check status 404
+	fromDB, err := s.repoTenderProvider.TenderVersion(ctx, tenderId, from)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+	toDB, err := s.repoTenderProvider.TenderVersion(ctx, tenderId, to)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+
+	return model.VersionDiff{
+		From:    from,
+		To:      to,
+		Changes: tenderChanges(fromDB, toDB),
+	}, nil
+}
+
+func (s *Service) BidVersions(ctx context.Context, bidId string) ([]model.BidVersionResponse, error) {
+	const op = "Service.BidVersions"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 404, 401, 403
+	err := s.checkBidHistoryAccess(ctx, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	bidsDB, err := s.repoBidProvider.BidVersions(ctx, bidId)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Bid versions from DB", slog.Int("count", len(bidsDB)))
+
+	return model.ConvertBidVersions(bidsDB), nil
+}
+
+func (s *Service) BidVersion(ctx context.Context, bidId string, version int32) (model.BidVersionResponse, error) {
+	// check status 404, 401, 403
+	err := s.checkBidHistoryAccess(ctx, bidId)
+	if err != nil {
+		return model.BidVersionResponse{}, err
+	}
+	// check status 404
+	bidDB, err := s.repoBidProvider.BidVersion(ctx, bidId, version)
+	if err != nil {
+		return model.BidVersionResponse{}, err
+	}
+
+	return model.ConvertBidVersionToResponse(bidDB), nil
+}
+
+func (s *Service) BidVersionsDiff(ctx context.Context, bidId string, from int32, to int32) (model.VersionDiff, error) {
+	// check status 404, 401, 403
+	err := s.checkBidHistoryAccess(ctx, bidId)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+	// check status 404
+	fromDB, err := s.repoBidProvider.BidVersion(ctx, bidId, from)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+	toDB, err := s.repoBidProvider.BidVersion(ctx, bidId, to)
+	if err != nil {
+		return model.VersionDiff{}, err
+	}
+
+	return model.VersionDiff{
+		From:    from,
+		To:      to,
+		Changes: bidChanges(fromDB, toDB),
+	}, nil
+}
+
+// checkTenderHistoryAccess follows TenderStatus: history of not published tender
+// is visible only to its organization
+func (s *Service) checkTenderHistoryAccess(ctx context.Context, tenderId string) error {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 404
+	tenderDB, err := s.checkers.CheckTender(ctx, tenderId)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	if strings.EqualFold(tenderDB.Status, "Created") || strings.EqualFold(tenderDB.Status, "Closed") {
+		return s.authorize(ctx, tenderDB.OrganizationId, caller.Id, policy.ViewTenders)
+	}
+	return nil
+}
+
+// checkBidHistoryAccess follows BidStatus: history of not published bid is visible only to its author
+func (s *Service) checkBidHistoryAccess(ctx context.Context, bidId string) error {
+	// check status 404
+	bidDB, err := s.checkers.CheckBid(ctx, bidId)
+	if err != nil {
+		return err
+	}
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	if !strings.EqualFold(bidDB.Status, "Published") {
+		return s.authorizeBidAuthor(ctx, bidDB, caller, policy.ViewBids)
+	}
+	return nil
+}
+
+func tenderChanges(from model.TenderDB, to model.TenderDB) []model.FieldChange {
+	changes := []model.FieldChange{}
+	changes = appendChange(changes, "name", from.Name, to.Name)
+	changes = appendChange(changes, "description", from.Description, to.Description)
+	changes = appendChange(changes, "serviceType", from.ServiceType, to.ServiceType)
+	changes = appendChange(changes, "status", from.Status, to.Status)
+	changes = appendChange(changes, "submissionDeadline", formatDeadline(from.SubmissionDeadline), formatDeadline(to.SubmissionDeadline))
+	changes = appendChange(changes, "decisionDeadline", formatDeadline(from.DecisionDeadline), formatDeadline(to.DecisionDeadline))
+	changes = appendAttachmentsChange(changes, from.Attachments, to.Attachments)
+	return changes
+}
+
+func bidChanges(from model.BidDB, to model.BidDB) []model.FieldChange {
+	changes := []model.FieldChange{}
+	changes = appendChange(changes, "name", from.Name, to.Name)
+	changes = appendChange(changes, "description", from.Description, to.Description)
+	changes = appendChange(changes, "status", from.Status, to.Status)
+	changes = appendChange(changes, "decision", from.Decision, to.Decision)
+
+	// offer added or removed is reported as a whole
+	switch {
+	case from.Offer == nil && to.Offer == nil:
+	case from.Offer == nil || to.Offer == nil:
+		changes = append(changes, model.FieldChange{Field: "offer", From: from.Offer, To: to.Offer})
+	default:
+		changes = appendChange(changes, "offer.amount", from.Offer.Amount, to.Offer.Amount)
+		changes = appendChange(changes, "offer.currency", from.Offer.Currency, to.Offer.Currency)
+		changes = appendChange(changes, "offer.deliveryDeadline", from.Offer.DeliveryDeadline, to.Offer.DeliveryDeadline)
+		changes = appendChange(changes, "offer.warrantyMonths", from.Offer.WarrantyMonths, to.Offer.WarrantyMonths)
+		if !slices.Equal(from.Offer.Items, to.Offer.Items) {
+			changes = append(changes, model.FieldChange{Field: "offer.items", From: from.Offer.Items, To: to.Offer.Items})
+		}
+	}
+	changes = appendAttachmentsChange(changes, from.Attachments, to.Attachments)
+	return changes
+}
+
+func appendChange[T comparable](changes []model.FieldChange, field string, from T, to T) []model.FieldChange {
+	if from == to {
+		return changes
+	}
+	return append(changes, model.FieldChange{Field: field, From: from, To: to})
+}
+
+// appendAttachmentsChange reports ids of attachments, empty set and nil are the same
+func appendAttachmentsChange(changes []model.FieldChange, from []string, to []string) []model.FieldChange {
+	if slices.Equal(from, to) {
+		return changes
+	}
+	return append(changes, model.FieldChange{Field: "attachments", From: from, To: to})
+}
+
+// formatDeadline matches TenderResponse, no deadline is an empty string
+func formatDeadline(deadline *time.Time) string {
+	if deadline == nil {
+		return ""
+	}
+	return deadline.UTC().Format(time.RFC3339)
+}
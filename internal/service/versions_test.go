@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+)
+
+func TestTenderVersions_Visibility(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "unknown tender",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(model.TenderDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "not published tender of other organization",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Created"), nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "published tender is visible to everyone",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(tender("Published"), nil)
+				d.tenderProvider.EXPECT().TenderVersions(mock.Anything, tenderId).Return([]model.TenderDB{tender("Created"), tender("Published")}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			_, err := svc.TenderVersions(tt.ctx, tenderId)
+			requireKind(t, err, tt.want)
+		})
+	}
+}
+
+func TestTenderVersionsDiff(t *testing.T) {
+	svc, d := newService(t)
+
+	deadline := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	from := tender("Created")
+	to := tender("Published")
+	to.Version = 3
+	to.Description = "changed"
+	to.DecisionDeadline = &deadline
+	d.checkers.EXPECT().CheckTender(mock.Anything, tenderId).Return(to, nil)
+	d.tenderProvider.EXPECT().TenderVersion(mock.Anything, tenderId, int32(1)).Return(from, nil)
+	d.tenderProvider.EXPECT().TenderVersion(mock.Anything, tenderId, int32(3)).Return(to, nil)
+
+	diff, err := svc.TenderVersionsDiff(callerCtx(), tenderId, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []model.FieldChange{
+		{Field: "description", From: "description", To: "changed"},
+		{Field: "status", From: "Created", To: "Published"},
+		{Field: "decisionDeadline", From: "", To: "2024-10-01T12:00:00Z"},
+	}, diff.Changes)
+}
+
+func TestBidVersionsDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		from *model.Offer
+		to   *model.Offer
+		want []model.FieldChange
+	}{
+		{
+			name: "no offers",
+			want: []model.FieldChange{},
+		},
+		{
+			name: "offer added",
+			to:   &model.Offer{Amount: "100", Currency: "RUB"},
+			want: []model.FieldChange{
+				{Field: "offer", From: (*model.Offer)(nil), To: &model.Offer{Amount: "100", Currency: "RUB"}},
+			},
+		},
+		{
+			name: "offer fields changed",
+			from: &model.Offer{Amount: "100", Currency: "RUB", Items: []model.OfferItem{{Name: "a", Quantity: "1", UnitPrice: "100"}}},
+			to:   &model.Offer{Amount: "90", Currency: "RUB", Items: []model.OfferItem{{Name: "a", Quantity: "1", UnitPrice: "90"}}},
+			want: []model.FieldChange{
+				{Field: "offer.amount", From: model.Decimal("100"), To: model.Decimal("90")},
+				{
+					Field: "offer.items",
+					From:  []model.OfferItem{{Name: "a", Quantity: "1", UnitPrice: "100"}},
+					To:    []model.OfferItem{{Name: "a", Quantity: "1", UnitPrice: "90"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+
+			from := bid(bidId, "Published")
+			from.Offer = tt.from
+			to := bid(bidId, "Published")
+			to.Version = 2
+			to.Offer = tt.to
+			d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(to, nil)
+			d.bidProvider.EXPECT().BidVersion(mock.Anything, bidId, int32(1)).Return(from, nil)
+			d.bidProvider.EXPECT().BidVersion(mock.Anything, bidId, int32(2)).Return(to, nil)
+
+			diff, err := svc.BidVersionsDiff(callerCtx(), bidId, 1, 2)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, diff.Changes)
+		})
+	}
+}
+
+func TestBidVersion_NotPublishedIsVisibleOnlyToAuthor(t *testing.T) {
+	svc, d := newService(t)
+
+	d.checkers.EXPECT().CheckBid(mock.Anything, bidId).Return(foreignBid(bidId, "Created"), nil)
+
+	_, err := svc.BidVersion(callerCtx(), bidId, 1)
+	requireKind(t, err, errs.KindForbidden)
+}
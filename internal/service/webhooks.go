@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/domain/policy"
+	sl "zadanie-6105/internal/lib/logger/slog"
+	"zadanie-6105/internal/lib/webhook"
+)
+
+const (
+	// how many events and deliveries are handled by one dispatch run
+	dispatchBatch = 100
+	// claimed delivery is not taken by other dispatchers for this long
+	deliveryLease = time.Minute
+)
+
+type RepoWebhook interface {
+	CreateWebhook(
+		ctx context.Context,
+		organizationId string,
+		url string,
+		secret string,
+		events []string,
+	) (string, error)
+	Webhooks(
+		ctx context.Context,
+		organizationId string,
+	) ([]model.WebhookDB, error)
+	Webhook(
+		ctx context.Context,
+		webhookId string,
+	) (model.WebhookDB, error)
+	DeleteWebhook(
+		ctx context.Context,
+		webhookId string,
+	) error
+	WebhookDeliveries(
+		ctx context.Context,
+		webhookId string,
+		page model.PageQuery,
+	) ([]model.WebhookDeliveryDB, int, error)
+}
+type RepoOutbox interface {
+	FanOutEvents(
+		ctx context.Context,
+		limit int32,
+	) (int, error)
+	ClaimDeliveries(
+		ctx context.Context,
+		now time.Time,
+		lease time.Duration,
+		limit int32,
+	) ([]model.Delivery, error)
+	RecordDelivery(
+		ctx context.Context,
+		deliveryId string,
+		status string,
+		attempts int32,
+		responseCode int,
+		errText string,
+		nextAttemptAt time.Time,
+	) error
+}
+type WebhookSender interface {
+	// CheckURL refuses receivers sender would not connect to
+	CheckURL(
+		ctx context.Context,
+		url string,
+	) error
+	Send(
+		ctx context.Context,
+		delivery model.Delivery,
+	) (int, error)
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, organizationId string, url string, events []string) (model.WebhookResponse, error) {
+	const op = "Service.CreateWebhook"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	// check status 401, 404, 403
+	err := s.checkWebhookAccess(ctx, organizationId)
+	if err != nil {
+		return model.WebhookResponse{}, err
+	}
+	// check status 400
+	err = s.webhookSender.CheckURL(ctx, url)
+	if err != nil {
+		return model.WebhookResponse{}, errs.Validation(err)
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		log.Error("failed to generate secret", sl.Err(err))
+		return model.WebhookResponse{}, errs.Internal(err)
+	}
+	webhookId, err := s.repoWebhook.CreateWebhook(ctx, organizationId, url, secret, events)
+	if err != nil {
+		return model.WebhookResponse{}, err
+	}
+	log.Info("Created webhook", slog.String("id", webhookId))
+
+	webhookDB, err := s.repoWebhook.Webhook(ctx, webhookId)
+	if err != nil {
+		return model.WebhookResponse{}, err
+	}
+
+	response := model.ConvertWebhookToResponse(webhookDB)
+	response.Secret = webhookDB.Secret
+	return response, nil
+}
+
+func (s *Service) Webhooks(ctx context.Context, organizationId string) ([]model.WebhookResponse, error) {
+	// check status 401, 404, 403
+	err := s.checkWebhookAccess(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooksDB, err := s.repoWebhook.Webhooks(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ConvertWebhooks(webhooksDB), nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, organizationId string, webhookId string) error {
+	// check status 401, 404, 403
+	err := s.checkWebhookAccess(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	// check status 404
+	_, err = s.organizationWebhook(ctx, organizationId, webhookId)
+	if err != nil {
+		return err
+	}
+
+	return s.repoWebhook.DeleteWebhook(ctx, webhookId)
+}
+
+func (s *Service) WebhookDeliveries(ctx context.Context, organizationId string, webhookId string, page model.PageQuery) (model.Page[model.WebhookDeliveryResponse], error) {
+	// check status 401, 404, 403
+	err := s.checkWebhookAccess(ctx, organizationId)
+	if err != nil {
+		return model.Page[model.WebhookDeliveryResponse]{}, err
+	}
+	// check status 404
+	_, err = s.organizationWebhook(ctx, organizationId, webhookId)
+	if err != nil {
+		return model.Page[model.WebhookDeliveryResponse]{}, err
+	}
+	// check status 400
+	page, err = pageQuery(page, model.SortCreatedAt)
+	if err != nil {
+		return model.Page[model.WebhookDeliveryResponse]{}, err
+	}
+
+	deliveriesDB, total, err := s.repoWebhook.WebhookDeliveries(ctx, webhookId, page)
+	if err != nil {
+		return model.Page[model.WebhookDeliveryResponse]{}, err
+	}
+	deliveriesDB, nextCursor := nextPage(deliveriesDB, page)
+
+	return model.Page[model.WebhookDeliveryResponse]{
+		Items:      model.ConvertDeliveries(deliveriesDB),
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
+
+// DispatchEvents turns new outbox events into deliveries and sends the due ones,
+// failed deliveries are retried with backoff until webhook.MaxAttempts
+func (s *Service) DispatchEvents(ctx context.Context) error {
+	const op = "Service.DispatchEvents"
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	dispatched, err := s.repoOutbox.FanOutEvents(ctx, dispatchBatch)
+	if err != nil {
+		return err
+	}
+	if dispatched > 0 {
+		log.Debug("dispatched events", slog.Int("count", dispatched))
+	}
+
+	deliveries, err := s.repoOutbox.ClaimDeliveries(ctx, time.Now(), deliveryLease, dispatchBatch)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		// not sent deliveries are picked up again when lease expires
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		status, nextAttemptAt, errText := model.DeliveryDelivered, time.Now(), ""
+		attempts := delivery.Attempts + 1
+		code, err := s.webhookSender.Send(ctx, delivery)
+		if err != nil {
+			errText = err.Error()
+			status = model.DeliveryPending
+			nextAttemptAt = nextAttemptAt.Add(webhook.Backoff(attempts))
+			if attempts >= webhook.MaxAttempts {
+				status = model.DeliveryFailed
+			}
+			log.Warn("failed to deliver event",
+				slog.String("deliveryId", delivery.Id),
+				slog.Int("attempts", int(attempts)),
+				sl.Err(err))
+		}
+
+		err = s.repoOutbox.RecordDelivery(ctx, delivery.Id, status, attempts, code, errText, nextAttemptAt)
+		if err != nil {
+			log.Error("failed to record delivery", slog.String("deliveryId", delivery.Id), sl.Err(err))
+		}
+	}
+
+	return nil
+}
+
+// checkWebhookAccess allows webhooks management only to organization members whose role allows it
+func (s *Service) checkWebhookAccess(ctx context.Context, organizationId string) error {
+	// check status 401
+	caller, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	// check status 404
+	_, err = s.repoOrganizationProvider.Organization(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	// check status 403
+	return s.authorize(ctx, organizationId, caller.Id, policy.ManageWebhooks)
+}
+
+// organizationWebhook hides webhooks of other organizations behind 404
+func (s *Service) organizationWebhook(ctx context.Context, organizationId string, webhookId string) (model.WebhookDB, error) {
+	webhookDB, err := s.repoWebhook.Webhook(ctx, webhookId)
+	if err != nil {
+		return model.WebhookDB{}, err
+	}
+	if webhookDB.OrganizationId != organizationId {
+		return model.WebhookDB{}, errs.NotFound(fmt.Errorf("webhook not found"))
+	}
+	return webhookDB, nil
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+	"zadanie-6105/internal/domain/errs"
+	"zadanie-6105/internal/domain/model"
+	"zadanie-6105/internal/lib/webhook"
+)
+
+const (
+	webhookId  = "66666666-6666-4666-8666-666666666666"
+	deliveryId = "77777777-7777-4777-8777-777777777777"
+)
+
+func organizationWebhook() model.WebhookDB {
+	return model.WebhookDB{
+		Id:             webhookId,
+		OrganizationId: organizationId,
+		Url:            "https://example.com/hook",
+		Secret:         "secret",
+		CreatedAt:      createdAt,
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(d *deps)
+		want  errs.Kind
+	}{
+		{
+			name: "no caller",
+			ctx:  context.Background(),
+			want: errs.KindUnauthorized,
+		},
+		{
+			name: "unknown organization",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{}, domainError(errs.KindNotFound))
+			},
+			want: errs.KindNotFound,
+		},
+		{
+			name: "caller is not responsible",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("", domainError(errs.KindForbidden))
+			},
+			want: errs.KindForbidden,
+		},
+		{
+			name: "receiver is not public",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.sender.EXPECT().CheckURL(mock.Anything, "https://example.com/hook").Return(webhook.ErrNotPublic)
+			},
+			want: errs.KindValidation,
+		},
+		{
+			name: "created",
+			ctx:  callerCtx(),
+			setup: func(d *deps) {
+				d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+				d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+				d.sender.EXPECT().CheckURL(mock.Anything, "https://example.com/hook").Return(nil)
+				d.webhooks.EXPECT().CreateWebhook(mock.Anything, organizationId, "https://example.com/hook", mock.AnythingOfType("string"), []string{model.EventBidCreated}).
+					Return(webhookId, nil)
+				d.webhooks.EXPECT().Webhook(mock.Anything, webhookId).Return(organizationWebhook(), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+			if tt.setup != nil {
+				tt.setup(d)
+			}
+
+			response, err := svc.CreateWebhook(tt.ctx, organizationId, "https://example.com/hook", []string{model.EventBidCreated})
+			requireKind(t, err, tt.want)
+			if tt.want == "" {
+				// secret is returned only on creation
+				assert.Equal(t, "secret", response.Secret)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveries_OtherOrganization(t *testing.T) {
+	svc, d := newService(t)
+
+	other := organizationWebhook()
+	other.OrganizationId = "88888888-8888-4888-8888-888888888888"
+	d.organizations.EXPECT().Organization(mock.Anything, organizationId).Return(model.OrganizationDB{Id: organizationId}, nil)
+	d.checkers.EXPECT().CheckRole(mock.Anything, organizationId, userId).Return("owner", nil)
+	d.webhooks.EXPECT().Webhook(mock.Anything, webhookId).Return(other, nil)
+
+	_, err := svc.WebhookDeliveries(callerCtx(), organizationId, webhookId, model.PageQuery{})
+	requireKind(t, err, errs.KindNotFound)
+}
+
+func TestDispatchEvents(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempts   int32
+		sendCode   int
+		sendErr    error
+		wantStatus string
+		wantRetry  bool
+	}{
+		{
+			name:       "delivered",
+			sendCode:   http.StatusOK,
+			wantStatus: model.DeliveryDelivered,
+		},
+		{
+			name:       "retried with backoff",
+			attempts:   2,
+			sendCode:   http.StatusBadGateway,
+			sendErr:    errors.New("webhook responded with status 502"),
+			wantStatus: model.DeliveryPending,
+			wantRetry:  true,
+		},
+		{
+			name:       "failed after last attempt",
+			attempts:   webhook.MaxAttempts - 1,
+			sendErr:    errors.New("connection refused"),
+			wantStatus: model.DeliveryFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, d := newService(t)
+
+			delivery := model.Delivery{Id: deliveryId, WebhookId: webhookId, Attempts: tt.attempts}
+			d.outbox.EXPECT().FanOutEvents(mock.Anything, mock.Anything).Return(1, nil)
+			d.outbox.EXPECT().ClaimDeliveries(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.Delivery{delivery}, nil)
+			d.sender.EXPECT().Send(mock.Anything, delivery).Return(tt.sendCode, tt.sendErr)
+
+			var nextAttemptAt time.Time
+			d.outbox.EXPECT().RecordDelivery(mock.Anything, deliveryId, tt.wantStatus, tt.attempts+1, tt.sendCode, mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ string, _ string, _ int32, _ int, _ string, next time.Time) error {
+					nextAttemptAt = next
+					return nil
+				})
+
+			before := time.Now()
+			require.NoError(t, svc.DispatchEvents(context.Background()))
+			if tt.wantRetry {
+				assert.False(t, nextAttemptAt.Before(before.Add(webhook.Backoff(tt.attempts+1))))
+			}
+		})
+	}
+}
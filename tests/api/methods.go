@@ -16,6 +16,7 @@ import (
 var (
 	CreateTenderURL = client.BaseURL + "/tenders/new"
 	CreateBidURL    = client.BaseURL + "/bids/new"
+	TokenURL        = client.BaseURL + "/auth/token"
 )
 
 type TenderResponse struct {
@@ -37,12 +38,39 @@ type BidResponse struct {
 	CreatedAt  time.Time `json:"createdAt"`
 }
 
-func CreateTenderJAMBO(c *client.Suite, t *testing.T) TenderResponse {
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Login returns access token for test user
+func Login(c *client.Suite, t *testing.T, username string) string {
+	bodyJSON, err := json.Marshal(map[string]string{
+		"username": username,
+		"password": client.Password(),
+	})
+	require.NoError(t, err)
+	req := client.FormRequest(http.MethodPost, TokenURL, bytes.NewReader(bodyJSON))
+	resp, err := c.Client.Do(req)
+	require.NoError(t, err)
+
+	var token TokenResponse
+	responseBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	err = json.Unmarshal(responseBytes, &token)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	return token.AccessToken
+}
+
+func CreateTenderJAMBO(c *client.Suite, t *testing.T, token string) TenderResponse {
 	body := tests.RandomTenderBodyJAMBO()
 	bodyJSON, err := json.Marshal(body)
 	require.NoError(t, err)
 	bodyReq := bytes.NewReader(bodyJSON)
-	req := client.FormRequest(http.MethodPost, CreateTenderURL, bodyReq)
+	req := client.FormAuthRequest(http.MethodPost, CreateTenderURL, bodyReq, token)
 	resp, err := c.Client.Do(req)
 	require.NoError(t, err)
 
@@ -57,20 +85,20 @@ func CreateTenderJAMBO(c *client.Suite, t *testing.T) TenderResponse {
 	return tender
 }
 
-func Publish(c *client.Suite, t *testing.T, url string) {
-	req := client.FormRequest(http.MethodPut, url, nil)
+func Publish(c *client.Suite, t *testing.T, url string, token string) {
+	req := client.FormAuthRequest(http.MethodPut, url, nil, token)
 	resp, err := c.Client.Do(req)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 	resp.Body.Close()
 }
 
-func CreateBidEGER(c *client.Suite, t *testing.T, tenderId string) BidResponse {
+func CreateBidEGER(c *client.Suite, t *testing.T, tenderId string, token string) BidResponse {
 	body := tests.RandomBidBodyEGER(tenderId)
 	bodyJSON, err := json.Marshal(body)
 	require.NoError(t, err)
 	bodyReq := bytes.NewReader(bodyJSON)
-	req := client.FormRequest(http.MethodPost, CreateBidURL, bodyReq)
+	req := client.FormAuthRequest(http.MethodPost, CreateBidURL, bodyReq, token)
 
 	resp, err := c.Client.Do(req)
 	require.NoError(t, err)
@@ -86,8 +114,8 @@ func CreateBidEGER(c *client.Suite, t *testing.T, tenderId string) BidResponse {
 	return bid
 }
 
-func SubmitDecision(c *client.Suite, t *testing.T, url string) BidResponse {
-	req := client.FormRequest(http.MethodPut, url, nil)
+func SubmitDecision(c *client.Suite, t *testing.T, url string, token string) BidResponse {
+	req := client.FormAuthRequest(http.MethodPut, url, nil, token)
 	resp, err := c.Client.Do(req)
 	require.NoError(t, err)
 
@@ -127,9 +155,9 @@ func GetTenders(c *client.Suite, t *testing.T, limit int32, offest int32) []Tend
 	return tenders
 }
 
-func BidsForTender(c *client.Suite, t *testing.T, tenderId string, username string) []BidResponse {
-	GetBidsForTenderURL := client.BaseURL + fmt.Sprintf("/bids/%s/list?username=%s", tenderId, username)
-	req := client.FormRequest(http.MethodGet, GetBidsForTenderURL, nil)
+func BidsForTender(c *client.Suite, t *testing.T, tenderId string, token string) []BidResponse {
+	GetBidsForTenderURL := client.BaseURL + fmt.Sprintf("/bids/%s/list", tenderId)
+	req := client.FormAuthRequest(http.MethodGet, GetBidsForTenderURL, nil, token)
 	resp, err := c.Client.Do(req)
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode)
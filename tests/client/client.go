@@ -54,6 +54,33 @@ func FormRequest(
 	return req
 }
 
+// FormAuthRequest is FormRequest with bearer token of the acting user
+func FormAuthRequest(
+	method string,
+	url string,
+	body io.Reader,
+	token string,
+) *http.Request {
+	req := FormRequest(method, url, body)
+	if req == nil {
+		return nil
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return req
+}
+
+// Password of test users, every test user must be registered with it
+func Password() string {
+	const key = "TEST_PASSWORD"
+
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	return "password"
+}
+
 func configPath() string {
 	const key = "CONFIG_PATH"
 
@@ -21,23 +21,25 @@ var (
 func TestReject(t *testing.T) {
 	_, c := client.New(t)
 
+	responsibleToken := api.Login(c, t, responsibleUsername)
+	authorToken := api.Login(c, t, authorName)
 	//CreateTenderJAMBO
-	tender := api.CreateTenderJAMBO(c, t)
+	tender := api.CreateTenderJAMBO(c, t, responsibleToken)
 	//PublishTender
 	PublishTenderURL = client.BaseURL +
-		fmt.Sprintf("/tenders/%s/status?status=Published&username=%s", tender.Id, responsibleUsername)
-	api.Publish(c, t, PublishTenderURL)
+		fmt.Sprintf("/tenders/%s/status?status=Published", tender.Id)
+	api.Publish(c, t, PublishTenderURL, responsibleToken)
 	//CreateBidEGER
-	bid := api.CreateBidEGER(c, t, tender.Id)
+	bid := api.CreateBidEGER(c, t, tender.Id, authorToken)
 	bidId = bid.Id
 	//PublishBid
 	PublishBidURL = client.BaseURL +
-		fmt.Sprintf("/bids/%s/status?status=Published&username=%s", bidId, authorName)
-	api.Publish(c, t, PublishBidURL)
+		fmt.Sprintf("/bids/%s/status?status=Published", bidId)
+	api.Publish(c, t, PublishBidURL, authorToken)
 	//RejectDecision
 	RejectBidURL = client.BaseURL +
-		fmt.Sprintf("/bids/%s/submit_decision?decision=Rejected&username=%s", bidId, responsibleUsername)
-	bid = api.SubmitDecision(c, t, RejectBidURL)
+		fmt.Sprintf("/bids/%s/submit_decision?decision=Rejected", bidId)
+	bid = api.SubmitDecision(c, t, RejectBidURL, responsibleToken)
 	require.Equal(t, "Canceled", bid.Status)
 	//3 version because we changed status 2 times: Created-Published-Canceled
 	require.Equal(t, 3, bid.Version)
@@ -47,35 +49,36 @@ func TestReject(t *testing.T) {
 func TestApprove(t *testing.T) {
 	_, c := client.New(t)
 
+	responsibleToken := api.Login(c, t, responsibleUsername)
+	authorToken := api.Login(c, t, authorName)
 	//CreateTenderJAMBO
-	tender := api.CreateTenderJAMBO(c, t)
+	tender := api.CreateTenderJAMBO(c, t, responsibleToken)
 	//PublishTender
 	PublishTenderURL = client.BaseURL +
-		fmt.Sprintf("/tenders/%s/status?status=Published&username=%s", tender.Id, responsibleUsername)
-	api.Publish(c, t, PublishTenderURL)
+		fmt.Sprintf("/tenders/%s/status?status=Published", tender.Id)
+	api.Publish(c, t, PublishTenderURL, responsibleToken)
 	//CreateBids
-	_ = api.CreateBidEGER(c, t, tender.Id)
-	_ = api.CreateBidEGER(c, t, tender.Id)
-	bid := api.CreateBidEGER(c, t, tender.Id)
+	_ = api.CreateBidEGER(c, t, tender.Id, authorToken)
+	_ = api.CreateBidEGER(c, t, tender.Id, authorToken)
+	bid := api.CreateBidEGER(c, t, tender.Id, authorToken)
 	bidId = bid.Id
 	//PublishBid
 	PublishBidURL = client.BaseURL +
-		fmt.Sprintf("/bids/%s/status?status=Published&username=%s", bidId, authorName)
-	api.Publish(c, t, PublishBidURL)
+		fmt.Sprintf("/bids/%s/status?status=Published", bidId)
+	api.Publish(c, t, PublishBidURL, authorToken)
 	//Approve
 	responsibles := []string{"Jambo", "ignat", "test_user"}
 	for i := 0; i < 3; i++ {
 		//hardcoded in DB
-		responsibleUsername = responsibles[i]
 		ApproveBidURL = client.BaseURL +
-			fmt.Sprintf("/bids/%s/submit_decision?decision=Approved&username=%s", bidId, responsibleUsername)
-		bid = api.SubmitDecision(c, t, ApproveBidURL)
+			fmt.Sprintf("/bids/%s/submit_decision?decision=Approved", bidId)
+		bid = api.SubmitDecision(c, t, ApproveBidURL, api.Login(c, t, responsibles[i]))
 	}
 	require.Equal(t, "Published", bid.Status)
 	//2 version because we changed status once: Created-Published
 	require.Equal(t, 2, bid.Version)
 	//check other bids is canceled
-	otherBids := api.BidsForTender(c, t, tender.Id, "Jambo")
+	otherBids := api.BidsForTender(c, t, tender.Id, responsibleToken)
 	for _, b := range otherBids {
 		if b.Id != bid.Id {
 			require.Equal(t, "Canceled", b.Status)
@@ -5,11 +5,10 @@ import (
 )
 
 type TenderBody struct {
-	Name            string `json:"name"`
-	Description     string `json:"description"`
-	ServiceType     string `json:"serviceType"`
-	OrganizationId  string `json:"organizationId"`
-	CreatorUsername string `json:"creatorUsername"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ServiceType    string `json:"serviceType"`
+	OrganizationId string `json:"organizationId"`
 }
 
 type BidBody struct {
@@ -22,16 +21,16 @@ type BidBody struct {
 
 func RandomTenderBodyJAMBO() TenderBody {
 	return TenderBody{
-		Name:            gofakeit.Name(),
-		Description:     gofakeit.Bird(),
-		ServiceType:     gofakeit.RandomString([]string{"Delivery", "Manufacture", "Construction"}),
-		OrganizationId:  "550e8400-e29b-41d4-a716-446655440000",
-		CreatorUsername: "Jambo",
+		Name:           gofakeit.Name(),
+		Description:    gofakeit.Bird(),
+		ServiceType:    gofakeit.RandomString([]string{"Delivery", "Manufacture", "Construction"}),
+		OrganizationId: "550e8400-e29b-41d4-a716-446655440000",
 	}
 }
 
 func RandomBidBodyEGER(tenderId string) BidBody {
 	//authorId is hardcoded because i cannot get user id by username(it is available only in service layer)
+	//it must belong to the user whose token creates the bid
 	return BidBody{
 		Name:        gofakeit.Name(),
 		Description: gofakeit.Slogan(),
@@ -13,15 +13,17 @@ var (
 
 func TestCreateTender_Happy(t *testing.T) {
 	_, c := client.New(t)
-	api.CreateTenderJAMBO(c, t)
+	token := api.Login(c, t, "Jambo")
+	api.CreateTenderJAMBO(c, t, token)
 }
 
 func TestCreatePublishedTender(t *testing.T) {
 	_, c := client.New(t)
 
-	tender := api.CreateTenderJAMBO(c, t)
+	token := api.Login(c, t, "Jambo")
+	tender := api.CreateTenderJAMBO(c, t, token)
 	tenderId = tender.Id
 	PublishTenderURL := client.BaseURL +
-		fmt.Sprintf("/tenders/%s/status?status=Published&username=%s", tender.Id, "Jambo")
-	api.Publish(c, t, PublishTenderURL)
+		fmt.Sprintf("/tenders/%s/status?status=Published", tender.Id)
+	api.Publish(c, t, PublishTenderURL, token)
 }